@@ -0,0 +1,16 @@
+resource "grafana_message_template" "my_template" {
+  name     = "My Template"
+  template = "{{define \"My Template\" }}\n template content\n{{ end }}"
+}
+
+data "grafana_alerting_export" "current" {
+  depends_on = [grafana_message_template.my_template]
+}
+
+output "contact_point_names" {
+  value = data.grafana_alerting_export.current.contact_points[*].name
+}
+
+output "notification_policy" {
+  value = jsondecode(data.grafana_alerting_export.current.notification_policy_json)
+}
@@ -0,0 +1,13 @@
+data "grafana_api_call" "health" {
+  path = "/api/health"
+  exports = {
+    version = "version"
+  }
+}
+
+data "grafana_api_call" "current_org" {
+  path = "/api/org"
+  exports = {
+    name = "name"
+  }
+}
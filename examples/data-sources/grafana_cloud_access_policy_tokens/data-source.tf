@@ -0,0 +1,35 @@
+data "grafana_cloud_organization" "current" {
+  slug = "<your org slug>"
+}
+
+resource "grafana_cloud_access_policy" "test" {
+  region = "us"
+  name   = "my-policy"
+
+  scopes = ["metrics:read"]
+
+  realm {
+    type       = "org"
+    identifier = data.grafana_cloud_organization.current.id
+  }
+}
+
+resource "grafana_cloud_access_policy_token" "test" {
+  region           = "us"
+  access_policy_id = grafana_cloud_access_policy.test.policy_id
+  name             = "my-policy-token"
+  expires_at       = "2030-01-01T00:00:00Z"
+}
+
+data "grafana_cloud_access_policy_tokens" "test" {
+  region           = "us"
+  access_policy_id = grafana_cloud_access_policy_token.test.access_policy_id
+}
+
+// Tokens expiring in the next 30 days
+output "tokens_to_rotate" {
+  value = [
+    for token in data.grafana_cloud_access_policy_tokens.test.tokens : token.name
+    if token.expires_at != "" && timecmp(token.expires_at, timeadd(plantimestamp(), "720h")) < 0
+  ]
+}
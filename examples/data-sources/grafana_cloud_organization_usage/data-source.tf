@@ -0,0 +1,7 @@
+data "grafana_cloud_organization_usage" "test" {
+  org_slug = "orgname"
+}
+
+output "stacks_over_10k_series" {
+  value = [for stack in data.grafana_cloud_organization_usage.test.stacks : stack.stack_slug if stack.metrics_active_series > 10000]
+}
@@ -0,0 +1,7 @@
+data "grafana_cloud_stack_plugins" "test" {
+  stack_slug = "stackname"
+}
+
+output "clock_panel_version" {
+  value = data.grafana_cloud_stack_plugins.test.versions["grafana-clock-panel"]
+}
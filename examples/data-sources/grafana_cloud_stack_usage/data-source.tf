@@ -0,0 +1,7 @@
+data "grafana_cloud_stack_usage" "test" {
+  stack_slug = "stackname"
+}
+
+output "active_series" {
+  value = data.grafana_cloud_stack_usage.test.metrics_active_series
+}
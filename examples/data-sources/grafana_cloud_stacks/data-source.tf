@@ -0,0 +1,10 @@
+data "grafana_cloud_stacks" "platform" {
+  org_slug = "orgname"
+  labels = {
+    team = "platform"
+  }
+}
+
+output "platform_stack_urls" {
+  value = [for stack in data.grafana_cloud_stacks.platform.stacks : stack.url]
+}
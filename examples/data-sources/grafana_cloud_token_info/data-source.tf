@@ -0,0 +1,8 @@
+data "grafana_cloud_token_info" "current" {}
+
+check "cloud_token_permissions" {
+  assert {
+    condition     = contains(data.grafana_cloud_token_info.current.scopes, "stacks:read")
+    error_message = "The configured Cloud token must have the stacks:read scope."
+  }
+}
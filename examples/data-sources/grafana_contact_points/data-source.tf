@@ -0,0 +1,16 @@
+resource "grafana_contact_point" "team_a" {
+  name = "team-a-email"
+
+  email {
+    addresses = ["team-a@company.org"]
+  }
+}
+
+data "grafana_contact_points" "team_a" {
+  name_prefix = "team-a-"
+  depends_on  = [grafana_contact_point.team_a]
+}
+
+output "team_a_receivers" {
+  value = data.grafana_contact_points.team_a.names
+}
@@ -1,4 +1,10 @@
+resource "grafana_folder" "test" {
+  uid   = "test-ds-dashboard-folder-uid"
+  title = "Dashboard Data Source Folder"
+}
+
 resource "grafana_dashboard" "test" {
+  folder = grafana_folder.test.uid
   config_json = jsonencode({
     id            = 12345,
     uid           = "test-ds-dashboard-uid"
@@ -21,3 +27,11 @@ data "grafana_dashboard" "from_uid" {
   ]
   uid = "test-ds-dashboard-uid"
 }
+
+data "grafana_dashboard" "from_title" {
+  depends_on = [
+    grafana_dashboard.test
+  ]
+  folder_uid = grafana_folder.test.uid
+  title      = "Production Overview"
+}
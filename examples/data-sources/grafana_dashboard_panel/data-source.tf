@@ -0,0 +1,22 @@
+resource "grafana_dashboard" "test" {
+  config_json = jsonencode({
+    title = "Production Overview"
+    uid   = "production-overview-panels"
+    panels = [{
+      id      = 2
+      title   = "Requests"
+      type    = "timeseries"
+      gridPos = { x = 0, y = 0, h = 8, w = 12 }
+    }]
+  })
+}
+
+data "grafana_dashboard_panel" "requests" {
+  dashboard_uid = grafana_dashboard.test.uid
+  panel_id      = 2
+}
+
+resource "grafana_library_panel" "requests" {
+  name       = data.grafana_dashboard_panel.requests.title
+  model_json = data.grafana_dashboard_panel.requests.model_json
+}
@@ -0,0 +1,24 @@
+resource "grafana_dashboard" "test" {
+  config_json = jsonencode({
+    title = "Production Overview"
+    uid   = "production-overview-images"
+    panels = [{
+      id    = 2
+      title = "Requests"
+      type  = "timeseries"
+    }]
+  })
+}
+
+data "grafana_dashboard_panel_image" "requests" {
+  dashboard_uid = grafana_dashboard.test.uid
+  panel_id      = 2
+  from          = "now-24h"
+  width         = 800
+  height        = 400
+  theme         = "light"
+}
+
+output "requests_image" {
+  value = data.grafana_dashboard_panel_image.requests.render_url
+}
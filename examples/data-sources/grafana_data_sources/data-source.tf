@@ -0,0 +1,20 @@
+resource "grafana_data_source" "prometheus" {
+  type = "prometheus"
+  name = "prometheus-ds-test"
+  url  = "https://my-instance.com"
+}
+
+resource "grafana_data_source" "loki" {
+  type = "loki"
+  name = "loki-ds-test"
+  url  = "https://my-instance.com"
+}
+
+data "grafana_data_sources" "prometheus" {
+  type = "prometheus"
+
+  depends_on = [
+    grafana_data_source.prometheus,
+    grafana_data_source.loki,
+  ]
+}
@@ -14,3 +14,14 @@ data "grafana_folders" "test" {
     grafana_folder.test_b,
   ]
 }
+
+// The folders the provider's credentials can edit, with a title starting with "test-folder-a"
+data "grafana_folders" "editable" {
+  permission   = "Edit"
+  title_prefix = "test-folder-a"
+
+  depends_on = [
+    grafana_folder.test_a,
+    grafana_folder.test_b,
+  ]
+}
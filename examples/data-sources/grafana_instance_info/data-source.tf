@@ -0,0 +1,16 @@
+data "grafana_instance_info" "this" {}
+
+// Only create the team sync on Grafana Enterprise
+resource "grafana_team" "platform" {
+  name = "Platform"
+}
+
+resource "grafana_team_external_group" "platform" {
+  count   = data.grafana_instance_info.this.enterprise ? 1 : 0
+  team_id = grafana_team.platform.id
+  groups  = ["platform"]
+}
+
+output "grafana_version" {
+  value = data.grafana_instance_info.this.version
+}
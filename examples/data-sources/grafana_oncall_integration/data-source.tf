@@ -0,0 +1,21 @@
+data "grafana_oncall_team" "my_team" {
+  name = "my team"
+}
+
+// The integration and the escalation chain are managed in another Terraform configuration
+data "grafana_oncall_integration" "alertmanager" {
+  name    = "Alertmanager"
+  team_id = data.grafana_oncall_team.my_team.id
+}
+
+data "grafana_oncall_escalation_chain" "critical" {
+  name    = "critical"
+  team_id = data.grafana_oncall_team.my_team.id
+}
+
+resource "grafana_oncall_route" "critical" {
+  integration_id      = data.grafana_oncall_integration.alertmanager.id
+  escalation_chain_id = data.grafana_oncall_escalation_chain.critical.id
+  routing_regex       = "severity=critical"
+  position            = 0
+}
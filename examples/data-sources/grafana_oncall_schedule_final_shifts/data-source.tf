@@ -0,0 +1,13 @@
+data "grafana_oncall_schedule" "schedule" {
+  name = "example_schedule"
+}
+
+data "grafana_oncall_schedule_final_shifts" "next_week" {
+  schedule_id = data.grafana_oncall_schedule.schedule.id
+  start_date  = "2024-01-01"
+  end_date    = "2024-01-07"
+}
+
+output "coverage_gaps" {
+  value = data.grafana_oncall_schedule_final_shifts.next_week.gaps
+}
@@ -0,0 +1,7 @@
+data "grafana_oncall_team" "my_team" {
+  name = "my team"
+}
+
+data "grafana_oncall_schedules" "team_schedules" {
+  team_id = data.grafana_oncall_team.my_team.id
+}
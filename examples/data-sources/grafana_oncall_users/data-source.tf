@@ -0,0 +1,9 @@
+data "grafana_oncall_schedules" "all" {}
+
+data "grafana_oncall_users" "on_call_now" {
+  ids = flatten(data.grafana_oncall_schedules.all.schedules[*].on_call_now)
+}
+
+output "unverified_users" {
+  value = [for u in data.grafana_oncall_users.on_call_now.users : u.username if !u.phone_number_verified]
+}
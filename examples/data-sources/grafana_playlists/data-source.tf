@@ -0,0 +1,19 @@
+resource "grafana_playlist" "test" {
+  name     = "My Playlist!"
+  interval = "5m"
+
+  item {
+    order = 1
+    title = "Terraform Dashboard By Tag"
+    type  = "dashboard_by_tag"
+    value = "terraform"
+  }
+}
+
+data "grafana_playlists" "mine" {
+  query = "My Playlist"
+
+  depends_on = [
+    grafana_playlist.test,
+  ]
+}
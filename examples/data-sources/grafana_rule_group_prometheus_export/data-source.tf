@@ -0,0 +1,51 @@
+resource "grafana_data_source" "prometheus" {
+  type = "prometheus"
+  name = "My Prometheus"
+  url  = "http://prometheus:9090"
+}
+
+resource "grafana_folder" "rule_folder" {
+  title = "My Rule Folder"
+}
+
+resource "grafana_rule_group" "my_rule_group" {
+  name             = "My Rule Group"
+  folder_uid       = grafana_folder.rule_folder.uid
+  interval_seconds = 60
+
+  rule {
+    name = "High request latency"
+    for  = "5m"
+    data {
+      ref_id = "A"
+      relative_time_range {
+        from = 600
+        to   = 0
+      }
+      datasource_uid = grafana_data_source.prometheus.uid
+      model = jsonencode({
+        refId   = "A"
+        expr    = "histogram_quantile(0.99, sum by (le) (rate(http_request_duration_seconds_bucket[5m])))"
+        instant = true
+      })
+    }
+    threshold {
+      ref_id = "A"
+      type   = "gt"
+      value  = 0.5
+    }
+    labels = {
+      severity = "warning"
+    }
+  }
+}
+
+data "grafana_rule_group_prometheus_export" "my_rule_group" {
+  folder_uid = grafana_rule_group.my_rule_group.folder_uid
+  name       = grafana_rule_group.my_rule_group.name
+}
+
+# The rules can be written to a file, e.g. to run `promtool check rules` on them in CI
+output "prometheus_rules" {
+  value = data.grafana_rule_group_prometheus_export.my_rule_group.yaml
+}
@@ -0,0 +1,40 @@
+resource "grafana_folder" "rule_folder" {
+  title = "My Rule Folder"
+}
+
+resource "grafana_rule_group" "my_rule_group" {
+  name             = "My Rule Group"
+  folder_uid       = grafana_folder.rule_folder.uid
+  interval_seconds = 60
+
+  rule {
+    name      = "My Rule"
+    condition = "A"
+    data {
+      ref_id = "A"
+      math {
+        expression = "2 + 2"
+      }
+    }
+    labels = {
+      severity = "warning"
+    }
+    annotations = {
+      summary = "The value is {{ $values.A.Value }} ({{ $labels.severity }})"
+    }
+  }
+}
+
+data "grafana_rule_preview" "my_rule" {
+  folder_uid = grafana_rule_group.my_rule_group.folder_uid
+  rule_group = grafana_rule_group.my_rule_group.name
+  name       = "My Rule"
+}
+
+# Fails the plan if the summary of the rule can't be rendered as expected
+check "rule_summary" {
+  assert {
+    condition     = data.grafana_rule_preview.my_rule.instances[0].annotations.summary == "The value is 4 (warning)"
+    error_message = "Unexpected summary: ${data.grafana_rule_preview.my_rule.instances[0].annotations.summary}"
+  }
+}
@@ -0,0 +1,5 @@
+data "grafana_synthetic_monitoring_regions" "main" {}
+
+output "regions_with_private_probes" {
+  value = [for region in data.grafana_synthetic_monitoring_regions.main.regions : region.name if length(region.private_probes) > 0]
+}
@@ -0,0 +1,21 @@
+data "grafana_synthetic_monitoring_probes" "main" {}
+
+data "grafana_synthetic_monitoring_usage_estimate" "checks" {
+  check {
+    name      = "HTTP homepage"
+    frequency = 60000
+    probes = [
+      data.grafana_synthetic_monitoring_probes.main.probes.Atlanta,
+      data.grafana_synthetic_monitoring_probes.main.probes.Paris,
+    ]
+    active_series_per_probe = 60
+  }
+
+  check {
+    name      = "Ping API"
+    frequency = 10000
+    probes = [
+      data.grafana_synthetic_monitoring_probes.main.probes.Atlanta,
+    ]
+  }
+}
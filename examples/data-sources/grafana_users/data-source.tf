@@ -10,3 +10,16 @@ data "grafana_users" "all_users" {
     grafana_user.test_all_users,
   ]
 }
+
+// Users whose login, email or name contains the query, as the members of a team
+data "grafana_users" "filtered" {
+  query = "test-grafana-users"
+  depends_on = [
+    grafana_user.test_all_users,
+  ]
+}
+
+resource "grafana_team" "filtered_users" {
+  name    = "Testing grafana_users filters"
+  members = data.grafana_users.filtered.emails
+}
@@ -0,0 +1,12 @@
+resource "grafana_admin_settings" "settings" {
+  saml = {
+    enabled          = "true"
+    idp_metadata_url = "https://idp.example.com/metadata"
+    allow_sign_up    = "false"
+  }
+
+  secure_saml = {
+    certificate = file("saml.crt")
+    private_key = file("saml.key")
+  }
+}
@@ -0,0 +1 @@
+terraform import grafana_alerting_admin_config.config {{org_id}}
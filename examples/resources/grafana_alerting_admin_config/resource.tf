@@ -0,0 +1,16 @@
+resource "grafana_data_source" "alertmanager" {
+  type = "alertmanager"
+  name = "External Alertmanager"
+  url  = "http://alertmanager.example.com:9093"
+
+  json_data_encoded = jsonencode({
+    implementation             = "prometheus"
+    handleGrafanaManagedAlerts = true
+  })
+}
+
+resource "grafana_alerting_admin_config" "config" {
+  send_alerts_to = "external"
+
+  depends_on = [grafana_data_source.alertmanager]
+}
@@ -0,0 +1,2 @@
+terraform import grafana_annotation_permissions.annotation_permissions_name {{role_uid}}
+terraform import grafana_annotation_permissions.annotation_permissions_name {{org_id}}:{{role_uid}}
@@ -0,0 +1,24 @@
+resource "grafana_dashboard" "production" {
+  config_json = jsonencode({
+    title = "Production Overview"
+    uid   = "production-overview"
+  })
+}
+
+resource "grafana_team" "sre" {
+  name = "SRE"
+}
+
+resource "grafana_service_account" "deployments" {
+  name = "deployments"
+  role = "Viewer"
+}
+
+// Only the SRE team and the deployment pipeline can annotate the production dashboard
+resource "grafana_annotation_permissions" "production" {
+  name             = "Production Overview annotators"
+  dashboard_uid    = grafana_dashboard.production.uid
+  actions          = ["create", "write", "delete"]
+  teams            = [grafana_team.sre.id]
+  service_accounts = [grafana_service_account.deployments.id]
+}
@@ -0,0 +1,20 @@
+resource "grafana_folder" "test" {
+  title = "Short URL target"
+}
+
+// Short URLs aren't supported by the other resources
+resource "grafana_api_call" "short_url" {
+  path = "/api/short-urls"
+  body = jsonencode({
+    path = "dashboards/f/${grafana_folder.test.uid}/"
+  })
+  exports = {
+    uid = "uid"
+    url = "url"
+  }
+}
+
+output "short_url" {
+  value     = grafana_api_call.short_url.response_exports["url"]
+  sensitive = true
+}
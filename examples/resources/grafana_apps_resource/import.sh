@@ -0,0 +1 @@
+terraform import grafana_apps_resource.name {{group}}/{{version}}/{{namespace}}/{{plural}}/{{name}}
@@ -0,0 +1,19 @@
+resource "grafana_apps_resource" "playlist" {
+  manifest = jsonencode({
+    apiVersion = "playlist.grafana.app/v0alpha1"
+    kind       = "Playlist"
+    metadata = {
+      name = "terraform-playlist"
+    }
+    spec = {
+      title    = "My Playlist!"
+      interval = "5m"
+      items = [
+        {
+          type  = "dashboard_by_tag"
+          value = "terraform"
+        },
+      ]
+    }
+  })
+}
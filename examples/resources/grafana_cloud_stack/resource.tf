@@ -3,4 +3,9 @@ resource "grafana_cloud_stack" "test" {
   slug        = "gcloudstacktest"
   region_slug = "eu"
   description = "Test Grafana Cloud Stack"
+
+  labels = {
+    team = "platform"
+    env  = "production"
+  }
 }
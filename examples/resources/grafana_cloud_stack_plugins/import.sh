@@ -0,0 +1 @@
+terraform import grafana_cloud_stack_plugins.stack_name {{stack_slug}}
@@ -0,0 +1,7 @@
+resource "grafana_cloud_stack_plugins" "test" {
+  stack_slug = "stackname"
+  plugins = {
+    "grafana-clock-panel"             = "2.1.3"
+    "grafana-googlesheets-datasource" = "1.2.4"
+  }
+}
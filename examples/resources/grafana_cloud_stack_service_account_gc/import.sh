@@ -0,0 +1 @@
+terraform import grafana_cloud_stack_service_account_gc.cleanup {{stack_slug}}
@@ -0,0 +1,4 @@
+resource "grafana_cloud_stack_service_account_gc" "cleanup" {
+  stack_slug = "<your stack slug>"
+  min_age    = "2h"
+}
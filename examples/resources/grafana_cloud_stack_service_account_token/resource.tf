@@ -15,3 +15,28 @@ output "service_account_token_foo_key" {
   value     = grafana_cloud_stack_service_account_token.foo.key
   sensitive = true
 }
+
+// Write a token to Vault instead of the Terraform state
+resource "grafana_cloud_stack_service_account_token" "management" {
+  stack_slug         = "<your stack slug>"
+  name               = "management"
+  service_account_id = grafana_cloud_stack_service_account.cloud_sa.id
+  store_key          = false
+
+  vault_secret {
+    mount = "secret"
+    path  = "grafana/<your stack slug>"
+  }
+}
+
+// Then, in the configuration that manages the resources of the stack:
+//
+// data "vault_kv_secret_v2" "grafana" {
+//   mount = "secret"
+//   name  = "grafana/<your stack slug>"
+// }
+//
+// provider "grafana" {
+//   url  = "https://<your stack slug>.grafana.net"
+//   auth = data.vault_kv_secret_v2.grafana.data["token"]
+// }
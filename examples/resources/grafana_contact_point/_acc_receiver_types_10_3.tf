@@ -18,4 +18,33 @@ resource "grafana_contact_point" "receiver_types" {
       name = "Test team"
     }
   }
+
+  mqtt {
+    broker_url     = "tcp://mqtt-broker:1883"
+    topic          = "grafana/alerts"
+    client_id      = "grafana"
+    message_format = "text"
+    message        = "message"
+    username       = "username"
+    password       = "password"
+    qos            = 1
+    retain         = true
+    tls_config {
+      insecure_skip_verify = true
+      client_key           = "key"
+    }
+  }
+
+  webhook {
+    url   = "http://my-url"
+    title = "Custom title"
+    headers = {
+      "X-Team"    = "sre"
+      "X-Api-Key" = "secret"
+    }
+    payload_template = "{\"title\": \"{{ template \"default.title\" . }}\"}"
+    settings = {
+      payload = jsonencode({ vars = { environment = "production" } })
+    }
+  }
 }
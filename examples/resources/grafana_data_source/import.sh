@@ -1,5 +1,7 @@
 terraform import grafana_data_source.by_integer_id {{datasource_id}} # To use the default provider org
 terraform import grafana_data_source.by_uid {{datasource_uid}} # To use the default provider org
+terraform import grafana_data_source.by_name name/{{datasource_name}} # To use the default provider org
 
 terraform import grafana_data_source.by_integer_id {{org_id}}:{{datasource_id}} # When "org_id" is set on the resource
-terraform import grafana_data_source.by_uid {{org_id}}:{{datasource_uid}} # When "org_id" is set on the resource
\ No newline at end of file
+terraform import grafana_data_source.by_uid {{org_id}}:{{datasource_uid}} # When "org_id" is set on the resource
+terraform import grafana_data_source.by_name {{org_id}}:name/{{datasource_name}} # When "org_id" is set on the resource, or when the name contains a colon
\ No newline at end of file
@@ -61,3 +61,25 @@ resource "grafana_data_source" "prometheus" {
   })
 }
 
+resource "grafana_data_source" "cloudwatch-assume-role" {
+  type = "cloudwatch"
+  name = "cw-assume-role-example"
+
+  aws_auth {
+    auth_type       = "default"
+    default_region  = "us-east-1"
+    assume_role_arn = "arn:aws:iam::123456789012:role/grafana"
+    external_id     = "grafana-external-id"
+  }
+}
+
+resource "grafana_data_source" "azure-monitor" {
+  type = "grafana-azure-monitor-datasource"
+  name = "azure-monitor-example"
+
+  azure_auth {
+    auth_type = "workloadidentity"
+    tenant_id = "00000000-0000-0000-0000-000000000000"
+    client_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
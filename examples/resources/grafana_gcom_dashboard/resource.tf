@@ -0,0 +1,18 @@
+resource "grafana_data_source" "prometheus" {
+  type = "prometheus"
+  name = "prometheus"
+  url  = "http://prometheus:9090"
+}
+
+resource "grafana_folder" "infrastructure" {
+  title = "Infrastructure"
+}
+
+// Node Exporter Full, upgraded when new revisions are published on grafana.com
+resource "grafana_gcom_dashboard" "node_exporter" {
+  gcom_id = 1860
+  folder  = grafana_folder.infrastructure.uid
+  inputs = {
+    DS_PROMETHEUS = grafana_data_source.prometheus.uid
+  }
+}
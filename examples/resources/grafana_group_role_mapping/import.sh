@@ -0,0 +1,2 @@
+terraform import grafana_group_role_mapping.group_name {{group_id}} # To use the default provider org
+terraform import grafana_group_role_mapping.group_name {{org_id}}:{{group_id}} # When "org_id" is set on the resource
@@ -0,0 +1,30 @@
+resource "grafana_role" "datasource_reader" {
+  name    = "Platform data source reader"
+  uid     = "platform-datasource-reader"
+  version = 1
+  global  = true
+
+  permissions {
+    action = "datasources:read"
+    scope  = "datasources:*"
+  }
+}
+
+resource "grafana_role" "folder_creator" {
+  name    = "Platform folder creator"
+  uid     = "platform-folder-creator"
+  version = 1
+  global  = true
+
+  permissions {
+    action = "folders:create"
+  }
+}
+
+resource "grafana_group_role_mapping" "platform" {
+  group_id = "platform-engineers"
+  role_uids = [
+    grafana_role.datasource_reader.uid,
+    grafana_role.folder_creator.uid,
+  ]
+}
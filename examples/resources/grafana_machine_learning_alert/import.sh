@@ -0,0 +1,2 @@
+terraform import grafana_machine_learning_alert.job_alert job:{{job_id}}:{{alert_id}}
+terraform import grafana_machine_learning_alert.outlier_alert outlier:{{outlier_id}}:{{alert_id}}
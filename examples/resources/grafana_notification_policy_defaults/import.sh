@@ -0,0 +1,2 @@
+# The defaults are a singleton, so the ID is a constant "policy_defaults" value.
+terraform import grafana_notification_policy_defaults.notification_policy_defaults_name "policy_defaults"
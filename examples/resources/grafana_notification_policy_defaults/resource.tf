@@ -0,0 +1,12 @@
+resource "grafana_contact_point" "a_contact_point" {
+  name = "A Contact Point"
+
+  email {
+    addresses = ["one@company.org", "two@company.org"]
+  }
+}
+
+resource "grafana_notification_policy_defaults" "defaults" {
+  contact_point = grafana_contact_point.a_contact_point.name
+  group_by      = ["grafana_folder", "alertname", "team"]
+}
@@ -69,4 +69,16 @@ resource "grafana_oncall_on_call_shift" "emea_weekday_shift" {
 
 output "emea_weekday__rolling_users" {
   value = [for k in flatten(grafana_oncall_on_call_shift.emea_weekday_shift.rolling_users) : lookup(local.users_map_by_id, k).username]
-}
\ No newline at end of file
+}
+
+// A 24/7 rotation of the EMEA team, handing off every Monday at 09:00, Paris time.
+resource "grafana_oncall_on_call_shift" "emea_primary_rotation" {
+  name = "EMEA Primary Rotation"
+  rotation {
+    participants = local.teams_map_of_user_id.emea
+    handoff      = "weekly"
+    start_date   = "2022-02-28"
+    handoff_time = "09:00"
+    time_zone    = "Europe/Paris"
+  }
+}
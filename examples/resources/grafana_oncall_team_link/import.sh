@@ -0,0 +1 @@
+terraform import grafana_oncall_team_link.team_link_name {{grafana_team_id}}
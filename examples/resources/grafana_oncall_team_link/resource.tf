@@ -0,0 +1,13 @@
+// The provider needs both the url and auth attributes and the oncall_access_token attribute.
+resource "grafana_team" "sre" {
+  name = "SRE"
+}
+
+resource "grafana_oncall_team_link" "sre" {
+  team_id = grafana_team.sre.id
+}
+
+resource "grafana_oncall_escalation_chain" "sre" {
+  name    = "SRE"
+  team_id = grafana_oncall_team_link.sre.oncall_team_id
+}
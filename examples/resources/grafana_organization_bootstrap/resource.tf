@@ -0,0 +1,17 @@
+resource "grafana_organization_bootstrap" "team_a" {
+  name       = "Team A"
+  timezone   = "utc"
+  week_start = "monday"
+}
+
+output "team_a_token" {
+  value     = grafana_organization_bootstrap.team_a.token
+  sensitive = true
+}
+
+// Then, in the configuration that manages the resources of the organization:
+//
+// provider "grafana" {
+//   url  = "http://localhost:3000"
+//   auth = "<the team_a_token output>"
+// }
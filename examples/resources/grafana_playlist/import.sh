@@ -0,0 +1,5 @@
+terraform import grafana_playlist.by_id {{playlist_id}} # To use the default provider org
+terraform import grafana_playlist.by_name name/{{playlist_name}} # To use the default provider org
+
+terraform import grafana_playlist.by_id {{org_id}}:{{playlist_id}} # When "org_id" is set on the resource
+terraform import grafana_playlist.by_name {{org_id}}:name/{{playlist_name}} # When "org_id" is set on the resource, or when the name contains a colon
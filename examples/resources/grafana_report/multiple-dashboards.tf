@@ -35,6 +35,10 @@ resource "grafana_report" "test" {
   }
 
   dashboards {
-    uid = grafana_dashboard.test2.uid
+    uid              = grafana_dashboard.test2.uid
+    report_variables = {
+      query0 = "a, b"
+      query1 = "c"
+    }
   }
 }
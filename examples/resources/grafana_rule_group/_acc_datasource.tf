@@ -0,0 +1,45 @@
+resource "grafana_data_source" "prometheus" {
+  type = "prometheus"
+  name = "Rule Group Prometheus"
+  url  = "http://prometheus.example.com:9090"
+}
+
+resource "grafana_folder" "rule_folder" {
+  title = "My Data Source Rule Folder"
+}
+
+resource "grafana_rule_group" "my_datasource_rule" {
+  name             = "My Data Source Rule Group"
+  folder_uid       = grafana_folder.rule_folder.uid
+  interval_seconds = 60
+  rule {
+    name      = "My Data Source Rule"
+    for       = "2m"
+    condition = "C"
+    data {
+      datasource = grafana_data_source.prometheus.id
+      relative_time_range {
+        from = 600
+        to   = 0
+      }
+      model = jsonencode({
+        datasource = { type = "prometheus" }
+        expr       = "up"
+        refId      = "A"
+      })
+    }
+    data {
+      reduce {
+        input    = "A"
+        function = "last"
+      }
+    }
+    data {
+      threshold {
+        ref_id = "B"
+        type   = "lt"
+        value  = 1
+      }
+    }
+  }
+}
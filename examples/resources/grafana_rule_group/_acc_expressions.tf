@@ -0,0 +1,74 @@
+resource "grafana_folder" "rule_folder" {
+  title = "My Expressions Rule Folder"
+}
+
+resource "grafana_rule_group" "my_expressions_rule" {
+  name             = "My Expressions Rule Group"
+  folder_uid       = grafana_folder.rule_folder.uid
+  interval_seconds = 60
+  rule {
+    name      = "My Expressions Rule"
+    for       = "2m"
+    condition = "E"
+    data {
+      ref_id = "A"
+      relative_time_range {
+        from = 600
+        to   = 0
+      }
+      datasource_uid = "PD8C576611E62080A"
+      model = jsonencode({
+        refId = "A"
+      })
+    }
+    data {
+      ref_id = "B"
+      resample {
+        input       = "A"
+        window      = "1m"
+        downsampler = "mean"
+        upsampler   = "fillna"
+      }
+    }
+    data {
+      ref_id = "C"
+      reduce {
+        input    = "B"
+        function = "mean"
+        mode     = "dropNN"
+      }
+    }
+    data {
+      ref_id = "D"
+      math {
+        expression = "$C * 100"
+      }
+    }
+    data {
+      ref_id = "E"
+      threshold {
+        ref_id      = "D"
+        type        = "within_range"
+        value       = 10
+        upper_value = 90
+      }
+    }
+    data {
+      ref_id = "F"
+      classic_conditions {
+        condition {
+          query   = "A"
+          reducer = "last"
+          type    = "gt"
+          value   = 80
+        }
+        condition {
+          query    = "A"
+          reducer  = "avg"
+          type     = "no_value"
+          operator = "or"
+        }
+      }
+    }
+  }
+}
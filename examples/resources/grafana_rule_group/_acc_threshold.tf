@@ -0,0 +1,30 @@
+resource "grafana_folder" "rule_folder" {
+  title = "My Threshold Rule Folder"
+}
+
+resource "grafana_rule_group" "my_threshold_rule" {
+  name             = "My Threshold Rule Group"
+  folder_uid       = grafana_folder.rule_folder.uid
+  interval_seconds = 60
+  rule {
+    name = "My Threshold Rule"
+    for  = "2m"
+    data {
+      ref_id = "A"
+      relative_time_range {
+        from = 600
+        to   = 0
+      }
+      datasource_uid = "PD8C576611E62080A"
+      model = jsonencode({
+        refId   = "A"
+        instant = true
+      })
+    }
+    threshold {
+      ref_id = "A"
+      type   = "gt"
+      value  = 80
+    }
+  }
+}
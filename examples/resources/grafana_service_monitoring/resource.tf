@@ -0,0 +1,8 @@
+resource "grafana_service_monitoring" "checkout" {
+  service_name              = "checkout"
+  prometheus_datasource_uid = "grafanacloud-prom"
+  loki_datasource_uid       = "grafanacloud-logs"
+
+  slo_objective         = 0.999
+  error_ratio_threshold = 0.02
+}
@@ -0,0 +1,12 @@
+resource "grafana_synthetic_monitoring_check" "ping" {
+  job     = "Ping Probe Selector"
+  target  = "grafana.com"
+  enabled = false
+  probe_selector {
+    region  = "EMEA"
+    refresh = true
+  }
+  settings {
+    ping {}
+  }
+}
@@ -0,0 +1 @@
+terraform import grafana_synthetic_monitoring_tenant_settings.main {{tenant-id}}
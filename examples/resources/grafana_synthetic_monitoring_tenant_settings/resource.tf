@@ -0,0 +1,16 @@
+resource "grafana_synthetic_monitoring_tenant_settings" "main" {
+  thresholds {
+    uptime {
+      upper_limit = 99
+      lower_limit = 95
+    }
+    reachability {
+      upper_limit = 99
+      lower_limit = 90
+    }
+    latency {
+      upper_limit = 1000
+      lower_limit = 200
+    }
+  }
+}
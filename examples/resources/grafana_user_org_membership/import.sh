@@ -0,0 +1 @@
+terraform import grafana_user_org_membership.membership_name {{org_id}}:{{user_id}}
@@ -0,0 +1,21 @@
+resource "grafana_organization" "staff" {
+  name = "Staff"
+
+  # The users of the organization are managed by grafana_user_org_membership resources
+  lifecycle {
+    ignore_changes = [admins, editors, viewers, users_without_access]
+  }
+}
+
+resource "grafana_user" "staff" {
+  email    = "staff.name@example.com"
+  name     = "Staff Name"
+  login    = "staff"
+  password = "my-password"
+}
+
+resource "grafana_user_org_membership" "staff" {
+  org_id  = grafana_organization.staff.org_id
+  user_id = grafana_user.staff.id
+  role    = "Editor"
+}
@@ -71,3 +71,15 @@ func ListOfSetsToStringSlice(listSet []interface{}) [][]string {
 func Ref[T any](v T) *T {
 	return &v
 }
+
+func InterfaceMapToStringMap(src map[string]interface{}) map[string]string {
+	dst := make(map[string]string, len(src))
+	for k, v := range src {
+		val, ok := v.(string)
+		if !ok {
+			val = ""
+		}
+		dst[k] = val
+	}
+	return dst
+}
@@ -2,10 +2,19 @@ package common
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	gapi "github.com/grafana/grafana-api-golang-client"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
@@ -21,9 +30,20 @@ type Client struct {
 	GrafanaAPIURLParsed *url.URL
 	GrafanaAPIConfig    *goapi.TransportConfig
 	GrafanaCloudAPI     *gapi.Client
+	GrafanaCloudAPIKey  string
+	// GrafanaCloudAPIURL and GrafanaCloudHTTPClient are used for Cloud API calls that aren't supported by GrafanaCloudAPI.
+	GrafanaCloudAPIURL     string
+	GrafanaCloudHTTPClient *http.Client
 
 	GrafanaOAPI *goapi.GrafanaHTTPAPI
 
+	// GrafanaRequestSigner signs the requests made to Grafana, when it's behind an authenticating proxy. Nil if the requests aren't signed.
+	GrafanaRequestSigner *RequestSigner
+
+	// GrafanaHTTPTransport is the transport of the requests made to Grafana without the API clients.
+	// It's built once, with the TLS configuration, the retries and the request signing of the OpenAPI client, so that its connections are reused.
+	GrafanaHTTPTransport http.RoundTripper
+
 	SMAPI *SMAPI.Client
 
 	MLAPI *mlapi.Client
@@ -32,7 +52,89 @@ type Client struct {
 
 	SLOClient *slo.APIClient
 
+	// DefaultLabels are merged into the labels of every resource that supports them.
+	DefaultLabels map[string]string
+
+	// DashboardDeprecatedPanels is the behavior of dashboard resources using deprecated panel types: ignore, warn or fail.
+	DashboardDeprecatedPanels string
+
+	// SkipVersionCheck disables the requests made to Grafana at plan time to read its version and settings, and the other plan-time checks against Grafana.
+	SkipVersionCheck bool
+
+	// StrictSchema enables the warnings about dashboard and data source JSON unknown to the Grafana instance.
+	StrictSchema bool
+
+	// DuplicateRuleTitles is the behavior of rule group resources using alert rule titles that are used by other rule groups of the same folder in the configuration: warn or fail.
+	DuplicateRuleTitles string
+
+	// AlertingImages is the behavior of contact point resources when Grafana can't include images in notifications: ignore, warn or fail.
+	AlertingImages string
+
+	// AlertingNotifierURLCheck is the check of the URLs of the notifiers of contact point resources: none, syntax or reachability.
+	AlertingNotifierURLCheck string
+
+	// PlanAPICallsFile is the path of the file to which the planned resource changes, with the HTTP operations they would make, are appended at plan time.
+	PlanAPICallsFile string
+
 	alertingMutex sync.Mutex
+
+	ruleTitlesMutex sync.Mutex
+	ruleTitles      map[string]string
+
+	contactPointNamesMutex sync.Mutex
+	contactPointNames      map[string]string
+
+	planAPICallsMutex sync.Mutex
+
+	listCacheMutex sync.Mutex
+	listCache      map[string]*listCacheEntry
+
+	grafanaVersionOnce sync.Once
+	grafanaVersion     *semver.Version
+	grafanaVersionErr  error
+
+	alertingIntervalsOnce sync.Once
+	alertingIntervals     AlertingIntervals
+	alertingIntervalsErr  error
+
+	alertingImagesOnce    sync.Once
+	alertingImagesProblem string
+	alertingImagesErr     error
+}
+
+// Values of the provider's `dashboard_deprecated_panels` attribute.
+const (
+	DashboardDeprecatedPanelsIgnore = "ignore"
+	DashboardDeprecatedPanelsWarn   = "warn"
+	DashboardDeprecatedPanelsFail   = "fail"
+)
+
+// Values of the provider's `alerting_images` attribute.
+const (
+	AlertingImagesIgnore = "ignore"
+	AlertingImagesWarn   = "warn"
+	AlertingImagesFail   = "fail"
+)
+
+// Values of the provider's `alerting_notifier_url_check` attribute.
+const (
+	AlertingNotifierURLCheckNone         = "none"
+	AlertingNotifierURLCheckSyntax       = "syntax"
+	AlertingNotifierURLCheckReachability = "reachability"
+)
+
+// Values of the provider's `duplicate_rule_titles` attribute.
+const (
+	DuplicateRuleTitlesWarn = "warn"
+	DuplicateRuleTitlesFail = "fail"
+)
+
+// AlertingIntervals are the evaluation intervals configured in the `unified_alerting` section of the Grafana settings.
+type AlertingIntervals struct {
+	// Base is the interval of the alerting scheduler. Rule group intervals must be a multiple of it.
+	Base time.Duration
+	// Min is the minimum interval of rule groups.
+	Min time.Duration
 }
 
 // WithAlertingMutex is a helper function that wraps a CRUD Terraform function with a mutex.
@@ -45,7 +147,268 @@ func WithAlertingMutex[T schema.CreateContextFunc | schema.ReadContextFunc | sch
 	}
 }
 
+// ClaimRuleTitles records that the given alert rule titles are used by a rule group in a folder of an organization.
+// It returns the titles that were already claimed by another rule group of the folder during the lifetime of the client (a single plan or apply),
+// mapped to that rule group. Grafana only requires the titles to be unique within a folder.
+func (c *Client) ClaimRuleTitles(orgID, folderUID, group string, titles []string) map[string]string {
+	c.ruleTitlesMutex.Lock()
+	defer c.ruleTitlesMutex.Unlock()
+	if c.ruleTitles == nil {
+		c.ruleTitles = map[string]string{}
+	}
+
+	claimed := map[string]string{}
+	for _, title := range titles {
+		key := c.claimOrgKey(orgID) + "/" + folderUID + "/" + title
+		if other, ok := c.ruleTitles[key]; ok && other != group {
+			claimed[title] = other
+			continue
+		}
+		c.ruleTitles[key] = group
+	}
+	return claimed
+}
+
+// ClaimContactPointName records that a contact point name of an organization is used by a contact point resource, identified by its configuration.
+// It returns false if the name was already claimed by a resource with another configuration during the lifetime of the client (a single plan or apply).
+func (c *Client) ClaimContactPointName(orgID, name, resource string) bool {
+	c.contactPointNamesMutex.Lock()
+	defer c.contactPointNamesMutex.Unlock()
+	if c.contactPointNames == nil {
+		c.contactPointNames = map[string]string{}
+	}
+
+	key := c.claimOrgKey(orgID) + "/" + name
+	if other, ok := c.contactPointNames[key]; ok && other != resource {
+		return false
+	}
+	c.contactPointNames[key] = resource
+	return true
+}
+
+// claimOrgKey returns the organization of the claims of a resource from its `org_id` attribute,
+// which is empty for the organization of the provider.
+func (c *Client) claimOrgKey(orgID string) string {
+	if id, err := strconv.ParseInt(orgID, 10, 64); err == nil && id > 0 {
+		return strconv.FormatInt(id, 10)
+	}
+	if c.GrafanaAPIConfig != nil && c.GrafanaAPIConfig.OrgID > 0 {
+		return strconv.FormatInt(c.GrafanaAPIConfig.OrgID, 10)
+	}
+	return "1"
+}
+
+// RecordPlannedResourceChange appends a planned resource change to the `PlanAPICallsFile` file, as a line of JSON.
+func (c *Client) RecordPlannedResourceChange(change interface{}) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	c.planAPICallsMutex.Lock()
+	defer c.planAPICallsMutex.Unlock()
+	f, err := os.OpenFile(c.PlanAPICallsFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open the plan API calls file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write to the plan API calls file: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) GrafanaSubpath(path string) string {
 	path = strings.TrimPrefix(path, c.GrafanaAPIURLParsed.Path)
 	return c.GrafanaAPIURLParsed.JoinPath(path).String()
 }
+
+var errVersionCheckSkipped = errors.New("the version check is disabled by the provider's `skip_version_check` attribute")
+
+// GrafanaVersion returns the version of the Grafana instance, as reported by its health endpoint.
+// The version is fetched on first use and cached for the lifetime of the client.
+func (c *Client) GrafanaVersion() (*semver.Version, error) {
+	if c.SkipVersionCheck {
+		return nil, errVersionCheckSkipped
+	}
+	c.grafanaVersionOnce.Do(func() {
+		c.grafanaVersion, c.grafanaVersionErr = c.fetchGrafanaVersion()
+	})
+	return c.grafanaVersion, c.grafanaVersionErr
+}
+
+// GrafanaAlertingIntervals returns the alerting evaluation intervals of the Grafana instance.
+// They are read from the admin settings, which requires the server admin role, on first use and cached for the lifetime of the client.
+func (c *Client) GrafanaAlertingIntervals() (AlertingIntervals, error) {
+	if c.SkipVersionCheck {
+		return AlertingIntervals{}, errVersionCheckSkipped
+	}
+	c.alertingIntervalsOnce.Do(func() {
+		c.alertingIntervals, c.alertingIntervalsErr = c.fetchGrafanaAlertingIntervals()
+	})
+	return c.alertingIntervals, c.alertingIntervalsErr
+}
+
+func (c *Client) fetchGrafanaAlertingIntervals() (AlertingIntervals, error) {
+	// Grafana's defaults
+	intervals := AlertingIntervals{Base: 10 * time.Second, Min: 10 * time.Second}
+	if c.GrafanaOAPI == nil {
+		return intervals, fmt.Errorf("the Grafana client is not configured")
+	}
+
+	resp, err := c.GrafanaOAPI.Admin.AdminGetSettings()
+	if err != nil {
+		return intervals, fmt.Errorf("failed to read the Grafana settings: %w", err)
+	}
+
+	settings := resp.Payload["unified_alerting"]
+	for key, target := range map[string]*time.Duration{"base_interval": &intervals.Base, "min_interval": &intervals.Min} {
+		value := settings[key]
+		if value == "" {
+			continue
+		}
+		if *target, err = time.ParseDuration(value); err != nil {
+			return intervals, fmt.Errorf("failed to parse the unified_alerting.%s setting %q: %w", key, value, err)
+		}
+	}
+	return intervals, nil
+}
+
+// GrafanaAlertingImagesProblem returns why Grafana can't include images of the alerts in notifications, or an empty string if it can.
+// Images require screenshots to be enabled, and an image renderer: the image renderer plugin or a remote rendering service.
+// It is checked on first use, which requires the server admin role, and cached for the lifetime of the client.
+func (c *Client) GrafanaAlertingImagesProblem() (string, error) {
+	if c.SkipVersionCheck {
+		return "", errVersionCheckSkipped
+	}
+	c.alertingImagesOnce.Do(func() {
+		c.alertingImagesProblem, c.alertingImagesErr = c.fetchGrafanaAlertingImagesProblem()
+	})
+	return c.alertingImagesProblem, c.alertingImagesErr
+}
+
+func (c *Client) fetchGrafanaAlertingImagesProblem() (string, error) {
+	if c.GrafanaOAPI == nil || c.GrafanaAPIConfig == nil {
+		return "", fmt.Errorf("the Grafana client is not configured")
+	}
+
+	resp, err := c.GrafanaOAPI.Admin.AdminGetSettings()
+	if err != nil {
+		return "", fmt.Errorf("failed to read the Grafana settings: %w", err)
+	}
+	if resp.Payload["unified_alerting.screenshots"]["capture"] != "true" {
+		return "screenshots are disabled: set `capture = true` in the `[unified_alerting.screenshots]` section of the Grafana configuration", nil
+	}
+	if resp.Payload["rendering"]["server_url"] != "" {
+		return "", nil
+	}
+
+	req, err := c.NewGrafanaRequest(context.Background(), http.MethodGet, "api/plugins/grafana-image-renderer/settings", nil)
+	if err != nil {
+		return "", err
+	}
+	httpClient := &http.Client{
+		Transport: c.GrafanaTransport(),
+		Timeout:   10 * time.Second,
+	}
+	pluginResp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to check whether the image renderer plugin is installed: %w", err)
+	}
+	defer pluginResp.Body.Close()
+	switch pluginResp.StatusCode {
+	case http.StatusOK:
+		return "", nil
+	case http.StatusNotFound:
+		return "no image renderer is available: install the grafana-image-renderer plugin, or set `server_url` in the `[rendering]` section of the Grafana configuration", nil
+	}
+	return "", fmt.Errorf("failed to check whether the image renderer plugin is installed: status %d", pluginResp.StatusCode)
+}
+
+// GrafanaTransport returns the transport of the requests made to Grafana without the API clients, e.g. to endpoints they don't support.
+// It falls back to the signed default transport when the client isn't configured by the provider, e.g. in tests.
+func (c *Client) GrafanaTransport() http.RoundTripper {
+	if c.GrafanaHTTPTransport != nil {
+		return c.GrafanaHTTPTransport
+	}
+	return c.GrafanaRequestSigner.Wrap(http.DefaultTransport)
+}
+
+// NewGrafanaRequest creates a request to Grafana without the API clients, with the HTTP headers and the credentials of the provider.
+// It must be sent with GrafanaTransport.
+func (c *Client) NewGrafanaRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	cfg := c.GrafanaAPIConfig
+	if c.GrafanaAPIURLParsed == nil || cfg == nil {
+		return nil, fmt.Errorf("the Grafana client is not configured")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.GrafanaSubpath(path), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range cfg.HTTPHeaders {
+		req.Header.Set(k, v)
+	}
+	if cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+	} else if cfg.BasicAuth != nil {
+		password, _ := cfg.BasicAuth.Password()
+		req.SetBasicAuth(cfg.BasicAuth.Username(), password)
+	}
+	return req, nil
+}
+
+// GrafanaOAPIWithOrgID returns a copy of the OpenAPI client scoped to the given organization, or to no organization if orgID is 0.
+// Its requests are signed like the ones of the client: use it rather than the WithOrgID method of the client, which creates a new transport.
+func (c *Client) GrafanaOAPIWithOrgID(orgID int64) *goapi.GrafanaHTTPAPI {
+	client := c.GrafanaOAPI.Clone().WithOrgID(orgID)
+	c.GrafanaRequestSigner.WrapOAPI(client)
+	return client
+}
+
+func (c *Client) fetchGrafanaVersion() (*semver.Version, error) {
+	if c.GrafanaAPIURLParsed == nil || c.GrafanaAPIConfig == nil {
+		return nil, fmt.Errorf("the Grafana client is not configured")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.GrafanaSubpath("api/health"), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.GrafanaAPIConfig.HTTPHeaders {
+		req.Header.Set(k, v)
+	}
+	httpClient := &http.Client{
+		Transport: c.GrafanaTransport(),
+		Timeout:   10 * time.Second,
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the Grafana health endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the Grafana health endpoint returned status %d", resp.StatusCode)
+	}
+
+	var health struct {
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return nil, fmt.Errorf("failed to decode the Grafana health response: %w", err)
+	}
+	if health.Version == "" {
+		return nil, fmt.Errorf("the Grafana health endpoint does not expose the version")
+	}
+
+	version, err := semver.NewVersion(health.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Grafana version %q: %w", health.Version, err)
+	}
+	// Grafana Cloud versions have a build suffix (ex: 10.4.0-67270) which would be compared as a pre-release
+	withoutSuffix, err := version.SetPrerelease("")
+	if err != nil {
+		return nil, err
+	}
+	return &withoutSuffix, nil
+}
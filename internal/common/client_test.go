@@ -0,0 +1,148 @@
+package common_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestGrafanaVersion(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	for _, tc := range []struct {
+		name        string
+		response    string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "oss", response: `{"database":"ok","version":"10.1.5"}`, expected: "10.1.5"},
+		{name: "cloud build suffix", response: `{"database":"ok","version":"10.4.0-67270"}`, expected: "10.4.0"},
+		{name: "hidden version", response: `{"database":"ok"}`, expectedErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/grafana/api/health" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				calls++
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			parsedURL, _ := url.Parse(server.URL + "/grafana")
+			client := &common.Client{
+				GrafanaAPIURL:       server.URL + "/grafana",
+				GrafanaAPIURLParsed: parsedURL,
+				GrafanaAPIConfig:    &goapi.TransportConfig{},
+			}
+
+			for i := 0; i < 2; i++ {
+				version, err := client.GrafanaVersion()
+				if tc.expectedErr {
+					if err == nil {
+						t.Fatalf("expected an error, got version %s", version)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if version.String() != tc.expected {
+					t.Errorf("expected version %s, got %s", tc.expected, version)
+				}
+			}
+			if calls != 1 {
+				t.Errorf("expected the version to be fetched once, got %d calls", calls)
+			}
+		})
+	}
+}
+
+func TestGrafanaVersion_skipped(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s, the version check is skipped", r.URL.Path)
+	}))
+	defer server.Close()
+
+	parsedURL, _ := url.Parse(server.URL)
+	client := &common.Client{
+		GrafanaAPIURL:       server.URL,
+		GrafanaAPIURLParsed: parsedURL,
+		GrafanaAPIConfig:    &goapi.TransportConfig{},
+		SkipVersionCheck:    true,
+	}
+
+	if _, err := client.GrafanaVersion(); err == nil {
+		t.Error("expected an error when the version check is skipped")
+	}
+	if _, err := client.GrafanaAlertingIntervals(); err == nil {
+		t.Error("expected an error when the version check is skipped")
+	}
+}
+
+func TestClaimRuleTitles(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	client := &common.Client{}
+	if claimed := client.ClaimRuleTitles("1", "folder-a", "group-a", []string{"cpu", "memory"}); len(claimed) != 0 {
+		t.Errorf("expected no claimed titles, got %v", claimed)
+	}
+	// Planning the same group again doesn't conflict with itself
+	if claimed := client.ClaimRuleTitles("1", "folder-a", "group-a", []string{"cpu", "memory"}); len(claimed) != 0 {
+		t.Errorf("expected no claimed titles, got %v", claimed)
+	}
+	// Other organizations and folders have their own titles
+	if claimed := client.ClaimRuleTitles("2", "folder-a", "group-b", []string{"cpu"}); len(claimed) != 0 {
+		t.Errorf("expected no claimed titles, got %v", claimed)
+	}
+	if claimed := client.ClaimRuleTitles("1", "folder-b", "group-b", []string{"cpu"}); len(claimed) != 0 {
+		t.Errorf("expected no claimed titles, got %v", claimed)
+	}
+
+	claimed := client.ClaimRuleTitles("1", "folder-a", "group-b", []string{"cpu", "disk"})
+	if len(claimed) != 1 || claimed["cpu"] != "group-a" {
+		t.Errorf("expected cpu to be claimed by group-a, got %v", claimed)
+	}
+	// An empty org ID is the organization of the provider
+	claimed = client.ClaimRuleTitles("", "folder-a", "group-c", []string{"memory"})
+	if len(claimed) != 1 || claimed["memory"] != "group-a" {
+		t.Errorf("expected memory to be claimed by group-a, got %v", claimed)
+	}
+}
+
+func TestClaimContactPointName(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	client := &common.Client{}
+	if !client.ClaimContactPointName("1", "on-call", "config-a") {
+		t.Error("expected the name to be claimed")
+	}
+	// Planning the same resource again doesn't conflict with itself
+	if !client.ClaimContactPointName("1", "on-call", "config-a") {
+		t.Error("expected the name to be claimed again by the same resource")
+	}
+	// Other organizations have their own names
+	if !client.ClaimContactPointName("2", "on-call", "config-b") {
+		t.Error("expected the name to be claimed in another organization")
+	}
+	if client.ClaimContactPointName("1", "on-call", "config-b") {
+		t.Error("expected the name to be already claimed")
+	}
+	// An empty org ID is the organization of the provider
+	if client.ClaimContactPointName("", "on-call", "config-c") {
+		t.Error("expected the name to be already claimed in the organization of the provider")
+	}
+	client = &common.Client{GrafanaAPIConfig: &goapi.TransportConfig{OrgID: 2}}
+	client.ClaimContactPointName("2", "on-call", "config-a")
+	if client.ClaimContactPointName("", "on-call", "config-b") {
+		t.Error("expected the name to be already claimed in the organization of the provider")
+	}
+}
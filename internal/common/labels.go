@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AppliedDefaultLabelsAttribute is the name of the attribute recording the provider's default labels applied to a resource.
+const AppliedDefaultLabelsAttribute = "applied_default_labels"
+
+// AppliedDefaultLabelsSchema is the schema of the attribute recording the provider's default labels applied to a resource.
+func AppliedDefaultLabelsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Description: "The default labels of the provider applied to the resource by the last apply. " +
+			"Adding, changing or removing default labels in the provider changes this attribute, which updates the resource.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// CustomizeDiffDefaultLabels plans an update of a resource when the provider's default labels differ from the ones applied to it.
+// The default labels are removed from the labels read from the API, so a change of the default labels doesn't show up in the labels themselves.
+func CustomizeDiffDefaultLabels(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*Client)
+	if !ok {
+		return nil
+	}
+	applied := InterfaceMapToStringMap(d.Get(AppliedDefaultLabelsAttribute).(map[string]interface{}))
+	if maps.Equal(applied, client.DefaultLabels) {
+		return nil
+	}
+	return d.SetNew(AppliedDefaultLabelsAttribute, client.DefaultLabels)
+}
+
+// SetAppliedDefaultLabels records the provider's default labels as applied to a resource. It must be called once the resource is created or updated.
+// The attribute is left unset while there are no default labels, so that the state of resources imported without default labels matches.
+func (c *Client) SetAppliedDefaultLabels(d *schema.ResourceData) {
+	if len(c.DefaultLabels) == 0 && len(d.Get(AppliedDefaultLabelsAttribute).(map[string]interface{})) == 0 {
+		return
+	}
+	d.Set(AppliedDefaultLabelsAttribute, c.DefaultLabels)
+}
+
+// WithDefaultLabels returns the given labels merged with the labels set in the provider's `default_labels` attribute.
+// Labels set on the resource take precedence over the default labels.
+func (c *Client) WithDefaultLabels(labels map[string]string) map[string]string {
+	if len(c.DefaultLabels) == 0 {
+		return labels
+	}
+
+	merged := make(map[string]string, len(c.DefaultLabels)+len(labels))
+	for k, v := range c.DefaultLabels {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// WithoutDefaultLabels removes the provider's default labels from labels read from the API, so that they don't show up as a diff
+// on resources that don't set them. Labels that are also present in the prior state of the resource are kept.
+func (c *Client) WithoutDefaultLabels(labels map[string]string, prior map[string]string) map[string]string {
+	if len(c.DefaultLabels) == 0 {
+		return labels
+	}
+
+	result := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if defaultValue, ok := c.DefaultLabels[k]; ok && defaultValue == v {
+			if _, inPrior := prior[k]; !inPrior {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// listCacheEntry is a memoized list response, stored as JSON so that each caller decodes its own copy and may modify it.
+type listCacheEntry struct {
+	mutex sync.Mutex
+	data  []byte
+}
+
+// MemoizeList returns the result of the given list call, which is only made once per key during the lifetime of the client (a single plan or apply),
+// so that resources reading a single item from the same list (e.g. each contact point of an organization) don't each fetch the whole list.
+// Concurrent calls with the same key wait for the first one. Errors aren't memoized.
+// The key must identify the list and its organization, and be invalidated with InvalidateList when the list is modified.
+func MemoizeList[T any](c *Client, key string, fetch func() (T, error)) (T, error) {
+	c.listCacheMutex.Lock()
+	if c.listCache == nil {
+		c.listCache = map[string]*listCacheEntry{}
+	}
+	entry, ok := c.listCache[key]
+	if !ok {
+		entry = &listCacheEntry{}
+		c.listCache[key] = entry
+	}
+	c.listCacheMutex.Unlock()
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	var result T
+	if entry.data == nil {
+		fetched, err := fetch()
+		if err != nil {
+			return result, err
+		}
+		data, err := json.Marshal(fetched)
+		if err != nil {
+			return result, err
+		}
+		entry.data = data
+	}
+	err := json.Unmarshal(entry.data, &result)
+	return result, err
+}
+
+// InvalidateList discards the memoized result of the list with the given key, so that the next MemoizeList call fetches it again.
+func (c *Client) InvalidateList(key string) {
+	c.listCacheMutex.Lock()
+	defer c.listCacheMutex.Unlock()
+	delete(c.listCache, key)
+}
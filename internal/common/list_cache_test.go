@@ -0,0 +1,76 @@
+package common_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestMemoizeList(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	client := &common.Client{}
+	calls := 0
+	fetch := func() ([]map[string]string, error) {
+		calls++
+		return []map[string]string{{"name": "a"}}, nil
+	}
+
+	// Concurrent calls share the same fetch
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := common.MemoizeList(client, "list:1", fetch); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+
+	// Each caller gets its own copy
+	first, _ := common.MemoizeList(client, "list:1", fetch)
+	first[0]["name"] = "modified"
+	second, _ := common.MemoizeList(client, "list:1", fetch)
+	if second[0]["name"] != "a" {
+		t.Fatalf("expected the memoized list to be unchanged, got %v", second)
+	}
+
+	// Other keys are fetched separately
+	if _, err := common.MemoizeList(client, "list:2", fetch); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+
+	// Invalidated lists are fetched again
+	client.InvalidateList("list:1")
+	if _, err := common.MemoizeList(client, "list:1", fetch); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+
+	// Errors aren't memoized
+	failing := func() ([]map[string]string, error) {
+		calls++
+		return nil, errors.New("failed")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := common.MemoizeList(client, "list:3", failing); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if calls != 5 {
+		t.Fatalf("expected 5 calls, got %d", calls)
+	}
+}
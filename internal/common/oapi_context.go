@@ -0,0 +1,56 @@
+package common
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+)
+
+// OAPIWithContext makes all the calls of the given Grafana OpenAPI client use the given context,
+// so that they are aborted when Terraform cancels the operation (e.g. on SIGINT) or when the context deadline is exceeded.
+// The client is modified in place, it must be a clone of the provider's client.
+func OAPIWithContext(ctx context.Context, client *goapi.GrafanaHTTPAPI) *goapi.GrafanaHTTPAPI {
+	if ctx == nil {
+		return client
+	}
+	client.SetTransport(&contextTransport{ctx: ctx, next: client.Transport})
+	return client
+}
+
+// contextTransport sets the context of the operations that don't have one.
+// The retrying transport of the client sleeps between attempts regardless of the context,
+// so calls are abandoned as soon as the context is done rather than waiting for the retries to be exhausted.
+type contextTransport struct {
+	ctx  context.Context //nolint:containedctx
+	next runtime.ClientTransport
+}
+
+type submitResult struct {
+	result interface{}
+	err    error
+}
+
+func (t *contextTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	ctx := op.Context
+	if ctx == nil {
+		ctx = t.ctx
+		op.Context = ctx
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	done := make(chan submitResult, 1)
+	go func() {
+		result, err := t.next.Submit(op)
+		done <- submitResult{result, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.result, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,54 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestOAPIWithContext(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Simulate an unresponsive instance
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	serverURL, _ := url.Parse(server.URL)
+	client := goapi.NewHTTPClientWithConfig(strfmt.Default, &goapi.TransportConfig{
+		Host:       serverURL.Host,
+		BasePath:   "/api",
+		Schemes:    []string{"http"},
+		NumRetries: 5,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := common.OAPIWithContext(ctx, client.Clone()).Folders.GetFolderByUID("test")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the call to be aborted promptly, took %s", elapsed)
+	}
+
+	// Calls with an already cancelled context aren't sent
+	_, err = common.OAPIWithContext(ctx, client.Clone()).Folders.GetFolderByUID("test")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got %v", err)
+	}
+}
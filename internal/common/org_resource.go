@@ -0,0 +1,34 @@
+package common
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+)
+
+// SplitOrgResourceID splits into two parts (org ID and resource ID) the ID of an org-scoped resource
+func SplitOrgResourceID(id string) (int64, string) {
+	if strings.ContainsRune(id, ':') {
+		parts := strings.SplitN(id, ":", 2)
+		orgID, _ := strconv.ParseInt(parts[0], 10, 64)
+		return orgID, parts[1]
+	}
+
+	return 0, id
+}
+
+// OAPIClientFromExistingOrgResource creates a client from the ID of an org-scoped resource
+// Those IDs are in the <orgID>:<resourceID> format
+// The client's calls are bound to the given context.
+func OAPIClientFromExistingOrgResource(ctx context.Context, meta interface{}, id string) (*goapi.GrafanaHTTPAPI, int64, string) {
+	orgID, restOfID := SplitOrgResourceID(id)
+	client := meta.(*Client).GrafanaOAPI.Clone()
+	if orgID == 0 {
+		orgID = client.OrgID()
+	} else if orgID > 0 {
+		client = meta.(*Client).GrafanaOAPIWithOrgID(orgID)
+	}
+	return OAPIWithContext(ctx, client), orgID, restOfID
+}
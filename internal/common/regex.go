@@ -7,4 +7,6 @@ var (
 	UIDRegexp    = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
 	EmailRegexp  = regexp.MustCompile(`.+\@.+\..+`)
 	SHA256Regexp = regexp.MustCompile(`^[A-Fa-f0-9]{64}$`)
+	// PrometheusDurationRegexp matches the durations of the Alertmanager configuration, ex: `1d12h` or `30s`
+	PrometheusDurationRegexp = regexp.MustCompile(`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`)
 )
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	httptransport "github.com/go-openapi/runtime/client"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+)
+
+// sigV4Expires is how long the signature of a request is valid, to allow for the retries of the request.
+const sigV4Expires = 5 * time.Minute
+
+// RequestSigner signs the requests made to Grafana, so that Grafana instances behind an authenticating proxy can be reached:
+// AWS services authenticating requests with SigV4 (e.g. API Gateway or VPC Lattice), or proxies authenticating OIDC tokens (e.g. GCP IAP).
+// AWS ALB authentication isn't supported: ALB only authenticates its own session cookies, which are set by an interactive login.
+type RequestSigner struct {
+	// AWSRegion and AWSService enable SigV4 signing with the given AWS credentials if set.
+	// The credentials are retrieved on each request, so that they're refreshed by the provider when they expire.
+	AWSRegion      string
+	AWSService     string
+	AWSCredentials aws.CredentialsProvider
+
+	// OIDCToken is sent in the OIDCHeader header as a bearer token if set.
+	// If OIDCTokenFile is set, the token is read from the file on each request instead, so that it can be refreshed while the provider runs.
+	OIDCToken     string
+	OIDCTokenFile string
+	OIDCHeader    string
+}
+
+// Wrap returns a transport signing the requests sent to the given transport. The transport is returned as is if the signer is nil.
+func (s *RequestSigner) Wrap(rt http.RoundTripper) http.RoundTripper {
+	if s == nil {
+		return rt
+	}
+	return &signingTransport{signer: s, next: rt}
+}
+
+// WrapOAPI signs the requests of an OpenAPI client. Nothing is done if the signer is nil.
+func (s *RequestSigner) WrapOAPI(client *goapi.GrafanaHTTPAPI) {
+	if rt, ok := client.Transport.(*httptransport.Runtime); ok {
+		rt.Transport = s.Wrap(rt.Transport)
+	}
+}
+
+type signingTransport struct {
+	signer *RequestSigner
+	next   http.RoundTripper
+}
+
+func (t *signingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it's given
+	req = req.Clone(req.Context())
+	if err := t.signer.sign(req); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+func (s *RequestSigner) sign(req *http.Request) error {
+	if s.OIDCToken != "" || s.OIDCTokenFile != "" {
+		token := s.OIDCToken
+		if s.OIDCTokenFile != "" {
+			content, err := os.ReadFile(s.OIDCTokenFile)
+			if err != nil {
+				return fmt.Errorf("failed to read the OIDC token of the proxy: %w", err)
+			}
+			token = strings.TrimSpace(string(content))
+		}
+		req.Header.Set(s.OIDCHeader, "Bearer "+token)
+	}
+	if s.AWSRegion != "" {
+		return s.signSigV4(req)
+	}
+	return nil
+}
+
+// signSigV4 signs a request with AWS Signature Version 4. The signature is set in the query string (like presigned URLs),
+// so that the Authorization header is left to the authentication to Grafana. Only the host is signed, since the headers
+// of the request may still be changed by the transports it's sent to.
+func (s *RequestSigner) signSigV4(req *http.Request) error {
+	payloadHash, err := hashRequestBody(req)
+	if err != nil {
+		return err
+	}
+	credentials, err := s.AWSCredentials.Retrieve(req.Context())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the AWS credentials to sign the request: %w", err)
+	}
+
+	unsignedURL := *req.URL
+	unsigned := &http.Request{Method: req.Method, URL: &unsignedURL, Host: req.Host, Header: http.Header{}}
+	query := unsigned.URL.Query()
+	query.Set("X-Amz-Expires", strconv.Itoa(int(sigV4Expires.Seconds())))
+	unsigned.URL.RawQuery = query.Encode()
+
+	signedURL, _, err := v4.NewSigner().PresignHTTP(req.Context(), credentials, unsigned, payloadHash, s.AWSService, s.AWSRegion, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to sign the request: %w", err)
+	}
+	req.URL, err = url.Parse(signedURL)
+	return err
+}
+
+// hashRequestBody returns the hex-encoded SHA-256 hash of the body of a request, and restores the body so that it can be sent.
+func hashRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		hash := sha256.Sum256(nil)
+		return hex.EncodeToString(hash[:]), nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read the body of the request to sign it: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), nil
+}
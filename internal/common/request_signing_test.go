@@ -0,0 +1,118 @@
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestRequestSigner(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	var received *http.Request
+	var receivedBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received, receivedBody = r, string(body)
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// The credentials are rotated on each request, like expiring credentials refreshed by the AWS SDK
+	var retrievals int
+	signer := &common.RequestSigner{
+		AWSRegion:  "eu-west-1",
+		AWSService: "execute-api",
+		AWSCredentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			retrievals++
+			return aws.Credentials{
+				AccessKeyID:     fmt.Sprintf("AKIDEXAMPLE%d", retrievals),
+				SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+				SessionToken:    "session token",
+			}, nil
+		}),
+		OIDCTokenFile: tokenFile,
+		OIDCHeader:    "Proxy-Authorization",
+	}
+	client := &http.Client{Transport: signer.Wrap(http.DefaultTransport)}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/folders?b=2&a=1", strings.NewReader(`{"title":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer grafana-token")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got := received.Header.Get("Authorization"); got != "Bearer grafana-token" {
+		t.Errorf("expected the Authorization header to be kept, got %q", got)
+	}
+	if got := received.Header.Get("Proxy-Authorization"); got != "Bearer first-token" {
+		t.Errorf("expected the OIDC token in the Proxy-Authorization header, got %q", got)
+	}
+	if receivedBody != `{"title":"test"}` {
+		t.Errorf("expected the body to be sent, got %q", receivedBody)
+	}
+	query := received.URL.Query()
+	for name, expected := range map[string]*regexp.Regexp{
+		"a":                    regexp.MustCompile(`^1$`),
+		"b":                    regexp.MustCompile(`^2$`),
+		"X-Amz-Algorithm":      regexp.MustCompile(`^AWS4-HMAC-SHA256$`),
+		"X-Amz-Credential":     regexp.MustCompile(`^AKIDEXAMPLE1/\d{8}/eu-west-1/execute-api/aws4_request$`),
+		"X-Amz-Date":           regexp.MustCompile(`^\d{8}T\d{6}Z$`),
+		"X-Amz-Expires":        regexp.MustCompile(`^300$`),
+		"X-Amz-SignedHeaders":  regexp.MustCompile(`^host$`),
+		"X-Amz-Security-Token": regexp.MustCompile(`^session token$`),
+		"X-Amz-Signature":      regexp.MustCompile(`^[0-9a-f]{64}$`),
+	} {
+		if got := query.Get(name); !expected.MatchString(got) {
+			t.Errorf("expected query parameter %s to match %s, got %q", name, expected, got)
+		}
+	}
+	// The canonical query string is sent, followed by the signature
+	if expected := regexp.MustCompile(`^X-Amz-Algorithm=.*&X-Amz-SignedHeaders=host&a=1&b=2&X-Amz-Signature=[0-9a-f]{64}$`); !expected.MatchString(received.URL.RawQuery) {
+		t.Errorf("expected the query string to match %s, got %q", expected, received.URL.RawQuery)
+	}
+
+	// The token file is read again on each request
+	if err := os.WriteFile(tokenFile, []byte("second-token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = client.Get(server.URL + "/api/health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got := received.Header.Get("Proxy-Authorization"); got != "Bearer second-token" {
+		t.Errorf("expected the refreshed OIDC token, got %q", got)
+	}
+	if got := received.URL.Query().Get("X-Amz-Credential"); !strings.HasPrefix(got, "AKIDEXAMPLE2/") {
+		t.Errorf("expected the refreshed AWS credentials, got %q", got)
+	}
+}
+
+func TestRequestSignerNil(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	var signer *common.RequestSigner
+	if rt := signer.Wrap(http.DefaultTransport); rt != http.DefaultTransport {
+		t.Errorf("expected a nil signer to return the transport as is")
+	}
+}
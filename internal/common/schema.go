@@ -74,3 +74,15 @@ func ValidateDurationWithDays(i interface{}, p cty.Path) diag.Diagnostics {
 	}
 	return nil
 }
+
+// ValidatePrometheusDuration validates durations in the Prometheus format, used by the Alertmanager configuration (ex: `1w`, `1d12h`).
+func ValidatePrometheusDuration(i interface{}, p cty.Path) diag.Diagnostics {
+	v := i.(string)
+	if v == "" || v == "0" {
+		return nil
+	}
+	if !PrometheusDurationRegexp.MatchString(v) {
+		return diag.Errorf("%q is not a valid duration, expected a duration such as `30s`, `5m` or `1d12h`", v)
+	}
+	return nil
+}
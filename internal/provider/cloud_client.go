@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudMaintenanceErrorMessage starts the errors returned when the Grafana Cloud API still responds that the stack is in maintenance at the end of the grace period.
+const cloudMaintenanceErrorMessage = "the Grafana Cloud stack is in maintenance"
+
+// cloudMaintenanceMaxBackoff is the maximum time to wait between two requests to the Grafana Cloud API during a maintenance.
+const cloudMaintenanceMaxBackoff = 30 * time.Second
+
+// cloudAPITransport wraps the HTTP transport of the Grafana Cloud API client.
+//   - Rate limited requests (429) are retried with an exponential backoff, honoring the Retry-After header.
+//   - Requests made while the stack is in maintenance (503, or 409 mentioning a maintenance) are retried with a jittered exponential backoff
+//     until the maintenance grace period is over. The grace period is shared by all requests, so that the retries of the API client don't extend it.
+//   - Listing calls on paginated APIs (the cursors of /api/v1, and the page numbers of the stack listings) are followed until the last page,
+//     so that the API client receives all items in a single response.
+type cloudAPITransport struct {
+	next                   http.RoundTripper
+	pageSize               int64
+	maintenanceGracePeriod time.Duration
+
+	mu sync.Mutex
+	// maintenanceDeadline is the end of the grace period of the ongoing maintenance, zero if the stack isn't in maintenance.
+	maintenanceDeadline time.Time
+}
+
+// cloudAPIPage is the format of the responses of paginated Grafana Cloud API endpoints.
+// The /api/v1 endpoints are paginated with cursors, and the older endpoints with page numbers.
+type cloudAPIPage struct {
+	Items    []json.RawMessage `json:"items"`
+	Metadata struct {
+		Pagination struct {
+			NextPage string `json:"nextPage"`
+		} `json:"pagination"`
+	} `json:"metadata"`
+	Page  int `json:"page"`
+	Pages int `json:"pages"`
+}
+
+// cloudAPINumberedPagesRegexp matches the paths of the listings of stacks, which are paginated with page numbers.
+var cloudAPINumberedPagesRegexp = regexp.MustCompile(`^/api/(orgs/[^/]+/)?instances$`)
+
+func newCloudAPIHTTPClient(retries, pageSize int64, maintenanceGracePeriod time.Duration) *http.Client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = int(retries)
+	retryClient.RetryWaitMin = time.Second
+	retryClient.RetryWaitMax = time.Minute
+	// Other status codes are retried by the Cloud API client, according to the `retry_status_codes` provider attribute. See cloudAPIRetryStatusCodes.
+	retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return resp != nil && resp.StatusCode == http.StatusTooManyRequests, nil
+	}
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	return &http.Client{
+		Transport: &cloudAPITransport{
+			next:                   &retryablehttp.RoundTripper{Client: retryClient},
+			pageSize:               pageSize,
+			maintenanceGracePeriod: maintenanceGracePeriod,
+		},
+	}
+}
+
+// cloudAPIRetryStatusCodes returns the status codes retried by the Cloud API client: the ones of the `retry_status_codes` provider attribute,
+// except 429, which the transport already retries with a backoff honoring Retry-After. Rate limited requests would be retried twice otherwise.
+func cloudAPIRetryStatusCodes(codes []string) []string {
+	var retried []string
+	for _, code := range codes {
+		if code != strconv.Itoa(http.StatusTooManyRequests) {
+			retried = append(retried, code)
+		}
+	}
+	return retried
+}
+
+func (t *cloudAPITransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet && cloudAPINumberedPagesRegexp.MatchString(req.URL.Path) {
+		return t.roundTripNumberedPages(t.withPageSize(req))
+	}
+	if req.Method != http.MethodGet || !strings.HasPrefix(req.URL.Path, "/api/v1/") {
+		return t.roundTrip(req)
+	}
+
+	resp, err := t.roundTrip(t.withPageSize(req))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	page, body, err := readCloudAPIPage(resp)
+	if err != nil || page == nil || page.Metadata.Pagination.NextPage == "" {
+		// Not a paginated response, return it as is
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, err
+	}
+
+	items := page.Items
+	for nextPage := page.Metadata.Pagination.NextPage; nextPage != ""; {
+		nextURL, err := req.URL.Parse(nextPage)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next page URL %q: %w", nextPage, err)
+		}
+		nextReq := req.Clone(req.Context())
+		nextReq.URL = nextURL
+		nextReq.Host = ""
+
+		nextPageContent, nextResp, err := t.roundTripPage(nextReq)
+		if nextPageContent == nil {
+			return nextResp, err
+		}
+		items = append(items, nextPageContent.Items...)
+		nextPage = nextPageContent.Metadata.Pagination.NextPage
+	}
+	return setCloudAPIItems(resp, items)
+}
+
+// roundTripNumberedPages sends a listing request of an endpoint paginated with page numbers, and the requests of its next pages.
+func (t *cloudAPITransport) roundTripNumberedPages(req *http.Request) (*http.Response, error) {
+	resp, err := t.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	page, body, err := readCloudAPIPage(resp)
+	if err != nil || page == nil || page.Page == 0 || page.Page >= page.Pages {
+		// Not a paginated response, or a single page, return it as is
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, err
+	}
+
+	items := page.Items
+	for next := page.Page + 1; next <= page.Pages; next++ {
+		nextReq := req.Clone(req.Context())
+		query := nextReq.URL.Query()
+		query.Set("page", strconv.Itoa(next))
+		nextReq.URL.RawQuery = query.Encode()
+
+		nextPageContent, nextResp, err := t.roundTripPage(nextReq)
+		if nextPageContent == nil {
+			return nextResp, err
+		}
+		items = append(items, nextPageContent.Items...)
+	}
+	return setCloudAPIItems(resp, items)
+}
+
+// roundTripPage sends the request of the next page of a listing. If the page can't be read, it returns the response or the error to return to the API client instead.
+func (t *cloudAPITransport) roundTripPage(req *http.Request) (*cloudAPIPage, *http.Response, error) {
+	resp, err := t.roundTrip(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp, nil
+	}
+	page, _, err := readCloudAPIPage(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if page == nil {
+		return nil, nil, fmt.Errorf("unexpected response format for page %s", req.URL)
+	}
+	return page, nil, nil
+}
+
+// withPageSize sets the page size of a listing request, if it's configured and not set by the API client.
+func (t *cloudAPITransport) withPageSize(req *http.Request) *http.Request {
+	if t.pageSize > 0 && req.URL.Query().Get("pageSize") == "" {
+		query := req.URL.Query()
+		query.Set("pageSize", strconv.FormatInt(t.pageSize, 10))
+		req = req.Clone(req.Context())
+		req.URL.RawQuery = query.Encode()
+	}
+	return req
+}
+
+// setCloudAPIItems replaces the body of the response of the first page of a listing with the items of all its pages.
+func setCloudAPIItems(resp *http.Response, items []json.RawMessage) (*http.Response, error) {
+	merged, err := json.Marshal(map[string]interface{}{"items": items})
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(merged))
+	resp.ContentLength = int64(len(merged))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(merged)))
+	return resp, nil
+}
+
+// roundTrip sends a request, and sends it again while the Grafana Cloud API responds that the stack is in maintenance, until the grace period is over.
+func (t *cloudAPITransport) roundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+		body, inMaintenance, err := readCloudMaintenanceResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !inMaintenance {
+			t.endMaintenance()
+			return resp, nil
+		}
+
+		wait := cloudMaintenanceBackoff(attempt, resp.Header.Get("Retry-After"))
+		deadline := t.startMaintenance()
+		if time.Now().Add(wait).After(deadline) || (req.Body != nil && req.GetBody == nil) {
+			return nil, fmt.Errorf("%s (status: %d, grace period: %s), body: %s", cloudMaintenanceErrorMessage, resp.StatusCode, t.maintenanceGracePeriod, body)
+		}
+		log.Printf("[WARN] the Grafana Cloud stack is in maintenance (status: %d), retrying %s %s in %s", resp.StatusCode, req.Method, req.URL.Path, wait)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		if req.GetBody != nil {
+			retryReq := req.Clone(req.Context())
+			if retryReq.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+			req = retryReq
+		}
+	}
+}
+
+// startMaintenance returns the end of the grace period of the ongoing maintenance, which starts now if there's none.
+func (t *cloudAPITransport) startMaintenance() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.maintenanceDeadline.IsZero() {
+		t.maintenanceDeadline = time.Now().Add(t.maintenanceGracePeriod)
+	}
+	return t.maintenanceDeadline
+}
+
+func (t *cloudAPITransport) endMaintenance() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.maintenanceDeadline = time.Time{}
+}
+
+// readCloudMaintenanceResponse tells whether a response of the Grafana Cloud API means that the stack is in maintenance: 503, or 409 mentioning a maintenance.
+// The body of these responses is read and returned, and put back in the response if it isn't a maintenance response.
+func readCloudMaintenanceResponse(resp *http.Response) ([]byte, bool, error) {
+	if resp.StatusCode != http.StatusServiceUnavailable && resp.StatusCode != http.StatusConflict {
+		return nil, false, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close() //nolint:errcheck
+	if err != nil {
+		return nil, false, err
+	}
+	if resp.StatusCode == http.StatusConflict && !strings.Contains(strings.ToLower(string(body)), "maintenance") {
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return body, false, nil
+	}
+	return body, true, nil
+}
+
+// cloudMaintenanceBackoff returns the time to wait before the next attempt of a request made during a maintenance:
+// an exponential backoff with jitter, up to cloudMaintenanceMaxBackoff, or the Retry-After header of the response if it's longer.
+func cloudMaintenanceBackoff(attempt int, retryAfter string) time.Duration {
+	backoff := cloudMaintenanceMaxBackoff
+	if attempt < 5 {
+		backoff = time.Second << attempt
+	}
+	wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)) //nolint:gosec
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && time.Duration(seconds)*time.Second > wait {
+		wait = time.Duration(seconds) * time.Second
+	}
+	return wait
+}
+
+// addCloudMaintenanceDiagnostics replaces the errors of the resources saying that the Grafana Cloud stack is in maintenance with a dedicated diagnostic.
+func addCloudMaintenanceDiagnostics(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			diags := f(ctx, d, m)
+			for i, diagnostic := range diags {
+				if diagnostic.Severity == diag.Error && strings.Contains(diagnostic.Summary, cloudMaintenanceErrorMessage) {
+					diags[i] = diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "The Grafana Cloud stack is in maintenance",
+						Detail: diagnostic.Summary + "\n\nThe requests were retried during the grace period set by the `maintenance_grace_period` provider attribute. " +
+							"Run the operation again once the maintenance is over, or increase the grace period.",
+					}
+				}
+			}
+			return diags
+		}
+	}
+
+	for _, r := range resources {
+		r.CreateContext = wrap(r.CreateContext)
+		r.ReadContext = wrap(r.ReadContext)
+		r.UpdateContext = wrap(r.UpdateContext)
+		r.DeleteContext = wrap(r.DeleteContext)
+	}
+	return resources
+}
+
+// readCloudAPIPage reads and closes the response body. The returned page is nil if the body isn't a list of items.
+func readCloudAPIPage(resp *http.Response) (*cloudAPIPage, []byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close() //nolint:errcheck
+	if err != nil {
+		return nil, body, err
+	}
+
+	var page cloudAPIPage
+	if err := json.Unmarshal(body, &page); err != nil || page.Items == nil {
+		return nil, body, nil
+	}
+	return &page, body, nil
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCloudAPIHTTPClient(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		if got := r.URL.Query().Get("pageSize"); got != "2" {
+			t.Errorf("expected pageSize=2, got %q", got)
+		}
+		nextPage := ""
+		items := []string{"a", "b"}
+		if r.URL.Query().Get("pageCursor") == "" {
+			nextPage = "/api/v1/accesspolicies?pageCursor=next&pageSize=2&region=us"
+		} else {
+			items = []string{"c"}
+		}
+		_, _ = w.Write([]byte(encodeItems(t, items, nextPage)))
+	}))
+	defer server.Close()
+
+	client := newCloudAPIHTTPClient(3, 2, 0)
+	resp, err := client.Get(server.URL + "/api/v1/accesspolicies?region=us")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"items":[{"name":"a"},{"name":"b"},{"name":"c"}]}`; string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests (one rate limited, two pages), got %d", requests)
+	}
+}
+
+func TestCloudAPIHTTPClient_numberedPages(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/api/orgs/my-org/instances" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("pageSize"); got != "2" {
+			t.Errorf("expected pageSize=2, got %q", got)
+		}
+		page := cloudAPIPage{Page: 1, Pages: 2}
+		names := []string{"a", "b"}
+		if r.URL.Query().Get("page") == "2" {
+			page.Page, names = 2, []string{"c"}
+		}
+		for _, name := range names {
+			page.Items = append(page.Items, json.RawMessage(fmt.Sprintf(`{"name":%q}`, name)))
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	resp, err := newCloudAPIHTTPClient(3, 2, 0).Get(server.URL + "/api/orgs/my-org/instances")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"items":[{"name":"a"},{"name":"b"},{"name":"c"}]}`; string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestCloudAPIRetryStatusCodes(t *testing.T) {
+	if got := cloudAPIRetryStatusCodes([]string{"429", "5xx", "401"}); strings.Join(got, ",") != "5xx,401" {
+		t.Errorf("expected 5xx,401, got %v", got)
+	}
+	if got := cloudAPIRetryStatusCodes([]string{"429"}); len(got) != 0 {
+		t.Errorf("expected no status codes, got %v", got)
+	}
+}
+
+func TestCloudAPIHTTPClient_maintenance(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"stack"}` {
+			t.Errorf("expected the request body to be sent again, got %q", body)
+		}
+		switch r.URL.Path {
+		case "/api/instances/in-maintenance":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message":"stack under maintenance"}`))
+		case "/api/instances/conflict":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"slug already exists"}`))
+		default:
+			if requests == 1 {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"message":"instance is in maintenance"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	post := func(client *http.Client, path string) (*http.Response, error) {
+		return client.Post(server.URL+path, "application/json", strings.NewReader(`{"name":"stack"}`))
+	}
+
+	// The request is sent again once the maintenance is over
+	resp, err := post(newCloudAPIHTTPClient(3, 0, time.Minute), "/api/instances")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || requests != 2 {
+		t.Errorf("expected a successful response after 2 requests, got status %d after %d requests", resp.StatusCode, requests)
+	}
+
+	// Other conflicts are returned as is
+	requests = 0
+	resp, err = post(newCloudAPIHTTPClient(3, 0, time.Minute), "/api/instances/conflict")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict || string(body) != `{"message":"slug already exists"}` || requests != 1 {
+		t.Errorf("expected the conflict to be returned after 1 request, got status %d with body %s after %d requests", resp.StatusCode, body, requests)
+	}
+
+	// The maintenance error is returned at the end of the grace period
+	requests = 0
+	_, err = post(newCloudAPIHTTPClient(3, 0, 0), "/api/instances/in-maintenance")
+	if err == nil || !strings.Contains(err.Error(), cloudMaintenanceErrorMessage) || !strings.Contains(err.Error(), "stack under maintenance") {
+		t.Errorf("expected a maintenance error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request without grace period, got %d", requests)
+	}
+}
+
+func encodeItems(t *testing.T, names []string, nextPage string) string {
+	t.Helper()
+
+	page := cloudAPIPage{}
+	for _, name := range names {
+		page.Items = append(page.Items, json.RawMessage(fmt.Sprintf(`{"name":%q}`, name)))
+	}
+	page.Metadata.Pagination.NextPage = nextPage
+	j, err := json.Marshal(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(j)
+}
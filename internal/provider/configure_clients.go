@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -11,9 +12,11 @@ import (
 	"strings"
 	"time"
 
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	gapi "github.com/grafana/grafana-api-golang-client"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/pkg/transport"
 	"github.com/grafana/machine-learning-go-client/mlapi"
 	slo "github.com/grafana/slo-openapi-client/go"
 	SMAPI "github.com/grafana/synthetic-monitoring-api-go-client"
@@ -41,10 +44,13 @@ func createClients(providerConfig frameworkProviderConfig) (*common.Client, erro
 		}
 	}
 	if !providerConfig.CloudAPIKey.IsNull() {
-		c.GrafanaCloudAPI, err = createCloudClient(providerConfig)
+		c.GrafanaCloudHTTPClient = newCloudAPIHTTPClient(providerConfig.Retries.ValueInt64(), providerConfig.CloudAPIPageSize.ValueInt64(), time.Second*time.Duration(providerConfig.MaintenanceGracePeriod.ValueInt64()))
+		c.GrafanaCloudAPI, err = createCloudClient(providerConfig, c.GrafanaCloudHTTPClient)
 		if err != nil {
 			return nil, err
 		}
+		c.GrafanaCloudAPIKey = providerConfig.CloudAPIKey.ValueString()
+		c.GrafanaCloudAPIURL = providerConfig.CloudAPIURL.ValueString()
 	}
 	if !providerConfig.SMAccessToken.IsNull() {
 		c.SMAPI = SMAPI.NewClient(providerConfig.SMURL.ValueString(), providerConfig.SMAccessToken.ValueString(), getRetryClient(providerConfig))
@@ -60,6 +66,17 @@ func createClients(providerConfig frameworkProviderConfig) (*common.Client, erro
 	}
 
 	grafana.StoreDashboardSHA256 = providerConfig.StoreDashboardSha256.ValueBool()
+	c.DashboardDeprecatedPanels = providerConfig.DashboardDeprecatedPanels.ValueString()
+	c.SkipVersionCheck = providerConfig.SkipVersionCheck.ValueBool()
+	c.StrictSchema = providerConfig.StrictSchema.ValueBool()
+	c.DuplicateRuleTitles = providerConfig.DuplicateRuleTitles.ValueString()
+	c.AlertingImages = providerConfig.AlertingImages.ValueString()
+	c.AlertingNotifierURLCheck = providerConfig.AlertingNotifierURLCheck.ValueString()
+	c.PlanAPICallsFile = providerConfig.PlanAPICallsFile.ValueString()
+
+	if c.DefaultLabels, err = getDefaultLabelsMap(providerConfig); err != nil {
+		return nil, err
+	}
 
 	return c, nil
 }
@@ -70,8 +87,23 @@ func createGrafanaOAPIClient(client *common.Client, providerConfig frameworkProv
 		return err
 	}
 
+	if client.GrafanaRequestSigner, err = createRequestSigner(providerConfig); err != nil {
+		return err
+	}
+
+	httpHeaders, err := getHTTPHeadersMap(providerConfig)
+	if err != nil {
+		return err
+	}
+
 	client.GrafanaAPIURL = providerConfig.URL.ValueString()
-	client.GrafanaAPIURLParsed, err = url.Parse(providerConfig.URL.ValueString())
+	if !providerConfig.FailoverURLs.IsNull() && !providerConfig.SkipVersionCheck.ValueBool() {
+		urls := append([]string{client.GrafanaAPIURL}, setToStringArray(providerConfig.FailoverURLs.Elements())...)
+		if client.GrafanaAPIURL, err = selectGrafanaURL(urls, tlsClientConfig, httpHeaders, client.GrafanaRequestSigner); err != nil {
+			return err
+		}
+	}
+	client.GrafanaAPIURLParsed, err = url.Parse(client.GrafanaAPIURL)
 	if err != nil {
 		return fmt.Errorf("failed to parse API url: %v", err.Error())
 	}
@@ -96,22 +128,35 @@ func createGrafanaOAPIClient(client *common.Client, providerConfig frameworkProv
 		BasicAuth:        userInfo,
 		OrgID:            orgID,
 		APIKey:           apiKey,
-	}
-
-	if cfg.HTTPHeaders, err = getHTTPHeadersMap(providerConfig); err != nil {
-		return err
+		HTTPHeaders:      httpHeaders,
 	}
 	client.GrafanaOAPI = goapi.NewHTTPClientWithConfig(strfmt.Default, &cfg)
 	client.GrafanaAPIConfig = &cfg
+	client.GrafanaRequestSigner.WrapOAPI(client.GrafanaOAPI)
+	client.GrafanaHTTPTransport = newGrafanaHTTPTransport(client, &cfg)
 
 	return nil
 }
 
+// newGrafanaHTTPTransport returns the transport of the requests made to Grafana without the API clients.
+// Like the transport of the OpenAPI client, it retries the requests and then signs them.
+func newGrafanaHTTPTransport(client *common.Client, cfg *goapi.TransportConfig) http.RoundTripper {
+	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+	httpTransport.TLSClientConfig = cfg.TLSConfig
+	return client.GrafanaRequestSigner.Wrap(&transport.RetryableTransport{
+		Transport:        httpTransport,
+		NumRetries:       cfg.NumRetries,
+		RetryTimeout:     cfg.RetryTimeout,
+		RetryStatusCodes: cfg.RetryStatusCodes,
+		HTTPHeaders:      cfg.HTTPHeaders,
+	})
+}
+
 func createMLClient(client *common.Client, providerConfig frameworkProviderConfig) error {
 	mlcfg := mlapi.Config{
 		BasicAuth:   client.GrafanaAPIConfig.BasicAuth,
 		BearerToken: client.GrafanaAPIConfig.APIKey,
-		Client:      getRetryClient(providerConfig),
+		Client:      getSignedRetryClient(client, providerConfig),
 		NumRetries:  client.GrafanaAPIConfig.NumRetries,
 	}
 	mlURL := client.GrafanaAPIURL
@@ -129,16 +174,22 @@ func createSLOClient(client *common.Client, providerConfig frameworkProviderConf
 	sloConfig.Host = client.GrafanaAPIURLParsed.Host
 	sloConfig.Scheme = client.GrafanaAPIURLParsed.Scheme
 	sloConfig.DefaultHeader["Authorization"] = "Bearer " + providerConfig.Auth.ValueString()
-	sloConfig.HTTPClient = getRetryClient(providerConfig)
+	sloConfig.HTTPClient = getSignedRetryClient(client, providerConfig)
 	client.SLOClient = slo.NewAPIClient(sloConfig)
 	return nil
 }
 
-func createCloudClient(providerConfig frameworkProviderConfig) (*gapi.Client, error) {
+func createCloudClient(providerConfig frameworkProviderConfig, httpClient *http.Client) (*gapi.Client, error) {
 	cfg := gapi.Config{
-		APIKey:       providerConfig.CloudAPIKey.ValueString(),
-		NumRetries:   int(providerConfig.Retries.ValueInt64()),
-		RetryTimeout: time.Second * time.Duration(providerConfig.RetryWait.ValueInt64()),
+		APIKey:           providerConfig.CloudAPIKey.ValueString(),
+		NumRetries:       int(providerConfig.Retries.ValueInt64()),
+		RetryTimeout:     time.Second * time.Duration(providerConfig.RetryWait.ValueInt64()),
+		RetryStatusCodes: cloudAPIRetryStatusCodes(setToStringArray(providerConfig.RetryStatusCodes.Elements())),
+		Client:           httpClient,
+	}
+	if len(cfg.RetryStatusCodes) == 0 {
+		// The API client retries 429 and 5xx when no status codes are set
+		cfg.NumRetries = 0
 	}
 
 	var err error
@@ -168,6 +219,19 @@ func getHTTPHeadersMap(providerConfig frameworkProviderConfig) (map[string]strin
 	return headers, nil
 }
 
+func getDefaultLabelsMap(providerConfig frameworkProviderConfig) (map[string]string, error) {
+	labels := map[string]string{}
+	for k, v := range providerConfig.DefaultLabels.Elements() {
+		if vString, ok := v.(types.String); ok {
+			labels[k] = vString.ValueString()
+		} else {
+			return nil, fmt.Errorf("invalid default label value for %s: %v", k, v)
+		}
+	}
+
+	return labels, nil
+}
+
 func createTempFileIfLiteral(value string) (path string, tempFile bool, err error) {
 	if value == "" {
 		return "", false, nil
@@ -191,6 +255,34 @@ func createTempFileIfLiteral(value string) (path string, tempFile bool, err erro
 	return value, false, nil
 }
 
+// createRequestSigner returns the signer of the requests to Grafana, when it's behind an authenticating proxy, or nil.
+func createRequestSigner(providerConfig frameworkProviderConfig) (*common.RequestSigner, error) {
+	region := providerConfig.AWSSigV4Region.ValueString()
+	token := providerConfig.ProxyOIDCToken.ValueString()
+	if region == "" && token == "" {
+		return nil, nil
+	}
+
+	signer := &common.RequestSigner{OIDCHeader: providerConfig.ProxyOIDCHeader.ValueString()}
+	if _, err := os.Stat(token); token != "" && err == nil {
+		signer.OIDCTokenFile = token
+	} else {
+		signer.OIDCToken = token
+	}
+	if region != "" {
+		// The credentials are resolved by the default chain of the AWS SDK: environment variables, shared configuration files,
+		// web identity tokens (e.g. IRSA), and ECS or EC2 instance roles. They're retrieved on the first request.
+		awsConfig, err := awsconfig.LoadDefaultConfig(context.Background(), awsconfig.WithRegion(region))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the AWS configuration to sign the requests to Grafana: %w", err)
+		}
+		signer.AWSRegion = region
+		signer.AWSService = providerConfig.AWSSigV4Service.ValueString()
+		signer.AWSCredentials = awsConfig.Credentials
+	}
+	return signer, nil
+}
+
 func parseAuth(providerConfig frameworkProviderConfig) (*url.Userinfo, int64, string, error) {
 	auth := strings.SplitN(providerConfig.Auth.ValueString(), ":", 2)
 	var orgID int64 = 1
@@ -275,3 +367,10 @@ func getRetryClient(providerConfig frameworkProviderConfig) *http.Client {
 	}
 	return retryClient.StandardClient()
 }
+
+// getSignedRetryClient returns a retry client whose requests to Grafana are signed by the request signer of the client.
+func getSignedRetryClient(client *common.Client, providerConfig frameworkProviderConfig) *http.Client {
+	httpClient := getRetryClient(providerConfig)
+	httpClient.Transport = client.GrafanaRequestSigner.Wrap(httpClient.Transport)
+	return httpClient
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+func TestNewGrafanaHTTPTransport(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("X-Custom") != "value" {
+			t.Errorf("expected the HTTP headers of the provider to be sent, got %v", r.Header)
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &common.Client{}
+	client.GrafanaHTTPTransport = newGrafanaHTTPTransport(client, &goapi.TransportConfig{
+		NumRetries:       1,
+		RetryTimeout:     1,
+		RetryStatusCodes: []string{"503"},
+		HTTPHeaders:      map[string]string{"X-Custom": "value"},
+	})
+	if client.GrafanaTransport() != client.GrafanaHTTPTransport {
+		t.Fatal("expected the transport built by the provider to be reused")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&http.Client{Transport: client.GrafanaTransport()}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 2 {
+		t.Errorf("expected the 503 to be retried, got status %d after %d calls", resp.StatusCode, calls)
+	}
+}
@@ -14,10 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
 )
 
 type frameworkProviderConfig struct {
 	URL              types.String `tfsdk:"url"`
+	FailoverURLs     types.List   `tfsdk:"failover_urls"`
 	Auth             types.String `tfsdk:"auth"`
 	HTTPHeaders      types.Map    `tfsdk:"http_headers"`
 	Retries          types.Int64  `tfsdk:"retries"`
@@ -30,11 +33,28 @@ type frameworkProviderConfig struct {
 	CACert             types.String `tfsdk:"ca_cert"`
 	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 
+	AWSSigV4Region  types.String `tfsdk:"aws_sigv4_region"`
+	AWSSigV4Service types.String `tfsdk:"aws_sigv4_service"`
+	ProxyOIDCToken  types.String `tfsdk:"proxy_oidc_token"`
+	ProxyOIDCHeader types.String `tfsdk:"proxy_oidc_header"`
+
 	StoreDashboardSha256 types.Bool `tfsdk:"store_dashboard_sha256"`
+	DefaultLabels        types.Map  `tfsdk:"default_labels"`
+
+	DashboardDeprecatedPanels types.String `tfsdk:"dashboard_deprecated_panels"`
+	SkipVersionCheck          types.Bool   `tfsdk:"skip_version_check"`
+	StrictSchema              types.Bool   `tfsdk:"strict_schema"`
+	DuplicateRuleTitles       types.String `tfsdk:"duplicate_rule_titles"`
+	AlertingImages            types.String `tfsdk:"alerting_images"`
+	AlertingNotifierURLCheck  types.String `tfsdk:"alerting_notifier_url_check"`
+	PlanAPICallsFile          types.String `tfsdk:"plan_api_calls_file"`
 
 	CloudAPIKey types.String `tfsdk:"cloud_api_key"`
 	CloudAPIURL types.String `tfsdk:"cloud_api_url"`
 
+	CloudAPIPageSize       types.Int64 `tfsdk:"cloud_api_page_size"`
+	MaintenanceGracePeriod types.Int64 `tfsdk:"maintenance_grace_period"`
+
 	SMAccessToken types.String `tfsdk:"sm_access_token"`
 	SMURL         types.String `tfsdk:"sm_url"`
 
@@ -52,6 +72,10 @@ func (c *frameworkProviderConfig) SetDefaults() error {
 	c.TLSKey = envDefaultFuncString(c.TLSKey, "GRAFANA_TLS_KEY")
 	c.TLSCert = envDefaultFuncString(c.TLSCert, "GRAFANA_TLS_CERT")
 	c.CACert = envDefaultFuncString(c.CACert, "GRAFANA_CA_CERT")
+	c.AWSSigV4Region = envDefaultFuncString(c.AWSSigV4Region, "GRAFANA_AWS_SIGV4_REGION")
+	c.AWSSigV4Service = envDefaultFuncString(c.AWSSigV4Service, "GRAFANA_AWS_SIGV4_SERVICE", "execute-api")
+	c.ProxyOIDCToken = envDefaultFuncString(c.ProxyOIDCToken, "GRAFANA_PROXY_OIDC_TOKEN")
+	c.ProxyOIDCHeader = envDefaultFuncString(c.ProxyOIDCHeader, "GRAFANA_PROXY_OIDC_HEADER", "Proxy-Authorization")
 	c.CloudAPIKey = envDefaultFuncString(c.CloudAPIKey, "GRAFANA_CLOUD_API_KEY")
 	c.CloudAPIURL = envDefaultFuncString(c.CloudAPIURL, "GRAFANA_CLOUD_API_URL", "https://grafana.com")
 	c.SMAccessToken = envDefaultFuncString(c.SMAccessToken, "GRAFANA_SM_ACCESS_TOKEN")
@@ -64,12 +88,52 @@ func (c *frameworkProviderConfig) SetDefaults() error {
 	if c.StoreDashboardSha256, err = envDefaultFuncBool(c.StoreDashboardSha256, "GRAFANA_STORE_DASHBOARD_SHA256", false); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_STORE_DASHBOARD_SHA256: %w", err)
 	}
+	c.DashboardDeprecatedPanels = envDefaultFuncString(c.DashboardDeprecatedPanels, "GRAFANA_DASHBOARD_DEPRECATED_PANELS", common.DashboardDeprecatedPanelsIgnore)
+	switch v := c.DashboardDeprecatedPanels.ValueString(); v {
+	case common.DashboardDeprecatedPanelsIgnore, common.DashboardDeprecatedPanelsWarn, common.DashboardDeprecatedPanelsFail:
+	default:
+		return fmt.Errorf("invalid dashboard_deprecated_panels value %q, must be one of: ignore, warn, fail", v)
+	}
+	c.DuplicateRuleTitles = envDefaultFuncString(c.DuplicateRuleTitles, "GRAFANA_DUPLICATE_RULE_TITLES", common.DuplicateRuleTitlesWarn)
+	switch v := c.DuplicateRuleTitles.ValueString(); v {
+	case common.DuplicateRuleTitlesWarn, common.DuplicateRuleTitlesFail:
+	default:
+		return fmt.Errorf("invalid duplicate_rule_titles value %q, must be one of: warn, fail", v)
+	}
+	c.AlertingImages = envDefaultFuncString(c.AlertingImages, "GRAFANA_ALERTING_IMAGES", common.AlertingImagesIgnore)
+	switch v := c.AlertingImages.ValueString(); v {
+	case common.AlertingImagesIgnore, common.AlertingImagesWarn, common.AlertingImagesFail:
+	default:
+		return fmt.Errorf("invalid alerting_images value %q, must be one of: ignore, warn, fail", v)
+	}
+	c.AlertingNotifierURLCheck = envDefaultFuncString(c.AlertingNotifierURLCheck, "GRAFANA_ALERTING_NOTIFIER_URL_CHECK", common.AlertingNotifierURLCheckNone)
+	switch v := c.AlertingNotifierURLCheck.ValueString(); v {
+	case common.AlertingNotifierURLCheckNone, common.AlertingNotifierURLCheckSyntax, common.AlertingNotifierURLCheckReachability:
+	default:
+		return fmt.Errorf("invalid alerting_notifier_url_check value %q, must be one of: none, syntax, reachability", v)
+	}
+	c.PlanAPICallsFile = envDefaultFuncString(c.PlanAPICallsFile, "GRAFANA_PLAN_API_CALLS_FILE")
 	if c.Retries, err = envDefaultFuncInt64(c.Retries, "GRAFANA_RETRIES", 3); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_RETRIES: %w", err)
 	}
 	if c.RetryWait, err = envDefaultFuncInt64(c.RetryWait, "GRAFANA_RETRY_WAIT", 0); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_RETRY_WAIT: %w", err)
 	}
+	if c.CloudAPIPageSize, err = envDefaultFuncInt64(c.CloudAPIPageSize, "GRAFANA_CLOUD_API_PAGE_SIZE", 0); err != nil {
+		return fmt.Errorf("failed to parse GRAFANA_CLOUD_API_PAGE_SIZE: %w", err)
+	}
+	if c.MaintenanceGracePeriod, err = envDefaultFuncInt64(c.MaintenanceGracePeriod, "GRAFANA_MAINTENANCE_GRACE_PERIOD", 300); err != nil {
+		return fmt.Errorf("failed to parse GRAFANA_MAINTENANCE_GRACE_PERIOD: %w", err)
+	}
+	if v := c.MaintenanceGracePeriod.ValueInt64(); v < 0 {
+		return fmt.Errorf("invalid maintenance_grace_period value %d, must be at least 0", v)
+	}
+	if c.SkipVersionCheck, err = envDefaultFuncBool(c.SkipVersionCheck, "GRAFANA_SKIP_VERSION_CHECK", false); err != nil {
+		return fmt.Errorf("failed to parse GRAFANA_SKIP_VERSION_CHECK: %w", err)
+	}
+	if c.StrictSchema, err = envDefaultFuncBool(c.StrictSchema, "GRAFANA_STRICT_SCHEMA", false); err != nil {
+		return fmt.Errorf("failed to parse GRAFANA_STRICT_SCHEMA: %w", err)
+	}
 	if c.InsecureSkipVerify, err = envDefaultFuncBool(c.InsecureSkipVerify, "GRAFANA_INSECURE_SKIP_VERIFY", false); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_INSECURE_SKIP_VERIFY: %w", err)
 	}
@@ -86,6 +150,14 @@ func (c *frameworkProviderConfig) SetDefaults() error {
 		c.HTTPHeaders = types.MapValueMust(types.StringType, headersValue)
 	}
 
+	if envValue := os.Getenv("GRAFANA_FAILOVER_URLS"); c.FailoverURLs.IsNull() && envValue != "" {
+		failoverURLs := []attr.Value{}
+		for _, u := range strings.Split(envValue, ",") {
+			failoverURLs = append(failoverURLs, types.StringValue(strings.TrimSpace(u)))
+		}
+		c.FailoverURLs = types.ListValueMust(types.StringType, failoverURLs)
+	}
+
 	if envValue := os.Getenv("GRAFANA_RETRY_STATUS_CODES"); c.RetryStatusCodes.IsNull() && envValue != "" {
 		retryStatusCodes := []attr.Value{}
 		for _, code := range strings.Split(envValue, ",") {
@@ -103,6 +175,62 @@ func (c *frameworkProviderConfig) SetDefaults() error {
 	return nil
 }
 
+const cloudAPIPageSizeDescription = "The page size used when listing Grafana Cloud resources (e.g. access policies, tokens and stacks). All pages are always fetched. " +
+	"Defaults to the page size of the API. May alternatively be set via the `GRAFANA_CLOUD_API_PAGE_SIZE` environment variable."
+
+const maintenanceGracePeriodDescription = "How long to retry the Grafana Cloud API calls, in seconds, while the API responds that the stack is in maintenance (503, or 409 mentioning a maintenance). " +
+	"The calls are retried with a jittered exponential backoff, and fail with an error saying the stack is in maintenance once the grace period is over. Set to 0 to fail right away. " +
+	"Defaults to 300. May alternatively be set via the `GRAFANA_MAINTENANCE_GRACE_PERIOD` environment variable."
+
+const defaultLabelsDescription = "Labels added to every resource that supports them: alert rules, SLOs, Synthetic Monitoring checks and Machine Learning jobs. " +
+	"Labels set on a resource take precedence over the default labels. " +
+	"Adding, changing or removing default labels updates these resources, through their `applied_default_labels` attribute."
+
+const dashboardDeprecatedPanelsDescription = "What to do when a dashboard uses deprecated panel types, such as the Angular based `graph`, `table-old` and `singlestat` panels: " +
+	"`ignore`, `warn` (a warning is shown when the dashboard is applied) or `fail` (the plan fails). Defaults to `ignore`. " +
+	"May alternatively be set via the `GRAFANA_DASHBOARD_DEPRECATED_PANELS` environment variable."
+
+const duplicateRuleTitlesDescription = "What to do when an alert rule title is used twice in a `grafana_rule_group` resource, or by several `grafana_rule_group` resources of the same folder in the configuration, " +
+	"since Grafana rejects duplicate rule titles within a folder in some configurations: `fail` (the plan fails) or `warn` (a warning is shown when the rule group is applied). Defaults to `warn`. " +
+	"May alternatively be set via the `GRAFANA_DUPLICATE_RULE_TITLES` environment variable."
+
+const alertingImagesDescription = "What to do when a `grafana_contact_point` resource would send notifications with images of the alerts, but Grafana can't capture them " +
+	"because screenshots are disabled or no image renderer is available: `ignore`, `warn` (a warning is shown when the contact point is applied) or `fail` (the plan fails). " +
+	"Only the notifiers which include images are checked: Discord, email, Google Chat, Opsgenie, PagerDuty, Pushover, Slack, Microsoft Teams, Telegram and webhook, unless they set `upload_image = false`. Reading the Grafana settings requires the server admin role: the check is skipped otherwise. Defaults to `ignore`. " +
+	"May alternatively be set via the `GRAFANA_ALERTING_IMAGES` environment variable."
+
+const alertingNotifierURLCheckDescription = "How to check the URLs of the webhook-like notifiers of `grafana_contact_point` resources (e.g. `webhook`, `oncall` or `slack`), to catch typos before notifications fail to be delivered: " +
+	"`none`, `syntax` (the plan fails if a URL isn't a valid HTTP(S) URL) or `reachability` (the URLs are also checked with a HEAD request with a 5s timeout before the contact point is applied, which fails if a host is unknown or a connection fails). " +
+	"The reachability check is made from where Terraform runs, which may not have the same network access as Grafana. Defaults to `none`. " +
+	"May alternatively be set via the `GRAFANA_ALERTING_NOTIFIER_URL_CHECK` environment variable."
+
+const awsSigV4RegionDescription = "The AWS region of the requests to Grafana, for a Grafana instance behind an AWS service authenticating requests with SigV4 (e.g. API Gateway with IAM authorization, or VPC Lattice). " +
+	"The requests are signed with the credentials of the default AWS credential chain: environment variables, shared configuration files, web identity tokens (e.g. IRSA), and ECS or EC2 instance roles. " +
+	"AWS ALB authentication isn't supported, since ALB only accepts its own session cookies. " +
+	"The signature is set in the query string, so that the `Authorization` header is left to the authentication to Grafana. " +
+	"May alternatively be set via the `GRAFANA_AWS_SIGV4_REGION` environment variable."
+
+const awsSigV4ServiceDescription = "The AWS service name the requests to Grafana are signed for, with `aws_sigv4_region`. Defaults to `execute-api` (API Gateway). " +
+	"May alternatively be set via the `GRAFANA_AWS_SIGV4_SERVICE` environment variable."
+
+const proxyOIDCTokenDescription = "OIDC ID token (file path or literal value) sent with the requests to Grafana, for a Grafana instance behind a proxy authenticating OIDC tokens, e.g. GCP Identity-Aware Proxy. " +
+	"The token is sent as a bearer token in the `proxy_oidc_header` header. A file is read on each request, so that the token can be refreshed while Terraform runs. " +
+	"May alternatively be set via the `GRAFANA_PROXY_OIDC_TOKEN` environment variable."
+
+const proxyOIDCHeaderDescription = "The header of the requests to Grafana in which `proxy_oidc_token` is sent. Defaults to `Proxy-Authorization`, so that the `Authorization` header is left to the authentication to Grafana. " +
+	"May alternatively be set via the `GRAFANA_PROXY_OIDC_HEADER` environment variable."
+
+const strictSchemaDescription = "Check the JSON of `grafana_dashboard` and `grafana_data_source` resources against the Grafana instance they are applied to, " +
+	"to catch configurations built for a newer Grafana: top-level dashboard fields unknown to Grafana, a dashboard `schemaVersion` newer than the instance supports, " +
+	"and panel or data source types whose plugin isn't installed on the instance. Each finding is shown as a warning when the resource is applied: Terraform doesn't show the warnings of the provider at plan time. " +
+	"The checks are best effort: dashboard fields are checked against the fields of all Grafana versions, schema versions newer than the provider knows are reported as such, and the `json_data_encoded` of data sources isn't checked, since Grafana doesn't publish the schemas of the plugins. " +
+	"May alternatively be set via the `GRAFANA_STRICT_SCHEMA` environment variable."
+
+const skipVersionCheckDescription = "Skip the requests made to Grafana at plan time to read its version and settings, which are used to validate resources " +
+	"(e.g. minimum Grafana versions and alert rule group intervals), and the other plan-time checks against Grafana, such as the existence of the folders of dashboards. The provider then makes no request to Grafana when it's configured, " +
+	"so plans that don't refresh the state (`-refresh=false`) succeed without network access. " +
+	"May alternatively be set via the `GRAFANA_SKIP_VERSION_CHECK` environment variable."
+
 type frameworkProvider struct {
 	version string
 }
@@ -120,6 +248,11 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "The root URL of a Grafana server. May alternatively be set via the `GRAFANA_URL` environment variable.",
 			},
+			"failover_urls": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: failoverURLsDescription,
+				ElementType:         types.StringType,
+			},
 			"auth": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -165,9 +298,59 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Skip TLS certificate verification. May alternatively be set via the `GRAFANA_INSECURE_SKIP_VERIFY` environment variable.",
 			},
+			"aws_sigv4_region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: awsSigV4RegionDescription,
+			},
+			"aws_sigv4_service": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: awsSigV4ServiceDescription,
+			},
+			"proxy_oidc_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: proxyOIDCTokenDescription,
+			},
+			"proxy_oidc_header": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: proxyOIDCHeaderDescription,
+			},
 			"store_dashboard_sha256": schema.BoolAttribute{
 				Optional:            true,
-				MarkdownDescription: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate.",
+				MarkdownDescription: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate. Dashboards with `ignored_json_paths` can't be used then.",
+			},
+			"default_labels": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: defaultLabelsDescription,
+			},
+			"dashboard_deprecated_panels": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: dashboardDeprecatedPanelsDescription,
+			},
+			"skip_version_check": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: skipVersionCheckDescription,
+			},
+			"strict_schema": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: strictSchemaDescription,
+			},
+			"duplicate_rule_titles": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: duplicateRuleTitlesDescription,
+			},
+			"alerting_images": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: alertingImagesDescription,
+			},
+			"alerting_notifier_url_check": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: alertingNotifierURLCheckDescription,
+			},
+			"plan_api_calls_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: planAPICallsFileDescription,
 			},
 
 			"cloud_api_key": schema.StringAttribute{
@@ -179,6 +362,14 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Grafana Cloud's API URL. May alternatively be set via the `GRAFANA_CLOUD_API_URL` environment variable.",
 			},
+			"cloud_api_page_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: cloudAPIPageSizeDescription,
+			},
+			"maintenance_grace_period": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: maintenanceGracePeriodDescription,
+			},
 
 			"sm_access_token": schema.StringAttribute{
 				Optional:            true,
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+const grafanaHealthCheckTimeout = 5 * time.Second
+
+const failoverURLsDescription = "Root URLs of other Grafana servers, in order of preference, to use when the server at `url` is unhealthy (e.g. the other members of a self-hosted HA pair behind separate hostnames). " +
+	"When the provider is configured, the health of `url` is checked, then the health of these URLs until a healthy server is found. " +
+	"That server is used for all the requests of the run. The health checks send the headers of `http_headers`. No check is made when `skip_version_check` is set. " +
+	"May alternatively be set via the `GRAFANA_FAILOVER_URLS` environment variable, as a comma-separated list."
+
+// selectGrafanaURL returns the first of the given Grafana root URLs whose health endpoint reports a healthy server.
+// The selection is made once, when the provider is configured, so all the requests of a run go to the same server.
+// The health checks send the configured HTTP headers, e.g. for the proxies which require them, and are signed like the other requests.
+func selectGrafanaURL(urls []string, tlsConfig *tls.Config, headers map[string]string, signer *common.RequestSigner) (string, error) {
+	client := &http.Client{
+		Timeout:   grafanaHealthCheckTimeout,
+		Transport: signer.Wrap(&http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment}),
+	}
+
+	var errs []string
+	for _, u := range urls {
+		err := checkGrafanaHealth(client, u, headers)
+		if err == nil {
+			return u, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", u, err))
+	}
+	return "", fmt.Errorf("no healthy Grafana server found:\n%s", strings.Join(errs, "\n"))
+}
+
+func checkGrafanaHealth(client *http.Client, rootURL string, headers map[string]string) error {
+	healthURL, err := url.JoinPath(rootURL, "api", "health")
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodGet, healthURL, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check returned status %d", resp.StatusCode)
+	}
+	return nil
+}
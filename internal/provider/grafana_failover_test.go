@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSelectGrafanaURL(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/grafana/api/health" {
+			t.Errorf("unexpected health check path %q", r.URL.Path)
+		}
+		if v := r.Header.Get("X-Proxy-Auth"); v != "secret" {
+			t.Errorf("expected the configured headers to be sent, got X-Proxy-Auth %q", v)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+
+	got, err := selectGrafanaURL([]string{down.URL, unhealthy.URL, healthy.URL + "/grafana", unhealthy.URL}, nil, map[string]string{"X-Proxy-Auth": "secret"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != healthy.URL+"/grafana" {
+		t.Errorf("expected the healthy server to be selected, got %s", got)
+	}
+
+	_, err = selectGrafanaURL([]string{down.URL, unhealthy.URL}, nil, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "health check returned status 503") {
+		t.Errorf("expected an error listing the unhealthy servers, got %v", err)
+	}
+}
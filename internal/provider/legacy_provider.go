@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/grafana/terraform-provider-grafana/internal/resources/cloud"
 	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
 	"github.com/grafana/terraform-provider-grafana/internal/resources/machinelearning"
@@ -36,63 +37,78 @@ func init() {
 func Provider(version string) *schema.Provider {
 	var (
 		// Resources that require the Grafana client to exist.
-		grafanaClientResources = addResourcesMetadataValidation(grafanaClientPresent, map[string]*schema.Resource{
+		grafanaClientResources = addResourcesMetadataValidation(grafanaClientPresent, addGrafanaMinimumVersionValidation(map[string]*schema.Resource{
 			// Grafana
-			"grafana_annotation":                 grafana.ResourceAnnotation(),
-			"grafana_api_key":                    grafana.ResourceAPIKey(),
-			"grafana_contact_point":              grafana.ResourceContactPoint(),
-			"grafana_dashboard":                  grafana.ResourceDashboard(),
-			"grafana_dashboard_public":           grafana.ResourcePublicDashboard(),
-			"grafana_dashboard_permission":       grafana.ResourceDashboardPermission(),
-			"grafana_data_source":                grafana.ResourceDataSource(),
-			"grafana_data_source_permission":     grafana.ResourceDatasourcePermission(),
-			"grafana_folder":                     grafana.ResourceFolder(),
-			"grafana_folder_permission":          grafana.ResourceFolderPermission(),
-			"grafana_library_panel":              grafana.ResourceLibraryPanel(),
-			"grafana_message_template":           grafana.ResourceMessageTemplate(),
-			"grafana_mute_timing":                grafana.ResourceMuteTiming(),
-			"grafana_notification_policy":        grafana.ResourceNotificationPolicy(),
-			"grafana_organization":               grafana.ResourceOrganization(),
-			"grafana_organization_preferences":   grafana.ResourceOrganizationPreferences(),
-			"grafana_playlist":                   grafana.ResourcePlaylist(),
-			"grafana_report":                     grafana.ResourceReport(),
-			"grafana_role":                       grafana.ResourceRole(),
-			"grafana_role_assignment":            grafana.ResourceRoleAssignment(),
-			"grafana_rule_group":                 grafana.ResourceRuleGroup(),
-			"grafana_team":                       grafana.ResourceTeam(),
-			"grafana_team_external_group":        grafana.ResourceTeamExternalGroup(),
-			"grafana_service_account_token":      grafana.ResourceServiceAccountToken(),
-			"grafana_service_account":            grafana.ResourceServiceAccount(),
-			"grafana_service_account_permission": grafana.ResourceServiceAccountPermission(),
-			"grafana_user":                       grafana.ResourceUser(),
+			"grafana_admin_settings":               grafana.ResourceAdminSettings(),
+			"grafana_alerting_admin_config":        grafana.ResourceAlertingAdminConfig(),
+			"grafana_annotation":                   grafana.ResourceAnnotation(),
+			"grafana_annotation_permissions":       grafana.ResourceAnnotationPermissions(),
+			"grafana_apps_resource":                grafana.ResourceAppsResource(),
+			"grafana_api_call":                     grafana.ResourceAPICall(),
+			"grafana_api_key":                      grafana.ResourceAPIKey(),
+			"grafana_contact_point":                grafana.ResourceContactPoint(),
+			"grafana_dashboard":                    grafana.ResourceDashboard(),
+			"grafana_dashboard_public":             grafana.ResourcePublicDashboard(),
+			"grafana_dashboard_permission":         grafana.ResourceDashboardPermission(),
+			"grafana_data_source":                  grafana.ResourceDataSource(),
+			"grafana_data_source_permission":       grafana.ResourceDatasourcePermission(),
+			"grafana_folder":                       grafana.ResourceFolder(),
+			"grafana_gcom_dashboard":               grafana.ResourceGcomDashboard(),
+			"grafana_folder_permission":            grafana.ResourceFolderPermission(),
+			"grafana_group_role_mapping":           grafana.ResourceGroupRoleMapping(),
+			"grafana_library_panel":                grafana.ResourceLibraryPanel(),
+			"grafana_message_template":             grafana.ResourceMessageTemplate(),
+			"grafana_mute_timing":                  grafana.ResourceMuteTiming(),
+			"grafana_notification_policy":          grafana.ResourceNotificationPolicy(),
+			"grafana_notification_policy_defaults": grafana.ResourceNotificationPolicyDefaults(),
+			"grafana_organization":                 grafana.ResourceOrganization(),
+			"grafana_organization_bootstrap":       grafana.ResourceOrganizationBootstrap(),
+			"grafana_organization_preferences":     grafana.ResourceOrganizationPreferences(),
+			"grafana_playlist":                     grafana.ResourcePlaylist(),
+			"grafana_report":                       grafana.ResourceReport(),
+			"grafana_role":                         grafana.ResourceRole(),
+			"grafana_role_assignment":              grafana.ResourceRoleAssignment(),
+			"grafana_rule_group":                   grafana.ResourceRuleGroup(),
+			"grafana_team":                         grafana.ResourceTeam(),
+			"grafana_team_external_group":          grafana.ResourceTeamExternalGroup(),
+			"grafana_service_account_token":        grafana.ResourceServiceAccountToken(),
+			"grafana_service_account":              grafana.ResourceServiceAccount(),
+			"grafana_service_account_permission":   grafana.ResourceServiceAccountPermission(),
+			"grafana_user":                         grafana.ResourceUser(),
+			"grafana_user_org_membership":          grafana.ResourceUserOrgMembership(),
 
 			// Machine Learning
 			"grafana_machine_learning_job":              machinelearning.ResourceJob(),
+			"grafana_machine_learning_alert":            machinelearning.ResourceAlert(),
 			"grafana_machine_learning_holiday":          machinelearning.ResourceHoliday(),
 			"grafana_machine_learning_outlier_detector": machinelearning.ResourceOutlierDetector(),
 
 			// SLO
-			"grafana_slo": slo.ResourceSlo(),
-		})
+			"grafana_slo":                slo.ResourceSlo(),
+			"grafana_service_monitoring": slo.ResourceServiceMonitoring(),
+		}, false))
 
 		// Resources that require the Synthetic Monitoring client to exist.
 		smClientResources = addResourcesMetadataValidation(smClientPresent, map[string]*schema.Resource{
-			"grafana_synthetic_monitoring_check": syntheticmonitoring.ResourceCheck(),
-			"grafana_synthetic_monitoring_probe": syntheticmonitoring.ResourceProbe(),
+			"grafana_synthetic_monitoring_check":           syntheticmonitoring.ResourceCheck(),
+			"grafana_synthetic_monitoring_probe":           syntheticmonitoring.ResourceProbe(),
+			"grafana_synthetic_monitoring_tenant_settings": syntheticmonitoring.ResourceTenantSettings(),
 		})
 
 		// Resources that require the Cloud client to exist.
-		cloudClientResources = addResourcesMetadataValidation(cloudClientPresent, map[string]*schema.Resource{
+		cloudClientResources = addCloudMaintenanceDiagnostics(addResourcesMetadataValidation(cloudClientPresent, map[string]*schema.Resource{
 			"grafana_cloud_access_policy":               cloud.ResourceAccessPolicy(),
 			"grafana_cloud_access_policy_token":         cloud.ResourceAccessPolicyToken(),
 			"grafana_cloud_api_key":                     cloud.ResourceAPIKey(),
 			"grafana_cloud_plugin_installation":         cloud.ResourcePluginInstallation(),
 			"grafana_cloud_stack":                       cloud.ResourceStack(),
 			"grafana_cloud_stack_api_key":               cloud.ResourceStackAPIKey(),
+			"grafana_cloud_stack_plugins":               cloud.ResourceStackPlugins(),
 			"grafana_cloud_stack_service_account":       cloud.ResourceStackServiceAccount(),
+			"grafana_cloud_stack_service_account_gc":    cloud.ResourceStackServiceAccountGC(),
 			"grafana_cloud_stack_service_account_token": cloud.ResourceStackServiceAccountToken(),
 			"grafana_synthetic_monitoring_installation": cloud.ResourceInstallation(),
-		})
+		}))
 
 		// Resources that require the OnCall client to exist.
 		onCallClientResources = addResourcesMetadataValidation(onCallClientPresent, map[string]*schema.Resource{
@@ -103,51 +119,79 @@ func Provider(version string) *schema.Provider {
 			"grafana_oncall_on_call_shift":    oncall.ResourceOnCallShift(),
 			"grafana_oncall_schedule":         oncall.ResourceSchedule(),
 			"grafana_oncall_outgoing_webhook": oncall.ResourceOutgoingWebhook(),
+			"grafana_oncall_team_link":        oncall.ResourceTeamLink(),
 		})
 
 		// Datasources that require the Grafana client to exist.
-		grafanaClientDatasources = addResourcesMetadataValidation(grafanaClientPresent, map[string]*schema.Resource{
-			"grafana_dashboard":                grafana.DatasourceDashboard(),
-			"grafana_dashboards":               grafana.DatasourceDashboards(),
-			"grafana_data_source":              grafana.DatasourceDatasource(),
-			"grafana_folder":                   grafana.DatasourceFolder(),
-			"grafana_folders":                  grafana.DatasourceFolders(),
-			"grafana_library_panel":            grafana.DatasourceLibraryPanel(),
-			"grafana_user":                     grafana.DatasourceUser(),
-			"grafana_users":                    grafana.DatasourceUsers(),
-			"grafana_role":                     grafana.DatasourceRole(),
-			"grafana_service_account":          grafana.DatasourceServiceAccount(),
-			"grafana_team":                     grafana.DatasourceTeam(),
-			"grafana_organization":             grafana.DatasourceOrganization(),
-			"grafana_organization_preferences": grafana.DatasourceOrganizationPreferences(),
+		grafanaClientDatasources = addResourcesMetadataValidation(grafanaClientPresent, addGrafanaMinimumVersionValidation(map[string]*schema.Resource{
+			"grafana_alerting_export":              grafana.DatasourceAlertingExport(),
+			"grafana_api_call":                     grafana.DatasourceAPICall(),
+			"grafana_contact_point_json":           grafana.DatasourceContactPointJSON(),
+			"grafana_contact_points":               grafana.DatasourceContactPoints(),
+			"grafana_dashboard":                    grafana.DatasourceDashboard(),
+			"grafana_dashboard_panel":              grafana.DatasourceDashboardPanel(),
+			"grafana_dashboard_panel_image":        grafana.DatasourceDashboardPanelImage(),
+			"grafana_dashboards":                   grafana.DatasourceDashboards(),
+			"grafana_data_source":                  grafana.DatasourceDatasource(),
+			"grafana_data_sources":                 grafana.DatasourceDatasources(),
+			"grafana_folder":                       grafana.DatasourceFolder(),
+			"grafana_folders":                      grafana.DatasourceFolders(),
+			"grafana_instance_info":                grafana.DatasourceInstanceInfo(),
+			"grafana_library_panel":                grafana.DatasourceLibraryPanel(),
+			"grafana_user":                         grafana.DatasourceUser(),
+			"grafana_users":                        grafana.DatasourceUsers(),
+			"grafana_role":                         grafana.DatasourceRole(),
+			"grafana_rule_group_prometheus_export": grafana.DatasourceRuleGroupPrometheusExport(),
+			"grafana_rule_preview":                 grafana.DatasourceRulePreview(),
+			"grafana_service_account":              grafana.DatasourceServiceAccount(),
+			"grafana_team":                         grafana.DatasourceTeam(),
+			"grafana_organization":                 grafana.DatasourceOrganization(),
+			"grafana_organization_preferences":     grafana.DatasourceOrganizationPreferences(),
+			"grafana_playlists":                    grafana.DatasourcePlaylists(),
 
 			// SLO
 			"grafana_slos": slo.DatasourceSlo(),
-		})
+		}, true))
 
 		// Datasources that require the Synthetic Monitoring client to exist.
 		smClientDatasources = addResourcesMetadataValidation(smClientPresent, map[string]*schema.Resource{
-			"grafana_synthetic_monitoring_probe":  syntheticmonitoring.DataSourceProbe(),
-			"grafana_synthetic_monitoring_probes": syntheticmonitoring.DataSourceProbes(),
+			"grafana_synthetic_monitoring_probe":   syntheticmonitoring.DataSourceProbe(),
+			"grafana_synthetic_monitoring_probes":  syntheticmonitoring.DataSourceProbes(),
+			"grafana_synthetic_monitoring_regions": syntheticmonitoring.DataSourceRegions(),
 		})
 
+		// Datasources that are computed by the provider and don't require any client.
+		noClientDatasources = map[string]*schema.Resource{
+			"grafana_synthetic_monitoring_usage_estimate": syntheticmonitoring.DataSourceUsageEstimate(),
+		}
+
 		// Datasources that require the Cloud client to exist.
-		cloudClientDatasources = addResourcesMetadataValidation(cloudClientPresent, map[string]*schema.Resource{
-			"grafana_cloud_ips":          cloud.DataSourceIPs(),
-			"grafana_cloud_organization": cloud.DataSourceOrganization(),
-			"grafana_cloud_stack":        cloud.DataSourceStack(),
-		})
+		cloudClientDatasources = addCloudMaintenanceDiagnostics(addResourcesMetadataValidation(cloudClientPresent, map[string]*schema.Resource{
+			"grafana_cloud_access_policy_tokens": cloud.DataSourceAccessPolicyTokens(),
+			"grafana_cloud_ips":                  cloud.DataSourceIPs(),
+			"grafana_cloud_organization":         cloud.DataSourceOrganization(),
+			"grafana_cloud_organization_usage":   cloud.DataSourceOrganizationUsage(),
+			"grafana_cloud_stack":                cloud.DataSourceStack(),
+			"grafana_cloud_stack_plugins":        cloud.DataSourceStackPlugins(),
+			"grafana_cloud_stack_usage":          cloud.DataSourceStackUsage(),
+			"grafana_cloud_stacks":               cloud.DataSourceStacks(),
+			"grafana_cloud_token_info":           cloud.DataSourceTokenInfo(),
+		}))
 
 		// Datasources that require the OnCall client to exist.
 		onCallClientDatasources = addResourcesMetadataValidation(onCallClientPresent, map[string]*schema.Resource{
-			"grafana_oncall_user":             oncall.DataSourceUser(),
-			"grafana_oncall_escalation_chain": oncall.DataSourceEscalationChain(),
-			"grafana_oncall_schedule":         oncall.DataSourceSchedule(),
-			"grafana_oncall_slack_channel":    oncall.DataSourceSlackChannel(),
-			"grafana_oncall_action":           oncall.DataSourceAction(), // deprecated
-			"grafana_oncall_outgoing_webhook": oncall.DataSourceOutgoingWebhook(),
-			"grafana_oncall_user_group":       oncall.DataSourceUserGroup(),
-			"grafana_oncall_team":             oncall.DataSourceTeam(),
+			"grafana_oncall_user":                  oncall.DataSourceUser(),
+			"grafana_oncall_users":                 oncall.DataSourceUsers(),
+			"grafana_oncall_escalation_chain":      oncall.DataSourceEscalationChain(),
+			"grafana_oncall_integration":           oncall.DataSourceIntegration(),
+			"grafana_oncall_schedule":              oncall.DataSourceSchedule(),
+			"grafana_oncall_slack_channel":         oncall.DataSourceSlackChannel(),
+			"grafana_oncall_action":                oncall.DataSourceAction(), // deprecated
+			"grafana_oncall_outgoing_webhook":      oncall.DataSourceOutgoingWebhook(),
+			"grafana_oncall_user_group":            oncall.DataSourceUserGroup(),
+			"grafana_oncall_team":                  oncall.DataSourceTeam(),
+			"grafana_oncall_schedules":             oncall.DataSourceSchedules(),
+			"grafana_oncall_schedule_final_shifts": oncall.DataSourceScheduleFinalShifts(),
 		})
 	)
 
@@ -172,6 +216,12 @@ func Provider(version string) *schema.Provider {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Optional. HTTP headers mapping keys to values used for accessing the Grafana and Grafana Cloud APIs. May alternatively be set via the `GRAFANA_HTTP_HEADERS` environment variable in JSON format.",
 			},
+			"failover_urls": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: failoverURLsDescription,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"retries": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -214,6 +264,27 @@ func Provider(version string) *schema.Provider {
 				Optional:    true,
 				Description: "Skip TLS certificate verification. May alternatively be set via the `GRAFANA_INSECURE_SKIP_VERIFY` environment variable.",
 			},
+			"aws_sigv4_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: awsSigV4RegionDescription,
+			},
+			"aws_sigv4_service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: awsSigV4ServiceDescription,
+			},
+			"proxy_oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: proxyOIDCTokenDescription,
+			},
+			"proxy_oidc_header": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: proxyOIDCHeaderDescription,
+			},
 
 			"cloud_api_key": {
 				Type:        schema.TypeString,
@@ -228,6 +299,18 @@ func Provider(version string) *schema.Provider {
 				Description:  "Grafana Cloud's API URL. May alternatively be set via the `GRAFANA_CLOUD_API_URL` environment variable.",
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
+			"cloud_api_page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  cloudAPIPageSizeDescription,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"maintenance_grace_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  maintenanceGracePeriodDescription,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 
 			"sm_access_token": {
 				Type:        schema.TypeString,
@@ -244,7 +327,52 @@ func Provider(version string) *schema.Provider {
 			"store_dashboard_sha256": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate.",
+				Description: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate. Dashboards with `ignored_json_paths` can't be used then.",
+			},
+			"default_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: defaultLabelsDescription,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"dashboard_deprecated_panels": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  dashboardDeprecatedPanelsDescription,
+				ValidateFunc: validation.StringInSlice([]string{common.DashboardDeprecatedPanelsIgnore, common.DashboardDeprecatedPanelsWarn, common.DashboardDeprecatedPanelsFail}, false),
+			},
+			"skip_version_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: skipVersionCheckDescription,
+			},
+			"strict_schema": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: strictSchemaDescription,
+			},
+			"duplicate_rule_titles": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  duplicateRuleTitlesDescription,
+				ValidateFunc: validation.StringInSlice([]string{common.DuplicateRuleTitlesWarn, common.DuplicateRuleTitlesFail}, false),
+			},
+			"alerting_images": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  alertingImagesDescription,
+				ValidateFunc: validation.StringInSlice([]string{common.AlertingImagesIgnore, common.AlertingImagesWarn, common.AlertingImagesFail}, false),
+			},
+			"alerting_notifier_url_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  alertingNotifierURLCheckDescription,
+				ValidateFunc: validation.StringInSlice([]string{common.AlertingNotifierURLCheckNone, common.AlertingNotifierURLCheckSyntax, common.AlertingNotifierURLCheckReachability}, false),
+			},
+			"plan_api_calls_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: planAPICallsFileDescription,
 			},
 
 			"oncall_access_token": {
@@ -261,18 +389,19 @@ func Provider(version string) *schema.Provider {
 			},
 		},
 
-		ResourcesMap: mergeResourceMaps(
+		ResourcesMap: addPlannedAPICallsRecording(mergeResourceMaps(
 			grafanaClientResources,
 			smClientResources,
 			onCallClientResources,
 			cloudClientResources,
-		),
+		)),
 
 		DataSourcesMap: mergeResourceMaps(
 			grafanaClientDatasources,
 			smClientDatasources,
 			onCallClientDatasources,
 			cloudClientDatasources,
+			noClientDatasources,
 		),
 	}
 
@@ -293,6 +422,15 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			headers = types.MapValueMust(types.StringType, headersValue)
 		}
 
+		defaultLabels := types.MapNull(types.StringType)
+		if v, ok := d.GetOk("default_labels"); ok {
+			defaultLabelsValue := map[string]attr.Value{}
+			for k, v := range v.(map[string]interface{}) {
+				defaultLabelsValue[k] = types.StringValue(v.(string))
+			}
+			defaultLabels = types.MapValueMust(types.StringType, defaultLabelsValue)
+		}
+
 		statusCodes := types.SetNull(types.StringType)
 		if v, ok := d.GetOk("retry_status_codes"); ok {
 			statusCodesValue := []attr.Value{}
@@ -302,26 +440,50 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			statusCodes = types.SetValueMust(types.StringType, statusCodesValue)
 		}
 
+		failoverURLs := types.ListNull(types.StringType)
+		if v, ok := d.GetOk("failover_urls"); ok {
+			failoverURLsValue := []attr.Value{}
+			for _, v := range v.([]interface{}) {
+				failoverURLsValue = append(failoverURLsValue, types.StringValue(v.(string)))
+			}
+			failoverURLs = types.ListValueMust(types.StringType, failoverURLsValue)
+		}
+
 		cfg := frameworkProviderConfig{
-			Auth:                 stringValueOrNull(d, "auth"),
-			URL:                  stringValueOrNull(d, "url"),
-			OrgID:                int64ValueOrNull(d, "org_id"),
-			TLSKey:               stringValueOrNull(d, "tls_key"),
-			TLSCert:              stringValueOrNull(d, "tls_cert"),
-			CACert:               stringValueOrNull(d, "ca_cert"),
-			InsecureSkipVerify:   boolValueOrNull(d, "insecure_skip_verify"),
-			CloudAPIKey:          stringValueOrNull(d, "cloud_api_key"),
-			CloudAPIURL:          stringValueOrNull(d, "cloud_api_url"),
-			SMAccessToken:        stringValueOrNull(d, "sm_access_token"),
-			SMURL:                stringValueOrNull(d, "sm_url"),
-			OncallAccessToken:    stringValueOrNull(d, "oncall_access_token"),
-			OncallURL:            stringValueOrNull(d, "oncall_url"),
-			StoreDashboardSha256: boolValueOrNull(d, "store_dashboard_sha256"),
-			HTTPHeaders:          headers,
-			Retries:              int64ValueOrNull(d, "retries"),
-			RetryStatusCodes:     statusCodes,
-			RetryWait:            types.Int64Value(int64(d.Get("retry_wait").(int))),
-			UserAgent:            types.StringValue(p.UserAgent("terraform-provider-grafana", version)),
+			Auth:                      stringValueOrNull(d, "auth"),
+			URL:                       stringValueOrNull(d, "url"),
+			FailoverURLs:              failoverURLs,
+			OrgID:                     int64ValueOrNull(d, "org_id"),
+			TLSKey:                    stringValueOrNull(d, "tls_key"),
+			TLSCert:                   stringValueOrNull(d, "tls_cert"),
+			CACert:                    stringValueOrNull(d, "ca_cert"),
+			InsecureSkipVerify:        boolValueOrNull(d, "insecure_skip_verify"),
+			AWSSigV4Region:            stringValueOrNull(d, "aws_sigv4_region"),
+			AWSSigV4Service:           stringValueOrNull(d, "aws_sigv4_service"),
+			ProxyOIDCToken:            stringValueOrNull(d, "proxy_oidc_token"),
+			ProxyOIDCHeader:           stringValueOrNull(d, "proxy_oidc_header"),
+			CloudAPIKey:               stringValueOrNull(d, "cloud_api_key"),
+			CloudAPIURL:               stringValueOrNull(d, "cloud_api_url"),
+			CloudAPIPageSize:          int64ValueOrNull(d, "cloud_api_page_size"),
+			MaintenanceGracePeriod:    int64ValueOrNull(d, "maintenance_grace_period"),
+			SMAccessToken:             stringValueOrNull(d, "sm_access_token"),
+			SMURL:                     stringValueOrNull(d, "sm_url"),
+			OncallAccessToken:         stringValueOrNull(d, "oncall_access_token"),
+			OncallURL:                 stringValueOrNull(d, "oncall_url"),
+			StoreDashboardSha256:      boolValueOrNull(d, "store_dashboard_sha256"),
+			DefaultLabels:             defaultLabels,
+			DashboardDeprecatedPanels: stringValueOrNull(d, "dashboard_deprecated_panels"),
+			SkipVersionCheck:          boolValueOrNull(d, "skip_version_check"),
+			StrictSchema:              boolValueOrNull(d, "strict_schema"),
+			DuplicateRuleTitles:       stringValueOrNull(d, "duplicate_rule_titles"),
+			AlertingImages:            stringValueOrNull(d, "alerting_images"),
+			AlertingNotifierURLCheck:  stringValueOrNull(d, "alerting_notifier_url_check"),
+			PlanAPICallsFile:          stringValueOrNull(d, "plan_api_calls_file"),
+			HTTPHeaders:               headers,
+			Retries:                   int64ValueOrNull(d, "retries"),
+			RetryStatusCodes:          statusCodes,
+			RetryWait:                 types.Int64Value(int64(d.Get("retry_wait").(int))),
+			UserAgent:                 types.StringValue(p.UserAgent("terraform-provider-grafana", version)),
 		}
 		if err := cfg.SetDefaults(); err != nil {
 			return nil, diag.FromErr(err)
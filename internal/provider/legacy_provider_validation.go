@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -77,3 +78,82 @@ func addResourcesMetadataValidation(validateFunc metadataValidation, resources m
 	}
 	return resources
 }
+
+// grafanaMinimumVersions lists the Grafana resources and datasources that are only available from a given Grafana version.
+var grafanaMinimumVersions = map[string]string{
+	"grafana_admin_settings":               "11.0.0",
+	"grafana_alerting_admin_config":        "9.2.0",
+	"grafana_alerting_export":              "10.1.0",
+	"grafana_annotation_permissions":       "9.2.0",
+	"grafana_contact_point":                "9.1.0",
+	"grafana_contact_points":               "9.1.0",
+	"grafana_dashboard_public":             "10.2.0",
+	"grafana_group_role_mapping":           "11.1.0",
+	"grafana_message_template":             "9.1.0",
+	"grafana_mute_timing":                  "9.1.0",
+	"grafana_notification_policy":          "9.1.0",
+	"grafana_notification_policy_defaults": "9.1.0",
+	"grafana_organization_bootstrap":       "9.1.0",
+	"grafana_rule_group":                   "9.1.0",
+	"grafana_rule_group_prometheus_export": "10.0.0",
+	"grafana_rule_preview":                 "10.0.0",
+	"grafana_service_account":              "9.1.0",
+	"grafana_service_monitoring":           "9.1.0",
+	"grafana_service_account_permission":   "9.2.4",
+	"grafana_service_account_token":        "9.1.0",
+}
+
+// checkGrafanaMinimumVersion returns an error if the target Grafana instance is older than the minimum version of the given resource.
+// If the version can't be detected (ex: it's hidden from the health endpoint), the check is skipped.
+func checkGrafanaMinimumVersion(resourceName string, m interface{}) error {
+	minVersion, ok := grafanaMinimumVersions[resourceName]
+	if !ok {
+		return nil
+	}
+	client, ok := m.(*common.Client)
+	if !ok || client.GrafanaOAPI == nil {
+		return nil
+	}
+
+	version, err := client.GrafanaVersion()
+	if err != nil {
+		log.Printf("[WARN] skipping the minimum version check of %s: %v", resourceName, err)
+		return nil
+	}
+	if version.LessThan(semver.MustParse(minVersion)) {
+		return fmt.Errorf("%s requires Grafana >= %s, target is %s", resourceName, minVersion, version)
+	}
+	return nil
+}
+
+// addGrafanaMinimumVersionValidation checks the Grafana version at plan time for resources (CustomizeDiff)
+// and at read time for datasources.
+func addGrafanaMinimumVersionValidation(resources map[string]*schema.Resource, isDatasource bool) map[string]*schema.Resource {
+	for name, r := range resources {
+		name := name
+		if _, ok := grafanaMinimumVersions[name]; !ok {
+			continue
+		}
+		if isDatasource {
+			prev := r.ReadContext
+			r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				if err := checkGrafanaMinimumVersion(name, m); err != nil {
+					return diag.FromErr(err)
+				}
+				return prev(ctx, d, m)
+			}
+			continue
+		}
+		prev := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if err := checkGrafanaMinimumVersion(name, m); err != nil {
+				return err
+			}
+			if prev != nil {
+				return prev(ctx, d, m)
+			}
+			return nil
+		}
+	}
+	return resources
+}
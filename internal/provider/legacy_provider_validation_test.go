@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+func TestGrafanaMinimumVersions(t *testing.T) {
+	p := Provider("dev")
+	for name, version := range grafanaMinimumVersions {
+		if p.ResourcesMap[name] == nil && p.DataSourcesMap[name] == nil {
+			t.Errorf("%s has a minimum Grafana version, but it's not a resource or data source of the provider", name)
+		}
+		if _, err := semver.StrictNewVersion(version); err != nil {
+			t.Errorf("invalid minimum Grafana version of %s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+const planAPICallsFileDescription = "Path of a file to which the HTTP operations (method and path) that applying each planned resource change would make are appended at plan time, one JSON object per line, " +
+	"so that reviewers can audit the blast radius of a change. Only the write operations of the alerting, dashboard, folder and data source resources are listed, other resources are listed without operations. " +
+	"Path parameters that aren't known at plan time are left as placeholders, e.g. `{uid}`. Resources that are only destroyed aren't listed. " +
+	"The file isn't truncated, and `terraform apply` plans the changes again: remove the file before running `terraform plan`. " +
+	"May alternatively be set via the `GRAFANA_PLAN_API_CALLS_FILE` environment variable."
+
+// Actions of the planned resource changes.
+const (
+	plannedActionCreate  = "create"
+	plannedActionUpdate  = "update"
+	plannedActionReplace = "replace"
+)
+
+// plannedAPICall is an HTTP operation that applying a resource change would make.
+type plannedAPICall struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+// plannedResourceChange is a line of the `plan_api_calls_file` file.
+type plannedResourceChange struct {
+	ResourceType string           `json:"resource_type"`
+	ID           string           `json:"id,omitempty"`
+	OrgID        string           `json:"org_id,omitempty"`
+	Action       string           `json:"action"`
+	Operations   []plannedAPICall `json:"operations,omitempty"`
+}
+
+// plannedAPICallTemplates are the write operations made by resources, by action.
+// The `{attribute}` placeholders of the paths are replaced by the value of the attribute, if known at plan time.
+// `{resource_id}` is replaced by the ID of the resource, without the org ID prefix.
+var plannedAPICallTemplates = map[string]struct {
+	create []plannedAPICall
+	update []plannedAPICall
+	delete []plannedAPICall
+}{
+	"grafana_alerting_admin_config": {
+		create: []plannedAPICall{{"POST", "/api/v1/ngalert/admin_config"}},
+		update: []plannedAPICall{{"POST", "/api/v1/ngalert/admin_config"}},
+		delete: []plannedAPICall{{"DELETE", "/api/v1/ngalert/admin_config"}},
+	},
+	"grafana_contact_point": {
+		create: []plannedAPICall{{"POST", "/api/v1/provisioning/contact-points"}},
+		update: []plannedAPICall{{"PUT", "/api/v1/provisioning/contact-points/{uid}"}, {"POST", "/api/v1/provisioning/contact-points"}, {"DELETE", "/api/v1/provisioning/contact-points/{uid}"}},
+		delete: []plannedAPICall{{"DELETE", "/api/v1/provisioning/contact-points/{uid}"}},
+	},
+	"grafana_dashboard": {
+		create: []plannedAPICall{{"POST", "/api/dashboards/db"}},
+		update: []plannedAPICall{{"POST", "/api/dashboards/db"}},
+		delete: []plannedAPICall{{"DELETE", "/api/dashboards/uid/{uid}"}},
+	},
+	"grafana_data_source": {
+		create: []plannedAPICall{{"POST", "/api/datasources"}},
+		update: []plannedAPICall{{"PUT", "/api/datasources/{resource_id}"}},
+		delete: []plannedAPICall{{"DELETE", "/api/datasources/{resource_id}"}},
+	},
+	"grafana_folder": {
+		create: []plannedAPICall{{"POST", "/api/folders"}},
+		update: []plannedAPICall{{"PUT", "/api/folders/{uid}"}},
+		delete: []plannedAPICall{{"DELETE", "/api/folders/{uid}"}},
+	},
+	"grafana_gcom_dashboard": {
+		create: []plannedAPICall{{"POST", "/api/dashboards/import"}},
+		update: []plannedAPICall{{"POST", "/api/dashboards/import"}},
+		delete: []plannedAPICall{{"DELETE", "/api/dashboards/uid/{uid}"}},
+	},
+	"grafana_message_template": {
+		create: []plannedAPICall{{"PUT", "/api/v1/provisioning/templates/{name}"}},
+		update: []plannedAPICall{{"PUT", "/api/v1/provisioning/templates/{name}"}},
+		delete: []plannedAPICall{{"DELETE", "/api/v1/provisioning/templates/{name}"}},
+	},
+	"grafana_mute_timing": {
+		create: []plannedAPICall{{"POST", "/api/v1/provisioning/mute-timings"}},
+		update: []plannedAPICall{{"PUT", "/api/v1/provisioning/mute-timings/{name}"}},
+		delete: []plannedAPICall{{"DELETE", "/api/v1/provisioning/mute-timings/{name}"}},
+	},
+	"grafana_notification_policy": {
+		create: []plannedAPICall{{"PUT", "/api/v1/provisioning/policies"}},
+		update: []plannedAPICall{{"PUT", "/api/v1/provisioning/policies"}},
+		delete: []plannedAPICall{{"DELETE", "/api/v1/provisioning/policies"}},
+	},
+	"grafana_rule_group": {
+		create: []plannedAPICall{{"PUT", "/api/v1/provisioning/folder/{folder_uid}/rule-groups/{name}"}},
+		update: []plannedAPICall{{"PUT", "/api/v1/provisioning/folder/{folder_uid}/rule-groups/{name}"}, {"DELETE", "/api/v1/provisioning/alert-rules/{uid}"}},
+		delete: []plannedAPICall{{"DELETE", "/api/v1/provisioning/alert-rules/{uid}"}},
+	},
+}
+
+// addPlannedAPICallsRecording wraps the CustomizeDiff function of the given resources, to record their planned changes
+// when the provider's `plan_api_calls_file` attribute is set.
+func addPlannedAPICallsRecording(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, r := range resources {
+		name, r := name, r
+		prev := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if prev != nil {
+				if err := prev(ctx, d, meta); err != nil {
+					return err
+				}
+			}
+			client, ok := meta.(*common.Client)
+			if !ok || client.PlanAPICallsFile == "" {
+				return nil
+			}
+			change, ok := plannedChange(name, r, d)
+			if !ok {
+				return nil
+			}
+			log.Printf("[INFO] planned change of %s %q: %s %v", name, change.ID, change.Action, change.Operations)
+			return client.RecordPlannedResourceChange(change)
+		}
+	}
+	return resources
+}
+
+// plannedChange returns the change planned for a resource, if any.
+func plannedChange(resourceType string, r *schema.Resource, d *schema.ResourceDiff) (plannedResourceChange, bool) {
+	change := plannedResourceChange{
+		ResourceType: resourceType,
+		ID:           d.Id(),
+		Action:       plannedActionCreate,
+	}
+	if orgID, ok := d.GetOk("org_id"); ok {
+		change.OrgID, _ = orgID.(string)
+	}
+
+	if d.Id() != "" {
+		changedKeys := d.GetChangedKeysPrefix("")
+		if len(changedKeys) == 0 {
+			return change, false
+		}
+		change.Action = plannedActionUpdate
+		for _, k := range changedKeys {
+			if s, ok := r.Schema[strings.SplitN(k, ".", 2)[0]]; ok && s.ForceNew {
+				change.Action = plannedActionReplace
+				break
+			}
+		}
+	}
+
+	templates, ok := plannedAPICallTemplates[resourceType]
+	if !ok {
+		return change, true
+	}
+	var calls []plannedAPICall
+	switch change.Action {
+	case plannedActionCreate:
+		calls = templates.create
+	case plannedActionUpdate:
+		calls = templates.update
+	case plannedActionReplace:
+		calls = append(append(calls, templates.delete...), templates.create...)
+	}
+
+	values := func(attribute string) (string, bool) {
+		if attribute == "resource_id" {
+			if d.Id() == "" {
+				return "", false
+			}
+			parts := strings.SplitN(d.Id(), ":", 2)
+			return parts[len(parts)-1], true
+		}
+		if _, ok := r.Schema[attribute]; !ok || !d.NewValueKnown(attribute) {
+			return "", false
+		}
+		v, ok := d.GetOk(attribute)
+		if !ok {
+			return "", false
+		}
+		s, ok := v.(string)
+		return s, ok && s != ""
+	}
+	for _, call := range calls {
+		change.Operations = append(change.Operations, plannedAPICall{Method: call.Method, Path: expandPlannedAPICallPath(call.Path, values)})
+	}
+	return change, true
+}
+
+var plannedAPICallPlaceholderRegexp = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// expandPlannedAPICallPath replaces the placeholders of a path by the values returned by the given function.
+// The placeholders without a value are kept as is.
+func expandPlannedAPICallPath(path string, values func(string) (string, bool)) string {
+	return plannedAPICallPlaceholderRegexp.ReplaceAllStringFunc(path, func(placeholder string) string {
+		if v, ok := values(strings.Trim(placeholder, "{}")); ok {
+			return v
+		}
+		return placeholder
+	})
+}
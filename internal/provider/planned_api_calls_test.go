@@ -0,0 +1,23 @@
+package provider
+
+import "testing"
+
+func TestExpandPlannedAPICallPath(t *testing.T) {
+	values := func(attribute string) (string, bool) {
+		v, ok := map[string]string{"folder_uid": "my-folder", "name": "my-group"}[attribute]
+		return v, ok
+	}
+
+	for _, tc := range []struct {
+		path     string
+		expected string
+	}{
+		{"/api/v1/provisioning/folder/{folder_uid}/rule-groups/{name}", "/api/v1/provisioning/folder/my-folder/rule-groups/my-group"},
+		{"/api/v1/provisioning/alert-rules/{uid}", "/api/v1/provisioning/alert-rules/{uid}"},
+		{"/api/dashboards/db", "/api/dashboards/db"},
+	} {
+		if got := expandPlannedAPICallPath(tc.path, values); got != tc.expected {
+			t.Errorf("expandPlannedAPICallPath(%q) = %q, expected %q", tc.path, got, tc.expected)
+		}
+	}
+}
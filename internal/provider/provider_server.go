@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-mux/tf6to5server"
+)
+
+// MakeProviderServer returns the server of the provider, muxing the Terraform Plugin Framework provider and the SDKv2 provider.
+// Mux config taken here: https://developer.hashicorp.com/terraform/plugin/framework/migrating/mux#terraform-0-12-compatibility-example
+// While not every resource is migrated to the Terraform Plugin Framework, we must mux the old and new providers together.
+func MakeProviderServer(ctx context.Context, version string) (func() tfprotov5.ProviderServer, error) {
+	// While we still have the SDK2 provider, we have to use the provider v5 protocol
+	// See https://developer.hashicorp.com/terraform/plugin/mux/translating-protocol-version-6-to-5
+	downgradedFrameworkProvider, err := tf6to5server.DowngradeServer(
+		ctx,
+		providerserver.NewProtocol6(FrameworkProvider(version)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov5.ProviderServer{
+		func() tfprotov5.ProviderServer {
+			return downgradedFrameworkProvider
+		},
+		Provider(version).GRPCProvider,
+	}
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
@@ -0,0 +1,58 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// cloudAPIRequest calls a Grafana Cloud API endpoint that isn't supported by the Cloud API client.
+// Errors are formatted like those of the Cloud API client, so that `common.CheckReadError` handles them the same way.
+func cloudAPIRequest(ctx context.Context, client *common.Client, method, path string, query url.Values, body, result interface{}) error {
+	u, err := url.Parse(client.GrafanaCloudAPIURL)
+	if err != nil {
+		return err
+	}
+	u = u.JoinPath(path)
+	u.RawQuery = query.Encode()
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+client.GrafanaCloudAPIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.GrafanaCloudHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("status: %d, body: %s", resp.StatusCode, respBody)
+	}
+	if result == nil || len(respBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBody, result)
+}
@@ -0,0 +1,110 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAccessPolicyTokens() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Lists the tokens of an access policy, with their creation and expiration dates. This can be used to find tokens that are due for rotation.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/account-management/authentication-and-permissions/access-policies/)
+* [API documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#list-tokens)
+`,
+		ReadContext: DataSourceAccessPolicyTokensRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Region of the access policy.",
+			},
+			"access_policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the access policy to list the tokens of.",
+			},
+			"tokens": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tokens of the access policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the token.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the token.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the token.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation date of the token.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last update date of the token. Empty if the token was never updated.",
+						},
+						"expires_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiration date of the token. Empty if the token never expires.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceAccessPolicyTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.Client).GrafanaCloudAPI
+	region := d.Get("region").(string)
+	policyID := d.Get("access_policy_id").(string)
+
+	result, err := client.CloudAccessPolicyTokens(region, policyID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tokens := make([]interface{}, 0, len(result.Items))
+	for _, token := range result.Items {
+		updatedAt := ""
+		if token.UpdatedAt != nil {
+			updatedAt = token.UpdatedAt.Format(time.RFC3339)
+		}
+		expiresAt := ""
+		if token.ExpiresAt != nil {
+			expiresAt = token.ExpiresAt.Format(time.RFC3339)
+		}
+		tokens = append(tokens, map[string]interface{}{
+			"id":           token.ID,
+			"name":         token.Name,
+			"display_name": token.DisplayName,
+			"created_at":   token.CreatedAt.Format(time.RFC3339),
+			"updated_at":   updatedAt,
+			"expires_at":   expiresAt,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", region, policyID))
+	d.Set("tokens", tokens)
+
+	return nil
+}
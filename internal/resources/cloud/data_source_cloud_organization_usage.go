@@ -0,0 +1,69 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceOrganizationUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Exports the current usage of each stack of a Grafana Cloud organization, as reported by the Cloud API: active series, logs ingestion and users.
+The values are refreshed by Grafana Cloud periodically, not in real time.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/account-management/billing-and-usage/)
+* [HTTP API](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#stacks/)
+`,
+		ReadContext: DataSourceOrganizationUsageRead,
+		Schema: map[string]*schema.Schema{
+			"org_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the organization.",
+			},
+			"stacks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage of the stacks of the organization, sorted by slug. The attributes are the same as those of the `grafana_cloud_stack_usage` data source.",
+				Elem: &schema.Resource{
+					Schema: stackUsageSchema(map[string]*schema.Schema{
+						"stack_slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The slug of the stack.",
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func DataSourceOrganizationUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgSlug := d.Get("org_slug").(string)
+
+	// The Cloud API client only lists the stacks of all the organizations the key has access to.
+	var resp gapi.StackItems
+	if err := cloudAPIRequest(ctx, meta.(*common.Client), http.MethodGet, "/api/orgs/"+orgSlug+"/instances", nil, nil, &resp); err != nil {
+		return diag.Errorf("failed to list the stacks of organization %q: %s", orgSlug, err)
+	}
+	sort.Slice(resp.Items, func(i, j int) bool { return resp.Items[i].Slug < resp.Items[j].Slug })
+
+	stacks := make([]interface{}, 0, len(resp.Items))
+	for _, stack := range resp.Items {
+		usage := flattenStackUsage(*stack)
+		usage["stack_slug"] = stack.Slug
+		stacks = append(stacks, usage)
+	}
+
+	d.SetId(orgSlug)
+	d.Set("stacks", stacks)
+
+	return nil
+}
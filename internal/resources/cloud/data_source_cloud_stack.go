@@ -32,18 +32,17 @@ available at “https://<stack_slug>.grafana.net".`,
 }
 
 func DataSourceStackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*common.Client).GrafanaCloudAPI
-
 	slug := d.Get("slug").(string)
 
-	stack, err := client.StackBySlug(slug)
+	stack, err := getStack(ctx, meta.(*common.Client), slug)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := FlattenStack(d, stack); err != nil {
+	if err := FlattenStack(d, stack.Stack); err != nil {
 		return diag.FromErr(err)
 	}
+	d.Set("labels", stack.Labels)
 
 	return nil
 }
@@ -0,0 +1,109 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceStackPlugins() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Lists the plugins installed on a Grafana Cloud stack, with their versions.
+
+* [Plugin Catalog](https://grafana.com/grafana/plugins/)
+`,
+		ReadContext: DataSourceStackPluginsRead,
+		Schema: map[string]*schema.Schema{
+			"stack_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the stack to list the plugins of.",
+			},
+			"plugins": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The plugins installed on the stack, sorted by slug.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Slug of the plugin.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the plugin.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Installed version of the plugin.",
+						},
+					},
+				},
+			},
+			"versions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the installed plugins' slugs to their versions. It has the format of the `plugins` attribute of the `grafana_cloud_stack_plugins` resource.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func DataSourceStackPluginsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	stackSlug := d.Get("stack_slug").(string)
+
+	installations, err := listCloudStackPlugins(ctx, meta.(*common.Client), stackSlug)
+	if err != nil {
+		return diag.Errorf("failed to list the plugins of stack %q: %s", stackSlug, err)
+	}
+
+	plugins := make([]interface{}, 0, len(installations))
+	versions := map[string]interface{}{}
+	for _, installation := range installations {
+		plugins = append(plugins, map[string]interface{}{
+			"slug":    installation.PluginSlug,
+			"name":    installation.PluginName,
+			"version": installation.Version,
+		})
+		versions[installation.PluginSlug] = installation.Version
+	}
+
+	d.SetId(stackSlug)
+	d.Set("plugins", plugins)
+	d.Set("versions", versions)
+
+	return nil
+}
+
+// cloudStackPlugin is a plugin installation, as returned by the instance plugins API.
+// The status is only set while the installation is in progress.
+type cloudStackPlugin struct {
+	PluginSlug string `json:"pluginSlug"`
+	PluginName string `json:"pluginName"`
+	Version    string `json:"version"`
+	Status     string `json:"status,omitempty"`
+}
+
+// listCloudStackPlugins lists the plugins installed on a stack, sorted by slug.
+func listCloudStackPlugins(ctx context.Context, client *common.Client, stackSlug string) ([]cloudStackPlugin, error) {
+	var resp struct {
+		Items []cloudStackPlugin `json:"items"`
+	}
+	if err := cloudAPIRequest(ctx, client, http.MethodGet, "/api/instances/"+stackSlug+"/plugins", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(resp.Items, func(i, j int) bool { return resp.Items[i].PluginSlug < resp.Items[j].PluginSlug })
+	return resp.Items, nil
+}
@@ -0,0 +1,117 @@
+package cloud
+
+import (
+	"context"
+	"time"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceStackUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Exports the current usage of a Grafana Cloud stack, as reported by the Cloud API: active series, logs ingestion and users.
+The values are refreshed by Grafana Cloud periodically, not in real time.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/account-management/billing-and-usage/)
+* [HTTP API](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#stacks/)
+`,
+		ReadContext: DataSourceStackUsageRead,
+		Schema: stackUsageSchema(map[string]*schema.Schema{
+			"stack_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the stack.",
+			},
+		}),
+	}
+}
+
+func DataSourceStackUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.Client).GrafanaCloudAPI
+	stackSlug := d.Get("stack_slug").(string)
+
+	stack, err := client.StackBySlug(stackSlug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(stackSlug)
+	for k, v := range flattenStackUsage(stack) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+// stackUsageSchema adds the computed usage attributes of a stack to the given schema.
+func stackUsageSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	usage := map[string]*schema.Schema{
+		"plan": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The plan of the stack.",
+		},
+		"billing_start_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The start of the current billing period, in RFC3339 format.",
+		},
+		"billing_end_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The end of the current billing period, in RFC3339 format.",
+		},
+		"metrics_active_series": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of active series of the stack's Prometheus instance.",
+		},
+		"metrics_billable_series": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The billable series of the stack's Prometheus instance for the current billing period.",
+		},
+		"graphite_billable_series": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The billable series of the stack's Graphite instance for the current billing period.",
+		},
+		"logs_ingested_gb": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The logs ingested by the stack's Loki instance for the current billing period, in GB.",
+		},
+		"active_users": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of currently active users of the stack's Grafana instance.",
+		},
+		"billable_users": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of billable users of the stack's Grafana instance for the current billing period.",
+		},
+	}
+	for k, v := range s {
+		usage[k] = v
+	}
+	return usage
+}
+
+func flattenStackUsage(stack gapi.Stack) map[string]interface{} {
+	return map[string]interface{}{
+		"plan":                     stack.Plan,
+		"billing_start_date":       stack.BillingStartDate.Format(time.RFC3339),
+		"billing_end_date":         stack.BillingEndDate.Format(time.RFC3339),
+		"metrics_active_series":    stack.HmInstancePromCurrentActiveSeries,
+		"metrics_billable_series":  stack.HmInstancePromCurrentUsage,
+		"graphite_billable_series": stack.HmInstanceGraphiteCurrentUsage,
+		"logs_ingested_gb":         stack.HlInstanceCurrentUsage,
+		"active_users":             stack.CurrentActiveUsers,
+		"billable_users":           stack.BillableUserCnt,
+	}
+}
@@ -0,0 +1,64 @@
+package cloud_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceStackUsage_Basic(t *testing.T) {
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	prefix := "tfusagetest"
+
+	resourceName := GetRandomStackName(prefix)
+	var stack gapi.Stack
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccDeleteExistingStacks(t, prefix)
+		},
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccStackCheckDestroy(&stack),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStackUsageConfig(resourceName, os.Getenv("GRAFANA_CLOUD_ORG")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStackCheckExists("grafana_cloud_stack.test", &stack),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stack_usage.test", "id", resourceName),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_stack_usage.test", "plan"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_stack_usage.test", "billing_start_date"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stack_usage.test", "metrics_active_series", "0"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_stack_usage.test", "logs_ingested_gb"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_stack_usage.test", "billable_users"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_cloud_organization_usage.test", "stacks.*", map[string]string{
+						"stack_slug":            resourceName,
+						"metrics_active_series": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStackUsageConfig(resourceName, orgSlug string) string {
+	return fmt.Sprintf(`
+resource "grafana_cloud_stack" "test" {
+  name        = "%[1]s"
+  slug        = "%[1]s"
+  region_slug = "eu"
+}
+
+data "grafana_cloud_stack_usage" "test" {
+  stack_slug = grafana_cloud_stack.test.slug
+}
+
+data "grafana_cloud_organization_usage" "test" {
+  org_slug   = "%[2]s"
+  depends_on = [grafana_cloud_stack.test]
+}
+`, resourceName, orgSlug)
+}
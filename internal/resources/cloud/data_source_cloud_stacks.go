@@ -0,0 +1,142 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceStacks() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Lists the stacks of a Grafana Cloud organization, optionally filtered by their labels.
+This allows managing the resources of all the stacks of a team or an environment, e.g. with ` + "`for_each`" + `.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#stacks/)
+`,
+		ReadContext: DataSourceStacksRead,
+		Schema: map[string]*schema.Schema{
+			"org_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the organization.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only list the stacks with all these labels.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"slugs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The slugs of the stacks, sorted alphabetically.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"stacks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The stacks, sorted by slug.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The stack id assigned to this stack by Grafana.",
+						},
+						"slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The slug of the stack.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the stack.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the Grafana instance of the stack.",
+						},
+						"region_slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the stack.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the stack.",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The labels of the stack.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceStacksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgSlug := d.Get("org_slug").(string)
+
+	var resp struct {
+		Items []cloudStack `json:"items"`
+	}
+	if err := cloudAPIRequest(ctx, meta.(*common.Client), http.MethodGet, "/api/orgs/"+orgSlug+"/instances", nil, nil, &resp); err != nil {
+		return diag.Errorf("failed to list the stacks of organization %q: %s", orgSlug, err)
+	}
+
+	filter := d.Get("labels").(map[string]interface{})
+	matched := filterStacksByLabels(resp.Items, filter)
+
+	slugs := make([]string, 0, len(matched))
+	stacks := make([]interface{}, 0, len(matched))
+	for _, stack := range matched {
+		slugs = append(slugs, stack.Slug)
+		stacks = append(stacks, map[string]interface{}{
+			"id":          strconv.FormatInt(stack.ID, 10),
+			"slug":        stack.Slug,
+			"name":        stack.Name,
+			"url":         stack.URL,
+			"region_slug": stack.RegionSlug,
+			"status":      stack.Status,
+			"labels":      stack.Labels,
+		})
+	}
+
+	d.SetId(orgSlug)
+	d.Set("slugs", slugs)
+	d.Set("stacks", stacks)
+
+	return nil
+}
+
+// filterStacksByLabels returns the stacks with all the given labels, sorted by slug.
+func filterStacksByLabels(stacks []cloudStack, labels map[string]interface{}) []cloudStack {
+	var matched []cloudStack
+	for _, stack := range stacks {
+		match := true
+		for k, v := range labels {
+			if value, ok := stack.Labels[k]; !ok || value != v.(string) {
+				match = false
+				break
+			}
+		}
+		if match {
+			matched = append(matched, stack)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].Slug < matched[j].Slug })
+	return matched
+}
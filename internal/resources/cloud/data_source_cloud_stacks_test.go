@@ -0,0 +1,77 @@
+package cloud_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceStacks_Labels(t *testing.T) {
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	prefix := "tfdatalabelstest"
+
+	resourceName := GetRandomStackName(prefix)
+	var stack gapi.Stack
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccDeleteExistingStacks(t, prefix)
+		},
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccStackCheckDestroy(&stack),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStacksConfig(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStackCheckExists("grafana_cloud_stack.test", &stack),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stack.test", "labels.test", resourceName),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stacks.test", "slugs.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stacks.test", "slugs.0", resourceName),
+					resource.TestCheckResourceAttrPair("data.grafana_cloud_stacks.test", "stacks.0.id", "grafana_cloud_stack.test", "id"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stacks.test", "stacks.0.region_slug", "eu"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stacks.test", "stacks.0.labels.test", resourceName),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stacks.none", "slugs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStacksConfig(resourceName string) string {
+	return fmt.Sprintf(`
+resource "grafana_cloud_stack" "test" {
+  name        = "%[1]s"
+  slug        = "%[1]s"
+  region_slug = "eu"
+  labels = {
+    test = "%[1]s"
+  }
+}
+
+data "grafana_cloud_stack" "test" {
+  slug       = grafana_cloud_stack.test.slug
+  depends_on = [grafana_cloud_stack.test]
+}
+
+data "grafana_cloud_stacks" "test" {
+  org_slug = "%[2]s"
+  labels = {
+    test = "%[1]s"
+  }
+  depends_on = [grafana_cloud_stack.test]
+}
+
+data "grafana_cloud_stacks" "none" {
+  org_slug = "%[2]s"
+  labels = {
+    test = "%[1]s"
+    env  = "missing"
+  }
+  depends_on = [grafana_cloud_stack.test]
+}
+`, resourceName, os.Getenv("GRAFANA_CLOUD_ORG"))
+}
@@ -0,0 +1,189 @@
+package cloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceTokenInfo() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Introspects the access policy token configured in the provider's ` + "`cloud_api_key`" + ` attribute.
+This can be used to assert that Terraform runs with the expected permissions, for example in a ` + "`check`" + ` block or a precondition.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/account-management/authentication-and-permissions/access-policies/)
+`,
+		ReadContext: DataSourceTokenInfoRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the access policy the token belongs to.",
+			},
+			"org_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Grafana Cloud organization the token belongs to.",
+			},
+			"token_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the token.",
+			},
+			"token_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the token.",
+			},
+			"token_display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the token.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the token. Empty if the token never expires.",
+			},
+			"access_policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the access policy the token belongs to.",
+			},
+			"access_policy_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the access policy the token belongs to.",
+			},
+			"scopes": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Scopes granted by the access policy.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"realm": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Realms the access policy applies to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the realm is a Cloud org or a specific stack. One of `org` or `stack`.",
+						},
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the org or stack.",
+						},
+						"label_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"selector": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The label selector matched in metrics or logs queries.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// cloudTokenPayload is the JSON payload encoded in access policy tokens (`glc_<base64 payload>`).
+type cloudTokenPayload struct {
+	OrgID    string `json:"o"`
+	Name     string `json:"n"`
+	Metadata struct {
+		Region string `json:"r"`
+	} `json:"m"`
+}
+
+func parseCloudToken(token string) (*cloudTokenPayload, error) {
+	encoded, ok := strings.CutPrefix(token, "glc_")
+	if !ok {
+		return nil, fmt.Errorf("the configured cloud_api_key is not an access policy token (expected a `glc_` prefix)")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		if decoded, err = base64.RawStdEncoding.DecodeString(encoded); err != nil {
+			return nil, fmt.Errorf("failed to decode the configured cloud_api_key: %w", err)
+		}
+	}
+
+	var payload cloudTokenPayload
+	if err := json.Unmarshal(decoded, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse the configured cloud_api_key: %w", err)
+	}
+	if payload.Name == "" || payload.Metadata.Region == "" {
+		return nil, fmt.Errorf("the configured cloud_api_key does not contain a token name and region")
+	}
+	return &payload, nil
+}
+
+func DataSourceTokenInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*common.Client)
+	client := c.GrafanaCloudAPI
+
+	payload, err := parseCloudToken(c.GrafanaCloudAPIKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region := payload.Metadata.Region
+
+	policies, err := client.CloudAccessPolicies(region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, policy := range policies.Items {
+		tokens, err := client.CloudAccessPolicyTokens(region, policy.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, token := range tokens.Items {
+			if token.Name != payload.Name {
+				continue
+			}
+
+			d.SetId(fmt.Sprintf("%s/%s", region, token.ID))
+			d.Set("region", region)
+			d.Set("org_id", payload.OrgID)
+			d.Set("token_id", token.ID)
+			d.Set("token_name", token.Name)
+			d.Set("token_display_name", token.DisplayName)
+			expiresAt := ""
+			if token.ExpiresAt != nil {
+				expiresAt = token.ExpiresAt.Format(time.RFC3339)
+			}
+			d.Set("expires_at", expiresAt)
+			d.Set("access_policy_id", policy.ID)
+			d.Set("access_policy_name", policy.Name)
+			d.Set("scopes", policy.Scopes)
+			d.Set("realm", flattenCloudAccessPolicyRealm(policy.Realms))
+
+			return nil
+		}
+	}
+
+	return diag.Errorf("token %q was not found in region %s. The access policy it belongs to must be visible to the token (accesspolicies:read scope)", payload.Name, region)
+}
@@ -0,0 +1,29 @@
+package cloud_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTokenInfo_Basic(t *testing.T) {
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_cloud_token_info/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_token_info.current", "token_id"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_token_info.current", "token_name"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_token_info.current", "region"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_token_info.current", "access_policy_id"),
+					resource.TestCheckTypeSetElemAttr("data.grafana_cloud_token_info.current", "scopes.*", "accesspolicies:read"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_token_info.current", "realm.0.type", "org"),
+				),
+			},
+		},
+	})
+}
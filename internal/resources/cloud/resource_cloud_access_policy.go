@@ -3,6 +3,8 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -69,6 +71,13 @@ func ResourceAccessPolicy() *schema.Resource {
 				Required: true,
 				Elem:     cloudAccessPolicyRealmSchema,
 			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				Description:  "Status of the access policy. Tokens of an `inactive` access policy are rejected, but the access policy and its tokens are kept so that it can be reactivated. Should be one of `active` or `inactive`.",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
 
 			// Computed
 			"policy_id": {
@@ -140,6 +149,12 @@ func CreateCloudAccessPolicy(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprintf("%s/%s", region, result.ID))
 
+	if status := d.Get("status").(string); status != "active" {
+		if err := updateCloudAccessPolicyStatus(ctx, meta.(*common.Client), region, result.ID, status); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadCloudAccessPolicy(ctx, d, meta)
 }
 
@@ -161,6 +176,12 @@ func UpdateCloudAccessPolicy(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("status") {
+		if err := updateCloudAccessPolicyStatus(ctx, meta.(*common.Client), region, id, d.Get("status").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadCloudAccessPolicy(ctx, d, meta)
 }
 
@@ -182,6 +203,16 @@ func ReadCloudAccessPolicy(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("created_at", result.CreatedAt.Format(time.RFC3339))
 	d.Set("updated_at", result.UpdatedAt.Format(time.RFC3339))
 
+	// The status isn't returned by the Cloud API client
+	var status cloudAccessPolicyStatus
+	if err := cloudAPIRequest(ctx, meta.(*common.Client), http.MethodGet, "/api/v1/accesspolicies/"+id, url.Values{"region": {region}}, nil, &status); err != nil {
+		return diag.FromErr(err)
+	}
+	if status.Status == "" {
+		status.Status = "active"
+	}
+	d.Set("status", status.Status)
+
 	return nil
 }
 
@@ -191,6 +222,14 @@ func DeleteCloudAccessPolicy(ctx context.Context, d *schema.ResourceData, meta i
 	return diag.FromErr(client.DeleteCloudAccessPolicy(region, id))
 }
 
+type cloudAccessPolicyStatus struct {
+	Status string `json:"status"`
+}
+
+func updateCloudAccessPolicyStatus(ctx context.Context, client *common.Client, region, id, status string) error {
+	return cloudAPIRequest(ctx, client, http.MethodPost, "/api/v1/accesspolicies/"+id, url.Values{"region": {region}}, cloudAccessPolicyStatus{Status: status}, nil)
+}
+
 func validateCloudAccessPolicyScope(v interface{}, path cty.Path) diag.Diagnostics {
 	if strings.Count(v.(string), ":") != 1 {
 		return diag.Errorf("invalid scope: %s. Should be in the `service:permission` format", v.(string))
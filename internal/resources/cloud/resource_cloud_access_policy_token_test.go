@@ -256,3 +256,81 @@ func testAccCloudAccessPolicyTokenConfigBasic(name, displayName string, scopes [
 	}
 	`, name, displayName, strings.Join(scopes, `","`), os.Getenv("GRAFANA_CLOUD_ORG"), expiresAt)
 }
+
+func TestResourceAccessPolicy_Status(t *testing.T) {
+	t.Parallel()
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	var policy gapi.CloudAccessPolicy
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccCloudAccessPolicyCheckDestroy("us", &policy),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudAccessPolicyConfigStatus("status-test", "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyCheckExists("grafana_cloud_access_policy.test", &policy),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy.test", "status", "inactive"),
+				),
+			},
+			{
+				Config: testAccCloudAccessPolicyConfigStatus("status-test", "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyCheckExists("grafana_cloud_access_policy.test", &policy),
+					resource.TestCheckResourceAttr("grafana_cloud_access_policy.test", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "grafana_cloud_access_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAccessPolicyTokens_Basic(t *testing.T) {
+	t.Parallel()
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_cloud_access_policy_tokens/data-source.tf", map[string]string{
+					"<your org slug>": os.Getenv("GRAFANA_CLOUD_ORG"),
+					"my-policy":       "tokens-data-source-test",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_cloud_access_policy_tokens.test", "tokens.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_access_policy_tokens.test", "tokens.0.name", "tokens-data-source-test-token"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_access_policy_tokens.test", "tokens.0.expires_at", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_access_policy_tokens.test", "tokens.0.id"),
+					resource.TestCheckResourceAttrSet("data.grafana_cloud_access_policy_tokens.test", "tokens.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudAccessPolicyConfigStatus(name, status string) string {
+	return fmt.Sprintf(`
+	data "grafana_cloud_organization" "current" {
+		slug = "%[3]s"
+	}
+
+	resource "grafana_cloud_access_policy" "test" {
+		region = "us"
+		name   = "%[1]s"
+		status = "%[2]s"
+
+		scopes = ["metrics:read"]
+
+		realm {
+			type       = "org"
+			identifier = data.grafana_cloud_organization.current.id
+		}
+	}
+	`, name, status, os.Getenv("GRAFANA_CLOUD_ORG"))
+}
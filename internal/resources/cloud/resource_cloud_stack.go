@@ -14,6 +14,7 @@ import (
 
 	gapi "github.com/grafana/grafana-api-golang-client"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -23,7 +24,27 @@ import (
 
 const defaultReadinessTimeout = time.Minute * 5
 
-var stackSlugRegex = regexp.MustCompile("^[a-z][a-z0-9]+$")
+var (
+	stackSlugRegex  = regexp.MustCompile("^[a-z][a-z0-9]+$")
+	stackLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9/\-.]+$`)
+)
+
+// stackMaxLabels is the maximum number of labels of a stack.
+const stackMaxLabels = 10
+
+// cloudStack is a stack with its labels, which aren't supported by the Cloud API client.
+type cloudStack struct {
+	gapi.Stack
+	Labels map[string]string `json:"labels"`
+}
+
+// stackUpdate is the body of the stack update request, with the labels of the stack.
+type stackUpdate struct {
+	Name        string            `json:"name"`
+	Slug        string            `json:"slug"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+}
 
 func ResourceStack() *schema.Resource {
 	return &schema.Resource{
@@ -76,6 +97,14 @@ available at “https://<stack_slug>.grafana.net".`,
 				Optional:    true,
 				Description: "Custom URL for the Grafana instance. Must have a CNAME setup to point to `.grafana.net` before creating the stack",
 			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: fmt.Sprintf("Labels of the stack, to organize stacks and find them with the `grafana_cloud_stacks` data source. "+
+					"Label keys and values must match the `%s` regular expression, and stacks can't have more than %d labels.", stackLabelRegex, stackMaxLabels),
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateStackLabels,
+			},
 			"wait_for_readiness": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -284,6 +313,13 @@ func CreateStack(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
+	// The labels can't be set by the Cloud API client when the stack is created
+	if len(d.Get("labels").(map[string]interface{})) > 0 {
+		if err := updateStack(ctx, meta.(*common.Client), d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if diag := ReadStack(ctx, d, meta); diag != nil {
 		return diag
 	}
@@ -292,23 +328,14 @@ func CreateStack(ctx context.Context, d *schema.ResourceData, meta interface{})
 }
 
 func UpdateStack(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*common.Client).GrafanaCloudAPI
-	stackID, _ := strconv.ParseInt(d.Id(), 10, 64)
-
-	// The underlying API olnly allows to update the name and description.
-	allowedChanges := []string{"name", "description", "slug"}
+	// The underlying API olnly allows to update the name, description and labels.
+	allowedChanges := []string{"name", "description", "slug", "labels"}
 	if d.HasChangesExcept(allowedChanges...) {
-		return diag.Errorf("Error: Only name, slug and description can be updated.")
+		return diag.Errorf("Error: Only name, slug, description and labels can be updated.")
 	}
 
-	if d.HasChange("name") || d.HasChange("description") || d.HasChanges("slug") {
-		stack := &gapi.UpdateStackInput{
-			Name:        d.Get("name").(string),
-			Slug:        d.Get("slug").(string),
-			Description: d.Get("description").(string),
-		}
-		err := client.UpdateStack(stackID, stack)
-		if err != nil {
+	if d.HasChanges(allowedChanges...) {
+		if err := updateStack(ctx, meta.(*common.Client), d); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -330,8 +357,7 @@ func DeleteStack(ctx context.Context, d *schema.ResourceData, meta interface{})
 }
 
 func ReadStack(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*common.Client).GrafanaCloudAPI
-	stack, err := getStackFromIDOrSlug(client, d.Id())
+	stack, err := getStack(ctx, meta.(*common.Client), d.Id())
 	if err, shouldReturn := common.CheckReadError("stack", d, err); shouldReturn {
 		return err
 	}
@@ -342,9 +368,10 @@ func ReadStack(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		return nil
 	}
 
-	if err := FlattenStack(d, *stack); err != nil {
+	if err := FlattenStack(d, stack.Stack); err != nil {
 		return diag.FromErr(err)
 	}
+	d.Set("labels", stack.Labels)
 	// Always set the wait attribute to true after creation
 	// It no longer matters and this will prevent drift if the stack was imported
 	d.Set("wait_for_readiness", true)
@@ -404,23 +431,44 @@ func FlattenStack(d *schema.ResourceData, stack gapi.Stack) error {
 	return nil
 }
 
-func getStackFromIDOrSlug(client *gapi.Client, id string) (*gapi.Stack, error) {
-	numericalID, err := strconv.ParseInt(id, 10, 64)
-	if err != nil {
-		// If the ID is not a number, then it may be a slug
-		stack, err := client.StackBySlug(id)
-		if err != nil {
-			return nil, fmt.Errorf("failed to find stack by ID or slug '%s': %w", id, err)
-		}
-		return &stack, nil
+// getStack fetches a stack with its labels. The stack can be identified by its ID or slug.
+func getStack(ctx context.Context, client *common.Client, idOrSlug string) (*cloudStack, error) {
+	var stack cloudStack
+	if err := cloudAPIRequest(ctx, client, http.MethodGet, "/api/instances/"+idOrSlug, nil, nil, &stack); err != nil {
+		return nil, err
 	}
+	return &stack, nil
+}
 
-	stack, err := client.StackByID(numericalID)
-	if err != nil {
-		return nil, err
+// updateStack updates the name, slug, description and labels of a stack.
+func updateStack(ctx context.Context, client *common.Client, d *schema.ResourceData) error {
+	labels := map[string]string{}
+	for k, v := range d.Get("labels").(map[string]interface{}) {
+		labels[k] = v.(string)
 	}
+	body := stackUpdate{
+		Name:        d.Get("name").(string),
+		Slug:        d.Get("slug").(string),
+		Description: d.Get("description").(string),
+		Labels:      labels,
+	}
+	return cloudAPIRequest(ctx, client, http.MethodPost, "/api/instances/"+d.Id(), nil, body, nil)
+}
 
-	return &stack, nil
+func validateStackLabels(i interface{}, p cty.Path) diag.Diagnostics {
+	labels := i.(map[string]interface{})
+	if len(labels) > stackMaxLabels {
+		return diag.Errorf("stacks can't have more than %d labels, got %d", stackMaxLabels, len(labels))
+	}
+	for k, v := range labels {
+		if !stackLabelRegex.MatchString(k) {
+			return diag.Errorf("label key %q must match %s", k, stackLabelRegex)
+		}
+		if value, _ := v.(string); !stackLabelRegex.MatchString(value) {
+			return diag.Errorf("value %q of label %q must match %s", value, k, stackLabelRegex)
+		}
+	}
+	return nil
 }
 
 // Append path to baseurl
@@ -0,0 +1,221 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultPluginInstallationTimeout = 5 * time.Minute
+
+func ResourceStackPlugins() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Manages the plugins installed on a Grafana Cloud stack, as a whole.
+All the installations, upgrades and uninstallations are applied at once, then the provider waits for Grafana Cloud to complete them.
+If some of them fail, the plugins actually installed are kept in the state: a failed update is planned again, and a failed creation is replaced, uninstalling the plugins it installed.
+Plugins that are installed on the stack but aren't in the ` + "`plugins`" + ` map are left untouched: an empty map manages no plugins.
+This resource shouldn't be used along with ` + "`grafana_cloud_plugin_installation`" + ` resources managing the same plugins.
+
+* [Plugin Catalog](https://grafana.com/grafana/plugins/)
+`,
+		CreateContext: ResourceStackPluginsCreate,
+		ReadContext:   ResourceStackPluginsRead,
+		UpdateContext: ResourceStackPluginsUpdate,
+		DeleteContext: ResourceStackPluginsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStackPluginsState,
+		},
+		Schema: map[string]*schema.Schema{
+			"stack_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the stack to install the plugins on.",
+			},
+			"plugins": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Description: "Map of the slugs of the plugins to install to their versions. When imported, all the plugins installed on the stack are managed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"installation_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          defaultPluginInstallationTimeout.String(),
+				ValidateDiagFunc: common.ValidateDuration,
+				Description:      "How long to wait for Grafana Cloud to complete the installations and upgrades.",
+			},
+		},
+	}
+}
+
+func ResourceStackPluginsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	stackSlug := d.Get("stack_slug").(string)
+
+	err := reconcileCloudStackPlugins(ctx, d, meta.(*common.Client), stackSlug, nil, d.Get("plugins").(map[string]interface{}))
+
+	// The plugins which were installed before an error are kept in the state, so that they're uninstalled when the resource is replaced
+	d.SetId(stackSlug)
+	return append(diag.FromErr(err), ResourceStackPluginsRead(ctx, d, meta)...)
+}
+
+func ResourceStackPluginsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	stackSlug := d.Id()
+
+	installations, err := listCloudStackPlugins(ctx, meta.(*common.Client), stackSlug)
+	if err, shouldReturn := common.CheckReadError("stack plugins", d, err); shouldReturn {
+		return err
+	}
+
+	// Only the managed plugins are read. An empty map manages no plugins.
+	managed := d.Get("plugins").(map[string]interface{})
+	plugins := map[string]interface{}{}
+	for _, installation := range installations {
+		if _, ok := managed[installation.PluginSlug]; ok {
+			plugins[installation.PluginSlug] = installation.Version
+		}
+	}
+
+	d.Set("stack_slug", stackSlug)
+	d.Set("plugins", plugins)
+	if d.Get("installation_timeout").(string) == "" {
+		d.Set("installation_timeout", defaultPluginInstallationTimeout.String())
+	}
+
+	return nil
+}
+
+// ImportStackPluginsState manages all the plugins installed on the stack, since the plugins of the configuration aren't known on import.
+func ImportStackPluginsState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	installations, err := listCloudStackPlugins(ctx, meta.(*common.Client), d.Id())
+	if err != nil {
+		return nil, err
+	}
+	plugins := map[string]interface{}{}
+	for _, installation := range installations {
+		plugins[installation.PluginSlug] = installation.Version
+	}
+	if err := d.Set("plugins", plugins); err != nil {
+		return nil, fmt.Errorf("failed to set plugins: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func ResourceStackPluginsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("plugins") {
+		oldPlugins, newPlugins := d.GetChange("plugins")
+		if err := reconcileCloudStackPlugins(ctx, d, meta.(*common.Client), d.Id(), oldPlugins.(map[string]interface{}), newPlugins.(map[string]interface{})); err != nil {
+			// The versions actually installed are read into the state, so that the failed changes are planned again
+			return append(diag.FromErr(err), ResourceStackPluginsRead(ctx, d, meta)...)
+		}
+	}
+
+	return ResourceStackPluginsRead(ctx, d, meta)
+}
+
+func ResourceStackPluginsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := reconcileCloudStackPlugins(ctx, d, meta.(*common.Client), d.Id(), d.Get("plugins").(map[string]interface{}), nil)
+	return diag.FromErr(err)
+}
+
+// reconcileCloudStackPlugins applies the difference between the old and new plugin versions to the stack,
+// then waits for the installations and upgrades to be completed.
+// All the changes are attempted, errors are reported together.
+func reconcileCloudStackPlugins(ctx context.Context, d *schema.ResourceData, client *common.Client, stackSlug string, oldPlugins, newPlugins map[string]interface{}) error {
+	var errs []string
+	for _, slug := range sortedKeys(oldPlugins) {
+		if _, ok := newPlugins[slug]; ok {
+			continue
+		}
+		err := cloudAPIRequest(ctx, client, http.MethodDelete, "/api/instances/"+stackSlug+"/plugins/"+slug, nil, nil, nil)
+		if err != nil && !common.IsNotFoundError(err) {
+			errs = append(errs, fmt.Sprintf("failed to uninstall plugin %q: %s", slug, err))
+		}
+	}
+
+	for _, slug := range sortedKeys(newPlugins) {
+		version := newPlugins[slug].(string)
+		oldVersion, installed := oldPlugins[slug]
+		switch {
+		case !installed:
+			body := map[string]string{"plugin": slug, "version": version}
+			if err := cloudAPIRequest(ctx, client, http.MethodPost, "/api/instances/"+stackSlug+"/plugins", nil, body, nil); err != nil {
+				errs = append(errs, fmt.Sprintf("failed to install plugin %q: %s", slug, err))
+			}
+		case oldVersion.(string) != version:
+			body := map[string]string{"version": version}
+			if err := cloudAPIRequest(ctx, client, http.MethodPost, "/api/instances/"+stackSlug+"/plugins/"+slug, nil, body, nil); err != nil {
+				errs = append(errs, fmt.Sprintf("failed to upgrade plugin %q to version %s: %s", slug, version, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	if len(newPlugins) == 0 {
+		return nil
+	}
+	return waitForCloudStackPlugins(ctx, d, client, stackSlug, newPlugins)
+}
+
+// waitForCloudStackPlugins retries until all the given plugins are installed on the stack, in the given versions.
+// Grafana Cloud installs the plugins asynchronously, an installation is complete once it's listed without a status.
+func waitForCloudStackPlugins(ctx context.Context, d *schema.ResourceData, client *common.Client, stackSlug string, plugins map[string]interface{}) error {
+	timeout := defaultPluginInstallationTimeout
+	if timeoutVal := d.Get("installation_timeout").(string); timeoutVal != "" {
+		timeout, _ = time.ParseDuration(timeoutVal)
+	}
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		installations, err := listCloudStackPlugins(ctx, client, stackSlug)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		installed := map[string]cloudStackPlugin{}
+		for _, installation := range installations {
+			installed[installation.PluginSlug] = installation
+		}
+
+		var pending []string
+		for _, slug := range sortedKeys(plugins) {
+			installation, ok := installed[slug]
+			switch {
+			case !ok:
+				pending = append(pending, fmt.Sprintf("%s (not installed)", slug))
+			case installation.Version != plugins[slug].(string):
+				pending = append(pending, fmt.Sprintf("%s (version %s installed)", slug, installation.Version))
+			case installation.Status != "" && installation.Status != "installed":
+				pending = append(pending, fmt.Sprintf("%s (%s)", slug, installation.Status))
+			}
+		}
+		if len(pending) > 0 {
+			return retry.RetryableError(fmt.Errorf("plugins were not installed in %s: %s", timeout, strings.Join(pending, ", ")))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for the plugins to be installed: %w", err)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,89 @@
+package cloud_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceStackPlugins(t *testing.T) {
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	slug := os.Getenv("GRAFANA_CLOUD_ORG")
+	pluginSlug := "aws-datasource-provisioner-app"
+	otherPluginSlug := "grafana-clock-panel"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCloudPluginDeleteExisting(t, slug, pluginSlug)
+			testAccCloudPluginDeleteExisting(t, slug, otherPluginSlug)
+		},
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrafanaCloudStackPlugins(slug, map[string]string{pluginSlug: "1.7.0", otherPluginSlug: "2.1.3"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudPluginInstallationCheckExists("grafana_cloud_stack_plugins.test", slug, pluginSlug),
+					testAccCloudPluginInstallationCheckExists("grafana_cloud_stack_plugins.test", slug, otherPluginSlug),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_plugins.test", "id", slug),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_plugins.test", "plugins.%", "2"),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_plugins.test", "plugins."+pluginSlug, "1.7.0"),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_plugins.test", "plugins."+otherPluginSlug, "2.1.3"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stack_plugins.test", "versions."+pluginSlug, "1.7.0"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stack_plugins.test", "versions."+otherPluginSlug, "2.1.3"),
+				),
+			},
+			// Upgrade one plugin and uninstall the other one in the same apply
+			{
+				Config: testAccGrafanaCloudStackPlugins(slug, map[string]string{pluginSlug: "1.8.0"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudPluginInstallationCheckExists("grafana_cloud_stack_plugins.test", slug, pluginSlug),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_plugins.test", "plugins.%", "1"),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_plugins.test", "plugins."+pluginSlug, "1.8.0"),
+					resource.TestCheckResourceAttr("data.grafana_cloud_stack_plugins.test", "versions."+pluginSlug, "1.8.0"),
+					resource.TestCheckNoResourceAttr("data.grafana_cloud_stack_plugins.test", "versions."+otherPluginSlug),
+				),
+			},
+			// An empty map uninstalls the managed plugins and leaves the other plugins of the stack untouched
+			{
+				Config: testAccGrafanaCloudStackPlugins(slug, map[string]string{}) + fmt.Sprintf(`
+		resource "grafana_cloud_plugin_installation" "other" {
+			stack_slug = "%s"
+			slug       = "%s"
+			version    = "2.1.3"
+		}
+	`, slug, otherPluginSlug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudPluginInstallationDestroy(slug, pluginSlug),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_plugins.test", "plugins.%", "0"),
+				),
+			},
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCloudPluginInstallationDestroy(slug, pluginSlug),
+			testAccCloudPluginInstallationDestroy(slug, otherPluginSlug),
+		),
+	})
+}
+
+func testAccGrafanaCloudStackPlugins(stackSlug string, plugins map[string]string) string {
+	pluginsConfig := ""
+	for slug, version := range plugins {
+		pluginsConfig += fmt.Sprintf("\t\t\t\t%q = %q\n", slug, version)
+	}
+	return fmt.Sprintf(`
+		resource "grafana_cloud_stack_plugins" "test" {
+			stack_slug = "%[1]s"
+			plugins = {
+%[2]s			}
+		}
+
+		data "grafana_cloud_stack_plugins" "test" {
+			stack_slug = "%[1]s"
+			depends_on = [grafana_cloud_stack_plugins.test]
+		}
+	`, stackSlug, pluginsConfig)
+}
@@ -47,8 +47,8 @@ This can be used to bootstrap a management service account for a new stack
 			"role": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin"}, false),
-				Description:  "The basic role of the service account in the organization.",
+				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin", "None"}, false),
+				Description:  "The basic role of the service account in the organization. `None` is for service accounts whose permissions are only granted by RBAC roles.",
 			},
 			"is_disabled": {
 				Type:        schema.TypeBool,
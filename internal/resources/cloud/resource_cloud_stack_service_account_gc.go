@@ -0,0 +1,239 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	gapi "github.com/grafana/grafana-api-golang-client"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// temporaryServiceAccountLifetime is the lifetime of the tokens of the temporary service accounts created to manage a stack.
+const temporaryServiceAccountLifetime = 60 * time.Second
+
+// temporaryServiceAccountRegexp matches the names of the temporary service accounts created by the provider to manage a stack,
+// i.e. the `terraform-temp-`, `terraform-temp-sa-`, `terraform-temp-sa-token-` and `terraform-temp-sa-gc-` prefixes
+// followed by their creation time in nanoseconds.
+var temporaryServiceAccountRegexp = regexp.MustCompile(`^terraform-temp-(?:sa-(?:token-|gc-)?)?(\d+)$`)
+
+func ResourceStackServiceAccountGC() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+Removes the temporary service accounts left over in a Grafana Cloud stack by the provider.
+
+The provider creates a temporary Admin service account, with a token valid for a minute, to manage the service accounts and API keys of a stack through the Cloud API.
+It's deleted at the end of each operation, but it's left over when the operation is interrupted, e.g. when an apply fails or is cancelled.
+The left over service accounts, whose names are ` + "`terraform-temp-`" + `, ` + "`terraform-temp-sa-`" + `, ` + "`terraform-temp-sa-token-`" + ` or ` + "`terraform-temp-sa-gc-`" + ` followed by their creation time, are listed in ` + "`orphaned_service_accounts`" + ` when the resource is refreshed,
+and deleted by the next apply.
+
+Destroying this resource removes it from the state, but doesn't delete any service account.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/service-accounts/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/serviceaccount/#service-account-api)`,
+
+		CreateContext: gcStackServiceAccounts,
+		ReadContext:   readStackServiceAccountGC,
+		UpdateContext: gcStackServiceAccounts,
+		DeleteContext: deleteStackServiceAccountGC,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// The orphaned service accounts found by the refresh are deleted by the apply
+			if len(d.Get("orphaned_service_accounts").([]interface{})) > 0 {
+				return d.SetNewComputed("orphaned_service_accounts")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"stack_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the stack whose temporary service accounts are deleted.",
+			},
+			"min_age": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "1h",
+				ValidateDiagFunc: validateServiceAccountGCMinAge,
+				Description: "The minimum age of the temporary service accounts to delete, as a duration. " +
+					"Younger service accounts may still be used by an operation in progress. It can't be less than a minute, the lifetime of their tokens.",
+			},
+			"orphaned_service_accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the temporary service accounts older than `min_age` found in the stack, which are deleted by the next apply.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func gcStackServiceAccounts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, cleanup, err := getClientForSAGC(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer cleanup()
+
+	minAge, _ := time.ParseDuration(d.Get("min_age").(string))
+	orphans, err := orphanedStackServiceAccounts(client, minAge)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, sa := range orphans {
+		log.Printf("[INFO] deleting temporary service account %s (%d) of stack %s", sa.Name, sa.ID, d.Get("stack_slug").(string))
+		if _, err := client.ServiceAccounts.DeleteServiceAccount(sa.ID); err != nil {
+			return diag.Errorf("failed to delete temporary service account %s: %s", sa.Name, err)
+		}
+	}
+
+	d.SetId(d.Get("stack_slug").(string))
+	return readStackServiceAccountGC(ctx, d, meta)
+}
+
+func readStackServiceAccountGC(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.Set("stack_slug", d.Id())
+	client, cleanup, err := getClientForSAGC(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer cleanup()
+
+	minAge, err := time.ParseDuration(d.Get("min_age").(string))
+	if err != nil {
+		// The min age isn't set when the resource is imported
+		minAge = time.Hour
+		d.Set("min_age", "1h")
+	}
+	orphans, err := orphanedStackServiceAccounts(client, minAge)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	names := make([]string, 0, len(orphans))
+	for _, sa := range orphans {
+		names = append(names, sa.Name)
+	}
+	d.Set("orphaned_service_accounts", names)
+	return nil
+}
+
+func deleteStackServiceAccountGC(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// orphanedStackServiceAccounts returns the temporary service accounts of a stack created more than minAge ago, sorted by name.
+// The service accounts are listed page by page, since a stack may have more of them than a single page holds.
+func orphanedStackServiceAccounts(client *goapi.GrafanaHTTPAPI, minAge time.Duration) ([]*models.ServiceAccountDTO, error) {
+	var sas []*models.ServiceAccountDTO
+	var page, perPage int64 = 1, 500
+	for {
+		params := service_accounts.NewSearchOrgServiceAccountsWithPagingParams().WithPage(&page).WithPerpage(&perPage)
+		resp, err := client.ServiceAccounts.SearchOrgServiceAccountsWithPaging(params)
+		if err != nil {
+			return nil, err
+		}
+		sas = append(sas, resp.Payload.ServiceAccounts...)
+		if len(resp.Payload.ServiceAccounts) < int(perPage) || int64(len(sas)) >= resp.Payload.TotalCount {
+			break
+		}
+		page++
+	}
+	return filterOrphanedServiceAccounts(sas, minAge, time.Now()), nil
+}
+
+func filterOrphanedServiceAccounts(sas []*models.ServiceAccountDTO, minAge time.Duration, now time.Time) []*models.ServiceAccountDTO {
+	var orphans []*models.ServiceAccountDTO
+	for _, sa := range sas {
+		match := temporaryServiceAccountRegexp.FindStringSubmatch(sa.Name)
+		if match == nil {
+			continue
+		}
+		created, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if now.Sub(time.Unix(0, created)) >= minAge {
+			orphans = append(orphans, sa)
+		}
+	}
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i].Name < orphans[j].Name })
+	return orphans
+}
+
+func validateServiceAccountGCMinAge(i interface{}, p cty.Path) diag.Diagnostics {
+	v := i.(string)
+	minAge, err := time.ParseDuration(v)
+	if err != nil {
+		return diag.Errorf("%q is not a valid duration: %s", v, err)
+	}
+	if minAge < temporaryServiceAccountLifetime {
+		return diag.Errorf("min_age must be at least %s, got %q", temporaryServiceAccountLifetime, v)
+	}
+	return nil
+}
+
+// getClientForSAGC creates a temporary service account in the stack, like CreateTemporaryStackGrafanaClient does,
+// but returns an OpenAPI client authenticated with its token, since the client of the Cloud API can't list the service accounts page by page.
+func getClientForSAGC(d *schema.ResourceData, m interface{}) (c *goapi.GrafanaHTTPAPI, cleanup func() error, err error) {
+	// The temporary service account of the garbage collection is younger than the minimum age, so it's never deleted by it
+	cloudClient := m.(*common.Client).GrafanaCloudAPI
+	stackSlug := d.Get("stack_slug").(string)
+	stack, err := cloudClient.StackBySlug(stackSlug)
+	if err != nil {
+		return nil, nil, err
+	}
+	stackURL, err := url.Parse(stack.URL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid URL of stack %s: %w", stackSlug, err)
+	}
+	apiPath, err := url.JoinPath(stackURL.Path, "api")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	name := fmt.Sprintf("terraform-temp-sa-gc-%d", time.Now().UnixNano())
+	sa, err := cloudClient.CreateGrafanaServiceAccountFromCloud(stackSlug, &gapi.CreateServiceAccountRequest{Name: name, Role: "Admin"})
+	if err != nil {
+		return nil, nil, err
+	}
+	token, err := cloudClient.CreateGrafanaServiceAccountTokenFromCloud(stackSlug, &gapi.CreateServiceAccountTokenRequest{
+		Name:             name,
+		ServiceAccountID: sa.ID,
+		SecondsToLive:    int64(temporaryServiceAccountLifetime.Seconds()),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c = goapi.NewHTTPClientWithConfig(strfmt.Default, &goapi.TransportConfig{
+		Host:     stackURL.Host,
+		BasePath: apiPath,
+		Schemes:  []string{stackURL.Scheme},
+		APIKey:   token.Key,
+	})
+	cleanup = func() error {
+		_, err := c.ServiceAccounts.DeleteServiceAccount(sa.ID)
+		return err
+	}
+	return c, cleanup, nil
+}
@@ -0,0 +1,62 @@
+package cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+func TestOrphanedStackServiceAccountsPages(t *testing.T) {
+	old := time.Now().Add(-2 * time.Hour).UnixNano()
+	var sas []*models.ServiceAccountDTO
+	for i := 0; i < 1200; i++ {
+		sas = append(sas, &models.ServiceAccountDTO{ID: int64(i), Name: fmt.Sprintf("sa-%d", i)})
+	}
+	// The orphans are on the last page
+	sas = append(sas, &models.ServiceAccountDTO{ID: 2000, Name: fmt.Sprintf("terraform-temp-sa-%d", old)})
+	sas = append(sas, &models.ServiceAccountDTO{ID: 2001, Name: fmt.Sprintf("terraform-temp-%d", time.Now().UnixNano())})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("perpage"))
+		start, end := (page-1)*perPage, page*perPage
+		if start > len(sas) {
+			start = len(sas)
+		}
+		if end > len(sas) {
+			end = len(sas)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(models.SearchOrgServiceAccountsResult{
+			ServiceAccounts: sas[start:end],
+			Page:            int64(page),
+			PerPage:         int64(perPage),
+			TotalCount:      int64(len(sas)),
+		})
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	client := goapi.NewHTTPClientWithConfig(strfmt.Default, &goapi.TransportConfig{
+		Host:     serverURL.Host,
+		BasePath: "/api",
+		Schemes:  []string{"http"},
+	})
+
+	orphans, err := orphanedStackServiceAccounts(client, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orphans) != 1 || orphans[0].ID != 2000 {
+		t.Errorf("expected the old temporary service account of the last page to be orphaned, got %+v", orphans)
+	}
+}
@@ -0,0 +1,74 @@
+package cloud_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGrafanaServiceAccountGCFromCloud(t *testing.T) {
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	var stack gapi.Stack
+	prefix := "tfsagctest"
+	slug := GetRandomStackName(prefix)
+	// The name of a temporary service account left over by an interrupted operation, created at the Unix epoch
+	orphan := "terraform-temp-sa-1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccDeleteExistingStacks(t, prefix)
+		},
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccStackCheckDestroy(&stack),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfigBasic(slug, slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStackCheckExists("grafana_cloud_stack.test", &stack),
+					func(s *terraform.State) error {
+						cloudClient := testutils.Provider.Meta().(*common.Client).GrafanaCloudAPI
+						_, err := cloudClient.CreateGrafanaServiceAccountFromCloud(slug, &gapi.CreateServiceAccountRequest{Name: orphan, Role: "Viewer"})
+						return err
+					},
+				),
+			},
+			{
+				Config: testAccStackConfigBasic(slug, slug) + `
+				resource "grafana_cloud_stack_service_account_gc" "test" {
+					stack_slug = grafana_cloud_stack.test.slug
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_cloud_stack_service_account_gc.test", "min_age", "1h"),
+					resource.TestCheckResourceAttr("grafana_cloud_stack_service_account_gc.test", "orphaned_service_accounts.#", "0"),
+					func(s *terraform.State) error {
+						cloudClient := testutils.Provider.Meta().(*common.Client).GrafanaCloudAPI
+						c, cleanup, err := cloudClient.CreateTemporaryStackGrafanaClient(slug, "test-service-account-", 60*time.Second)
+						if err != nil {
+							return err
+						}
+						defer cleanup()
+
+						sas, err := c.GetServiceAccounts()
+						if err != nil {
+							return err
+						}
+						for _, sa := range sas {
+							if sa.Name == orphan {
+								return fmt.Errorf("expected the temporary service account %s to be deleted", orphan)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package cloud_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -40,6 +41,10 @@ func TestAccGrafanaServiceAccountFromCloud(t *testing.T) {
 					resource.TestCheckResourceAttrSet("grafana_cloud_stack_service_account_token.management_token", "key"),
 				),
 			},
+			{
+				Config:      strings.Replace(testAccGrafanaServiceAccountFromCloud(slug, slug), `name       = "management-sa-token"`, `name       = "management-sa-token"`+"\n\t\tstore_key  = false", 1),
+				ExpectError: regexp.MustCompile("`vault_secret` must be set when `store_key` is false"),
+			},
 			{
 				Config: testAccStackConfigBasic(slug, slug),
 				Check:  testAccGrafanaServiceAccountCheckDestroyCloud,
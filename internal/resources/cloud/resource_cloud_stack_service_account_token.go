@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -21,11 +22,21 @@ Manages service account tokens of a Grafana Cloud stack using the Cloud API
 This can be used to bootstrap a management service account token for a new stack
 
 * [Official documentation](https://grafana.com/docs/grafana/latest/administration/service-accounts/)
-* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/serviceaccount/#service-account-api)`,
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/serviceaccount/#service-account-api)
+
+The token can be written to a secret of a Vault KV version 2 secrets engine with ` + "`vault_secret`" + `, and kept out of the Terraform state with ` + "`store_key = false`" + `.
+Providers configured with the token then read it from Vault, e.g. with the ` + "`vault_kv_secret_v2`" + ` data source of the Vault provider.`,
 
 		CreateContext: stackServiceAccountTokenCreate,
 		ReadContext:   stackServiceAccountTokenRead,
+		UpdateContext: stackServiceAccountTokenUpdate,
 		DeleteContext: stackServiceAccountTokenDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.Get("store_key").(bool) && len(d.Get("vault_secret").([]interface{})) == 0 {
+				return fmt.Errorf("`vault_secret` must be set when `store_key` is false, otherwise the token can't be retrieved")
+			}
+			return nil
+		},
 
 		Schema: map[string]*schema.Schema{
 			"stack_slug": {
@@ -48,6 +59,39 @@ This can be used to bootstrap a management service account token for a new stack
 				Optional: true,
 				ForceNew: true,
 			},
+			"store_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Store the token in the `key` attribute, and so in the Terraform state. Set to `false` to only write it to `vault_secret`. Setting it to `false` on an existing token removes the token from the state.",
+			},
+			"vault_secret": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "A secret of a Vault KV version 2 secrets engine to write the token to, when it's created. The other fields of the secret are kept. Changing it doesn't write an existing token again. " +
+					"The Vault server and token are read from the `VAULT_ADDR`, `VAULT_TOKEN` and `VAULT_NAMESPACE` environment variables, and its TLS configuration from `VAULT_CACERT`, `VAULT_CAPATH` and `VAULT_SKIP_VERIFY`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mount": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path of the KV version 2 secrets engine, e.g. `secret`.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path of the secret in the secrets engine, e.g. `grafana/my-stack`.",
+						},
+						"field": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "token",
+							Description: "The field of the secret to write the token to.",
+						},
+					},
+				},
+			},
 			"key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -90,10 +134,23 @@ func stackServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if secrets := d.Get("vault_secret").([]interface{}); len(secrets) > 0 {
+		secret := secrets[0].(map[string]interface{})
+		if err := writeVaultKVSecretField(ctx, secret["mount"].(string), secret["path"].(string), secret["field"].(string), response.Key); err != nil {
+			// The token can't be retrieved anymore, delete it so that it's created again on the next apply
+			if _, deleteErr := c.DeleteServiceAccountToken(serviceAccountID, response.ID); deleteErr != nil {
+				log.Printf("[WARN] failed to delete service account token %d: %v", response.ID, deleteErr)
+			}
+			return diag.Errorf("failed to write the service account token to Vault: %v", err)
+		}
+	}
+
 	d.SetId(strconv.FormatInt(response.ID, 10))
-	err = d.Set("key", response.Key)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.Get("store_key").(bool) {
+		err = d.Set("key", response.Key)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	// Fill the true resource's state by performing a read
@@ -146,6 +203,16 @@ func stackServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// stackServiceAccountTokenUpdate only updates the attributes that are used when the token is created. The token can't be retrieved again.
+func stackServiceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("store_key").(bool) {
+		if err := d.Set("key", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return stackServiceAccountTokenRead(ctx, d, m)
+}
+
 func stackServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c, cleanup, err := getClientForSATokenManagement(d, m)
 	if err != nil {
@@ -93,6 +93,8 @@ func TestResourceStack_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_cloud_stack.test", "name", resourceName+"new"),
 					resource.TestCheckResourceAttr("grafana_cloud_stack.test", "slug", resourceName),
 					resource.TestCheckResourceAttr("grafana_cloud_stack.test", "description", stackDescription),
+					resource.TestCheckResourceAttr("grafana_cloud_stack.test", "labels.%", "1"),
+					resource.TestCheckResourceAttr("grafana_cloud_stack.test", "labels.team", "platform"),
 					resource.TestCheckResourceAttr("grafana_cloud_stack.test", "status", "active"),
 				),
 			},
@@ -190,6 +192,9 @@ func testAccStackConfigUpdate(name string, slug string, description string) stri
 		slug  = "%s"
 		region_slug = "eu"
 		description = "%s"
+		labels = {
+			team = "platform"
+		}
 	  }
 	`, name, slug, description)
 }
@@ -0,0 +1,167 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const vaultRequestTimeout = 30 * time.Second
+
+var (
+	// The Vault client is built once from the environment, so that its connections are reused across the tokens.
+	defaultVaultClientOnce sync.Once
+	defaultVaultClient     *vaultClient
+	defaultVaultClientErr  error
+)
+
+// vaultClient is a client of the Vault HTTP API.
+type vaultClient struct {
+	addr      *url.URL
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+// vaultStatusError is returned when Vault responds with an error status.
+type vaultStatusError struct {
+	statusCode int
+	body       string
+}
+
+func (e *vaultStatusError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.statusCode, e.body)
+}
+
+// writeVaultKVSecretField sets a field of a secret of a Vault KV version 2 secrets engine, keeping the other fields of the secret.
+// The Vault server and the credentials are read from the `VAULT_ADDR`, `VAULT_TOKEN`, `VAULT_NAMESPACE`, `VAULT_CACERT`, `VAULT_CAPATH`
+// and `VAULT_SKIP_VERIFY` environment variables, like the Vault CLI does.
+func writeVaultKVSecretField(ctx context.Context, mount, path, field, value string) error {
+	defaultVaultClientOnce.Do(func() {
+		defaultVaultClient, defaultVaultClientErr = newVaultClientFromEnv()
+	})
+	if defaultVaultClientErr != nil {
+		return defaultVaultClientErr
+	}
+	return defaultVaultClient.writeKVSecretField(ctx, mount, path, field, value)
+}
+
+func newVaultClientFromEnv() (*vaultClient, error) {
+	addr, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		return nil, fmt.Errorf("the VAULT_ADDR and VAULT_TOKEN environment variables must be set to store secrets in Vault")
+	}
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAULT_ADDR: %w", err)
+	}
+
+	tlsConfig := &tls.Config{}
+	if v := os.Getenv("VAULT_SKIP_VERIFY"); v != "" {
+		skipVerify, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VAULT_SKIP_VERIFY: %w", err)
+		}
+		tlsConfig.InsecureSkipVerify = skipVerify
+	}
+	// Like the Vault CLI, VAULT_CACERT takes precedence over VAULT_CAPATH
+	if caCert, caPath := os.Getenv("VAULT_CACERT"), os.Getenv("VAULT_CAPATH"); caCert != "" {
+		if tlsConfig.RootCAs, err = loadVaultCACerts([]string{caCert}); err != nil {
+			return nil, fmt.Errorf("invalid VAULT_CACERT: %w", err)
+		}
+	} else if caPath != "" {
+		entries, err := os.ReadDir(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VAULT_CAPATH: %w", err)
+		}
+		var files []string
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				files = append(files, filepath.Join(caPath, entry.Name()))
+			}
+		}
+		if tlsConfig.RootCAs, err = loadVaultCACerts(files); err != nil {
+			return nil, fmt.Errorf("invalid VAULT_CAPATH: %w", err)
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &vaultClient{
+		addr:      u,
+		token:     token,
+		namespace: os.Getenv("VAULT_NAMESPACE"),
+		client:    &http.Client{Timeout: vaultRequestTimeout, Transport: transport},
+	}, nil
+}
+
+func loadVaultCACerts(files []string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, file := range files {
+		pem, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificate found in %s", file)
+		}
+	}
+	return pool, nil
+}
+
+func (c *vaultClient) writeKVSecretField(ctx context.Context, mount, path, field, value string) error {
+	u := c.addr.JoinPath("v1", strings.Trim(mount, "/"), "data", strings.Trim(path, "/")).String()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"data": map[string]string{field: value},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Patching keeps the other fields of the secret, but fails when the secret doesn't exist yet
+	err = c.request(ctx, http.MethodPatch, u, "application/merge-patch+json", body)
+	var statusErr *vaultStatusError
+	if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+		err = c.request(ctx, http.MethodPost, u, "application/json", body)
+	}
+	return err
+}
+
+func (c *vaultClient) request(ctx context.Context, method, u, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", c.token)
+	req.Header.Set("Content-Type", contentType)
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return &vaultStatusError{statusCode: resp.StatusCode, body: string(respBody)}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package cloud
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVaultClientWriteKVSecretField(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		statuses        map[string]int
+		expectedMethods []string
+		expectedStatus  int
+	}{
+		{
+			name:            "patch",
+			statuses:        map[string]int{http.MethodPatch: http.StatusOK},
+			expectedMethods: []string{http.MethodPatch},
+		},
+		{
+			name:            "create when the secret doesn't exist",
+			statuses:        map[string]int{http.MethodPatch: http.StatusNotFound, http.MethodPost: http.StatusOK},
+			expectedMethods: []string{http.MethodPatch, http.MethodPost},
+		},
+		{
+			name:            "patch error",
+			statuses:        map[string]int{http.MethodPatch: http.StatusForbidden},
+			expectedMethods: []string{http.MethodPatch},
+			expectedStatus:  http.StatusForbidden,
+		},
+		{
+			name:            "create error",
+			statuses:        map[string]int{http.MethodPatch: http.StatusNotFound, http.MethodPost: http.StatusInternalServerError},
+			expectedMethods: []string{http.MethodPatch, http.MethodPost},
+			expectedStatus:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var methods []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				if r.URL.Path != "/v1/secret/data/grafana/token" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if token := r.Header.Get("X-Vault-Token"); token != "my-token" {
+					t.Errorf("unexpected token %s", token)
+				}
+				if namespace := r.Header.Get("X-Vault-Namespace"); namespace != "my-namespace" {
+					t.Errorf("unexpected namespace %s", namespace)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"data":{"key":"value"}}` {
+					t.Errorf("unexpected body %s", body)
+				}
+				w.WriteHeader(tc.statuses[r.Method])
+				w.Write([]byte(`{"errors":[]}`))
+			}))
+			defer server.Close()
+
+			addr, _ := url.Parse(server.URL)
+			client := &vaultClient{addr: addr, token: "my-token", namespace: "my-namespace", client: server.Client()}
+			err := client.writeKVSecretField(context.Background(), "/secret/", "grafana/token", "key", "value")
+
+			if strings.Join(methods, ",") != strings.Join(tc.expectedMethods, ",") {
+				t.Errorf("expected the %v requests, got %v", tc.expectedMethods, methods)
+			}
+			if tc.expectedStatus == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var statusErr *vaultStatusError
+			if !errors.As(err, &statusErr) || statusErr.statusCode != tc.expectedStatus {
+				t.Fatalf("expected a %d status error, got %v", tc.expectedStatus, err)
+			}
+		})
+	}
+}
+
+func TestNewVaultClientFromEnv(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caPath := t.TempDir()
+	caCert := filepath.Join(caPath, "ca.pem")
+	if err := os.WriteFile(caCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name          string
+		env           map[string]string
+		expectedError string
+	}{
+		{
+			name:          "missing token",
+			env:           map[string]string{"VAULT_TOKEN": ""},
+			expectedError: "the VAULT_ADDR and VAULT_TOKEN environment variables must be set",
+		},
+		{
+			name:          "untrusted certificate",
+			expectedError: "certificate",
+		},
+		{
+			name: "CA certificate",
+			env:  map[string]string{"VAULT_CACERT": caCert},
+		},
+		{
+			name: "CA path",
+			env:  map[string]string{"VAULT_CAPATH": caPath},
+		},
+		{
+			name: "skip verify",
+			env:  map[string]string{"VAULT_SKIP_VERIFY": "true"},
+		},
+		{
+			name:          "invalid skip verify",
+			env:           map[string]string{"VAULT_SKIP_VERIFY": "maybe"},
+			expectedError: "invalid VAULT_SKIP_VERIFY",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("VAULT_ADDR", server.URL)
+			t.Setenv("VAULT_TOKEN", "my-token")
+			for _, key := range []string{"VAULT_NAMESPACE", "VAULT_CACERT", "VAULT_CAPATH", "VAULT_SKIP_VERIFY"} {
+				t.Setenv(key, "")
+			}
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			client, err := newVaultClientFromEnv()
+			if err == nil {
+				err = client.writeKVSecretField(context.Background(), "secret", "grafana/token", "key", "value")
+			}
+			if tc.expectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedError)) {
+				t.Fatalf("expected an error containing %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// validateContactPointImages checks at plan time that Grafana can include images in the notifications of the contact point,
+// according to the provider's `alerting_images` attribute.
+func validateContactPointImages(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*common.Client)
+	if !ok || (client.AlertingImages != common.AlertingImagesWarn && client.AlertingImages != common.AlertingImagesFail) {
+		return nil
+	}
+	if !contactPointUsesImages(d.GetRawConfig()) {
+		return nil
+	}
+
+	problem, err := client.GrafanaAlertingImagesProblem()
+	if err != nil {
+		log.Printf("[WARN] could not check whether Grafana can include images in notifications: %v", err)
+		return nil
+	}
+	if problem == "" {
+		return nil
+	}
+	if client.AlertingImages == common.AlertingImagesFail {
+		return fmt.Errorf("the notifications of contact point %q won't include images: %s", d.Get("name").(string), problem)
+	}
+	logPlanWarning("the notifications of contact point %q won't include images: %s", d.Get("name").(string), problem)
+	return nil
+}
+
+// contactPointImagesWarnings returns a warning if Grafana can't include images in the notifications of the contact point, and the provider is in `warn` mode.
+func contactPointImagesWarnings(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := meta.(*common.Client)
+	if !ok || client.AlertingImages != common.AlertingImagesWarn || !contactPointUsesImages(d.GetRawConfig()) {
+		return nil
+	}
+
+	problem, err := client.GrafanaAlertingImagesProblem()
+	if err != nil || problem == "" {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The notifications of contact point %q won't include images", d.Get("name").(string)),
+		Detail:   problem,
+	}}
+}
+
+// imageNotifiers are the notifiers which include the images of the alerts in their notifications, attached or as a URL.
+var imageNotifiers = []string{"discord", "email", "googlechat", "opsgenie", "pagerduty", "pushover", "slack", "teams", "telegram", "webhook"}
+
+// contactPointUsesImages returns whether a notifier of the given config may include images, i.e. is one of imageNotifiers and doesn't set `upload_image = false`.
+func contactPointUsesImages(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	for _, field := range imageNotifiers {
+		v := config.GetAttr(field)
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		for it := v.ElementIterator(); it.Next(); {
+			_, notifier := it.Element()
+			if notifier.IsNull() || !notifier.IsKnown() {
+				continue
+			}
+			if !notifier.Type().HasAttribute("upload_image") {
+				return true
+			}
+			if uploadImage := notifier.GetAttr("upload_image"); !uploadImage.IsKnown() || uploadImage.IsNull() || uploadImage.True() {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// notifierURLProbeTimeout is the timeout of the requests checking that the URLs of the notifiers are reachable.
+const notifierURLProbeTimeout = 5 * time.Second
+
+// notifierURLAttributes are the attributes of the notifiers which are URLs of endpoints the notifications are sent to, by notifier field.
+// The `url` attribute of the `alertmanager` notifier is a comma-separated list of URLs.
+var notifierURLAttributes = map[string][]string{
+	"alertmanager": {"url"},
+	"dingding":     {"url"},
+	"discord":      {"url"},
+	"googlechat":   {"url"},
+	"jira":         {"api_url"},
+	"kafka":        {"rest_proxy_url"},
+	"oncall":       {"url"},
+	"opsgenie":     {"url"},
+	"sensugo":      {"url"},
+	"slack":        {"endpoint_url", "url"},
+	"teams":        {"url"},
+	"victorops":    {"url"},
+	"webex":        {"api_url"},
+	"webhook":      {"url"},
+	"wecom":        {"url"},
+}
+
+// notifierURL is a URL of a notifier of a contact point.
+type notifierURL struct {
+	field, attribute string
+	url              string
+	sensitive        bool
+}
+
+func (u notifierURL) String() string {
+	if u.sensitive {
+		return fmt.Sprintf("%s.%s", u.field, u.attribute)
+	}
+	return fmt.Sprintf("%s.%s (%q)", u.field, u.attribute, u.url)
+}
+
+// validateContactPointURLs checks at plan time that the URLs of the notifiers of the contact point are valid HTTP(S) URLs,
+// if the provider's `alerting_notifier_url_check` attribute is set. URLs that aren't known at plan time are skipped.
+func validateContactPointURLs(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*common.Client)
+	if !ok || client.AlertingNotifierURLCheck == "" || client.AlertingNotifierURLCheck == common.AlertingNotifierURLCheckNone {
+		return nil
+	}
+
+	var invalid []string
+	for _, u := range contactPointURLs(d.GetRawConfig()) {
+		if err := validateNotifierURL(u.url); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %s", u, err))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid notifier URLs in contact point %q:\n%s", d.Get("name").(string), strings.Join(invalid, "\n"))
+	}
+	return nil
+}
+
+// probeContactPointURLs checks that the URLs of the notifiers of the contact point are reachable, if the provider's `alerting_notifier_url_check` attribute is `reachability`.
+// Any HTTP response counts as reachable: the check catches unknown hosts and refused or timed out connections.
+func probeContactPointURLs(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := meta.(*common.Client)
+	if !ok || client.AlertingNotifierURLCheck != common.AlertingNotifierURLCheckReachability {
+		return nil
+	}
+
+	// The notification endpoints are reached through the proxy of the environment, with the CA and verification settings of the provider's TLS configuration.
+	// The client certificate of the configuration authenticates the provider to Grafana, so it isn't sent to the endpoints.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if client.GrafanaAPIConfig != nil && client.GrafanaAPIConfig.TLSConfig != nil {
+		transport.TLSClientConfig = client.GrafanaAPIConfig.TLSConfig.Clone()
+		transport.TLSClientConfig.Certificates = nil
+	}
+	httpClient := &http.Client{
+		Transport: transport,
+		Timeout:   notifierURLProbeTimeout,
+		// Redirects are followed by Grafana when sending notifications, but the first response is enough to know the endpoint is reachable
+		CheckRedirect: func(req *http.Request, via []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	var unreachable []string
+	for _, u := range contactPointURLs(d.GetRawConfig()) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.url, nil)
+		if err != nil {
+			unreachable = append(unreachable, fmt.Sprintf("%s: %s", u, withoutURL(err)))
+			continue
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			unreachable = append(unreachable, fmt.Sprintf("%s: %s", u, withoutURL(err)))
+			continue
+		}
+		resp.Body.Close()
+	}
+	if len(unreachable) > 0 {
+		return diag.Errorf("unreachable notifier URLs in contact point %q:\n%s", d.Get("name").(string), strings.Join(unreachable, "\n"))
+	}
+	return nil
+}
+
+// contactPointURLs returns the known URLs of the notifiers of the given config, as listed in notifierURLAttributes.
+func contactPointURLs(config cty.Value) []notifierURL {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	var urls []notifierURL
+	for _, n := range notifiers {
+		field := n.meta().field
+		attributes, ok := notifierURLAttributes[field]
+		if !ok {
+			continue
+		}
+		v := config.GetAttr(field)
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		notifierSchema := n.schema().Schema
+		for it := v.ElementIterator(); it.Next(); {
+			_, notifier := it.Element()
+			if notifier.IsNull() || !notifier.IsKnown() {
+				continue
+			}
+			for _, attribute := range attributes {
+				value := notifier.GetAttr(attribute)
+				if value.IsNull() || !value.IsKnown() || value.AsString() == "" {
+					continue
+				}
+				values := []string{value.AsString()}
+				if field == "alertmanager" {
+					values = strings.Split(value.AsString(), ",")
+				}
+				for _, s := range values {
+					urls = append(urls, notifierURL{
+						field:     field,
+						attribute: attribute,
+						url:       strings.TrimSpace(s),
+						sensitive: notifierSchema[attribute].Sensitive,
+					})
+				}
+			}
+		}
+	}
+	return urls
+}
+
+// validateNotifierURL checks that a URL is an absolute HTTP(S) URL with a host.
+func validateNotifierURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return withoutURL(err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("the scheme must be http or https")
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("the host is missing")
+	}
+	return nil
+}
+
+// withoutURL removes the URL from the errors of the url and net/http packages, since the URLs of some notifiers are secrets.
+func withoutURL(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		return urlErr.Err
+	}
+	return err
+}
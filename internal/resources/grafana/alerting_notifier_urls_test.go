@@ -0,0 +1,108 @@
+package grafana
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// testContactPointConfig builds the raw config of a contact point with the given string attributes of its notifiers, by notifier field.
+func testContactPointConfig(notifierAttributes map[string][]map[string]string) cty.Value {
+	configType := ResourceContactPoint().CoreConfigSchema().ImpliedType()
+	attributes := map[string]cty.Value{}
+	for name, attributeType := range configType.AttributeTypes() {
+		notifierList, ok := notifierAttributes[name]
+		if !ok {
+			attributes[name] = cty.NullVal(attributeType)
+			continue
+		}
+		elementType := attributeType.ElementType()
+		var elements []cty.Value
+		for _, values := range notifierList {
+			element := map[string]cty.Value{}
+			for attribute, t := range elementType.AttributeTypes() {
+				if v, ok := values[attribute]; ok {
+					element[attribute] = cty.StringVal(v)
+				} else {
+					element[attribute] = cty.NullVal(t)
+				}
+			}
+			elements = append(elements, cty.ObjectVal(element))
+		}
+		attributes[name] = cty.SetVal(elements)
+	}
+	return cty.ObjectVal(attributes)
+}
+
+func TestContactPointURLs(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		notifiers map[string][]map[string]string
+		expected  []notifierURL
+	}{
+		{
+			name:      "no URL notifier",
+			notifiers: map[string][]map[string]string{"email": {{"addresses": "a@b.c"}}},
+		},
+		{
+			name:      "sensitive URL",
+			notifiers: map[string][]map[string]string{"discord": {{"url": "https://discord.com/api/webhooks/1"}}},
+			expected:  []notifierURL{{field: "discord", attribute: "url", url: "https://discord.com/api/webhooks/1", sensitive: true}},
+		},
+		{
+			name:      "non-sensitive URL",
+			notifiers: map[string][]map[string]string{"webhook": {{"url": "https://example.com/hook"}}},
+			expected:  []notifierURL{{field: "webhook", attribute: "url", url: "https://example.com/hook"}},
+		},
+		{
+			name:      "comma-separated alertmanager URLs",
+			notifiers: map[string][]map[string]string{"alertmanager": {{"url": "http://am1:9093, http://am2:9093"}}},
+			expected: []notifierURL{
+				{field: "alertmanager", attribute: "url", url: "http://am1:9093"},
+				{field: "alertmanager", attribute: "url", url: "http://am2:9093"},
+			},
+		},
+		{
+			name:      "empty URL",
+			notifiers: map[string][]map[string]string{"webhook": {{"url": ""}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			urls := contactPointURLs(testContactPointConfig(tc.notifiers))
+			if len(urls) != len(tc.expected) || (len(urls) > 0 && !reflect.DeepEqual(urls, tc.expected)) {
+				t.Errorf("expected %+v, got %+v", tc.expected, urls)
+			}
+		})
+	}
+
+	if urls := contactPointURLs(cty.NullVal(cty.DynamicPseudoType)); urls != nil {
+		t.Errorf("expected no URL for a null config, got %+v", urls)
+	}
+}
+
+func TestValidateNotifierURL(t *testing.T) {
+	for _, tc := range []struct {
+		url           string
+		expectedError string
+	}{
+		{url: "https://example.com/hook"},
+		{url: "http://10.0.0.1:9093"},
+		{url: "ftp://example.com", expectedError: "the scheme must be http or https"},
+		{url: "example.com/hook", expectedError: "the scheme must be http or https"},
+		{url: "https:///hook", expectedError: "the host is missing"},
+		{url: "https://exa mple.com", expectedError: "invalid character"},
+	} {
+		err := validateNotifierURL(tc.url)
+		if tc.expectedError == "" && err != nil {
+			t.Errorf("unexpected error for %q: %v", tc.url, err)
+		}
+		if tc.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedError)) {
+			t.Errorf("expected an error containing %q for %q, got %v", tc.expectedError, tc.url, err)
+		}
+		if err != nil && strings.Contains(err.Error(), tc.url) {
+			t.Errorf("the error for %q contains the URL: %v", tc.url, err)
+		}
+	}
+}
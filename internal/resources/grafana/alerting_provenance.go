@@ -0,0 +1,31 @@
+package grafana
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// provenanceAPI is the provenance of the alerting objects provisioned through the API, e.g. by Terraform, without `disable_provenance`.
+const provenanceAPI = "api"
+
+// ignoreUIChangesAttribute is the `ignore_ui_changes` attribute of an alerting resource. `ignored` describes what isn't refreshed from Grafana.
+func ignoreUIChangesAttribute(kind, ignored string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Ignore the changes made to the " + kind + " outside of Terraform, e.g. in the Grafana UI, when its provenance isn't `api`, i.e. when it's editable in the UI (see `disable_provenance`). " +
+			ignored + " isn't refreshed from Grafana then, so no drift is reported. The configuration is still applied when it changes.",
+	}
+}
+
+// ignoreUIChanges returns whether the state shouldn't be refreshed from an alerting object with the given provenance, according to the `ignore_ui_changes` attribute.
+// The state is always refreshed on import, as the attribute isn't set yet.
+func ignoreUIChanges(data *schema.ResourceData, provenance string) bool {
+	if !data.Get("ignore_ui_changes").(bool) || provenance == provenanceAPI {
+		return false
+	}
+	log.Printf("[DEBUG] not refreshing %s from Grafana: its provenance is %q and `ignore_ui_changes` is set", data.Id(), provenance)
+	return true
+}
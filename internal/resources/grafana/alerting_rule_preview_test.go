@@ -0,0 +1,52 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewRulePreviewRequest(t *testing.T) {
+	export := `{"groups": [{"name": "My Group", "rules": [
+		{"title": "Other Rule", "condition": "A", "data": []},
+		{"title": "My Rule", "condition": "B", "for": "5m", "labels": {"severity": "warning"}, "annotations": {"summary": "{{ $value }}"},
+		 "data": [{"refId": "B", "datasourceUid": "__expr__", "relativeTimeRange": {"from": 600, "to": 0}, "model": {"type": "math", "expression": "1"}}]}
+	]}]}`
+	var group rulePreviewGroupExport
+	if err := json.Unmarshal([]byte(export), &group); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := newRulePreviewRequest(group, AlertRuleGroupKey{FolderUID: "my-folder", Name: "My Group"}, "My Rule")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"rule":{"grafana_alert":{"title":"My Rule","condition":"B","data":[{"refId":"B","datasourceUid":"__expr__","relativeTimeRange":{"from":600,"to":0},"model":{"type":"math","expression":"1"}}]},` +
+		`"for":"5m","labels":{"severity":"warning"},"annotations":{"summary":"{{ $value }}"}},"folderUid":"my-folder","ruleGroup":"My Group"}`
+	if string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+
+	if _, err := newRulePreviewRequest(group, AlertRuleGroupKey{FolderUID: "my-folder", Name: "My Group"}, "Missing Rule"); err == nil || err.Error() != `rule "Missing Rule" not found in rule group "My Group"` {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestFlattenRulePreviewAlerts(t *testing.T) {
+	instances := flattenRulePreviewAlerts([]rulePreviewAlert{
+		{Labels: map[string]string{"alertname": "My Rule", "instance": "b"}, Annotations: map[string]string{"summary": "b"}},
+		{Labels: map[string]string{"alertname": "My Rule", "instance": "a"}, Annotations: map[string]string{"summary": "a"}},
+	})
+	if len(instances) != 2 {
+		t.Fatalf("expected 2 instances, got %d", len(instances))
+	}
+	for i, summary := range []string{"a", "b"} {
+		annotations := instances[i].(map[string]interface{})["annotations"].(map[string]string)
+		if annotations["summary"] != summary {
+			t.Errorf("expected instance %d to be %q, got %q", i, summary, annotations["summary"])
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package grafana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// appsAPIRequest calls an endpoint of the Grafana app platform (`/apis/...`), which isn't supported by the Grafana OpenAPI client.
+// Errors are formatted like those of the legacy Grafana client, so that `common.CheckReadError` handles them the same way.
+func appsAPIRequest(ctx context.Context, client *common.Client, method, path string, query url.Values, contentType string, body []byte, result interface{}) error {
+	status, respBody, err := grafanaAPIRequest(ctx, client, 0, method, path, query, contentType, body)
+	if err != nil {
+		return err
+	}
+	if status >= 400 {
+		return fmt.Errorf("status: %d, body: %s", status, respBody)
+	}
+	if result == nil || len(respBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBody, result)
+}
+
+// grafanaAPIRequest calls an endpoint of Grafana with the provider's credentials, and returns the status code and body of the response.
+// The request is scoped to the given organization, or to the provider's organization if the ID is 0.
+func grafanaAPIRequest(ctx context.Context, client *common.Client, orgID int64, method, path string, query url.Values, contentType string, body []byte) (int, []byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := client.NewGrafanaRequest(ctx, method, path, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if orgID == 0 {
+		orgID = client.GrafanaAPIConfig.OrgID
+	}
+	if orgID > 0 {
+		req.Header.Set(goapi.OrgIDHeader, strconv.FormatInt(orgID, 10))
+	}
+
+	httpClient := &http.Client{
+		Transport: client.GrafanaTransport(),
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, respBody, err
+}
@@ -0,0 +1,118 @@
+package grafana
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// configURLMaxSize is the maximum size of a JSON model fetched from `config_url`.
+const configURLMaxSize = 32 << 20
+
+// configURLHTTPClient is the client fetching the content of `config_url`.
+var configURLHTTPClient = &http.Client{
+	Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+	Timeout:   time.Minute,
+}
+
+// withConfigURLAttributes adds the `config_url` and `config_sha256` attributes to a schema. They set the JSON model in the given attribute from a URL.
+func withConfigURLAttributes(jsonAttr string, resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range configURLAttributes(jsonAttr) {
+		resourceSchema[k] = v
+	}
+	return resourceSchema
+}
+
+func configURLAttributes(jsonAttr string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"config_url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{jsonAttr, "config_url"},
+			RequiredWith: []string{"config_sha256"},
+			ValidateFunc: validation.IsURLWithScheme([]string{"https"}),
+			Description: fmt.Sprintf("The HTTPS URL of the JSON model, instead of `%s`, e.g. the download URL of a dashboard revision on grafana.com. "+
+				"The model is fetched at plan time, and again at apply time, and the changes of its content are planned like changes of `%s`. "+
+				"`config_sha256` must be set, so that the content applied is the one planned.", jsonAttr, jsonAttr),
+		},
+		"config_sha256": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"config_url"},
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a hex-encoded SHA-256 checksum"),
+			Description:  "The SHA-256 checksum of the content of `config_url`, hex-encoded. The plan and the apply fail when the content doesn't match it.",
+		},
+	}
+}
+
+// setJSONFromConfigURL is a CustomizeDiffFunc fetching the JSON model from `config_url` into the given attribute, normalized by its StateFunc.
+// The attribute is computed to hold the content of `config_url`: it's only kept from the state while `config_url` is set.
+func setJSONFromConfigURL(jsonAttr string, normalize func(interface{}) string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("config_url") || !d.NewValueKnown("config_sha256") {
+			return d.SetNewComputed(jsonAttr)
+		}
+		configURL := d.Get("config_url").(string)
+		if configURL == "" {
+			rawConfig := d.GetRawConfig()
+			if old, _ := d.GetChange("config_url"); old.(string) != "" && !rawConfig.IsNull() && rawConfig.GetAttr(jsonAttr).IsNull() {
+				return fmt.Errorf("%s must be set when config_url is removed", jsonAttr)
+			}
+			return nil
+		}
+
+		body, err := fetchConfigURL(ctx, configURL, d.Get("config_sha256").(string))
+		if err != nil {
+			return err
+		}
+		var model map[string]interface{}
+		if model, err = UnmarshalDashboardConfigJSON(string(body)); err != nil {
+			return fmt.Errorf("the content of %s isn't a JSON object: %w", configURL, err)
+		}
+		if normalize(model) == normalize(d.Get(jsonAttr)) {
+			return nil
+		}
+		return d.SetNew(jsonAttr, normalize(model))
+	}
+}
+
+// fetchConfigURL fetches the content of a URL, and checks its SHA-256 checksum.
+func fetchConfigURL(ctx context.Context, configURL, checksum string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, configURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := configURLHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", configURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: status %d", configURL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, configURLMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", configURL, err)
+	}
+	if len(body) > configURLMaxSize {
+		return nil, fmt.Errorf("the content of %s is larger than %d bytes", configURL, configURLMaxSize)
+	}
+
+	sum := sha256.Sum256(body)
+	if actual := hex.EncodeToString(sum[:]); actual != strings.ToLower(checksum) {
+		return nil, fmt.Errorf("the SHA-256 checksum of the content of %s is %s, expected %s (config_sha256)", configURL, actual, strings.ToLower(checksum))
+	}
+	return body, nil
+}
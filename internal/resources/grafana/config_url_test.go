@@ -0,0 +1,39 @@
+package grafana
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchConfigURL(t *testing.T) {
+	model := `{"title": "test"}`
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(model))
+	}))
+	defer server.Close()
+
+	defaultClient := configURLHTTPClient
+	configURLHTTPClient = server.Client()
+	defer func() { configURLHTTPClient = defaultClient }()
+
+	sum := sha256.Sum256([]byte(model))
+	checksum := hex.EncodeToString(sum[:])
+
+	body, err := fetchConfigURL(context.Background(), server.URL, strings.ToUpper(checksum))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != model {
+		t.Errorf("expected %s, got %s", model, body)
+	}
+
+	_, err = fetchConfigURL(context.Background(), server.URL, strings.Repeat("0", 64))
+	if err == nil || !strings.Contains(err.Error(), "the SHA-256 checksum of the content of "+server.URL+" is "+checksum) {
+		t.Errorf("expected a checksum error, got %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// deprecatedPanelTypes maps the panel types that are deprecated (most of them are Angular based, which is removed in Grafana 12)
+// to the panel type that replaces them.
+var deprecatedPanelTypes = map[string]string{
+	"graph":                    "timeseries",
+	"table-old":                "table",
+	"singlestat":               "stat",
+	"grafana-singlestat-panel": "stat",
+	"grafana-piechart-panel":   "piechart",
+	"grafana-worldmap-panel":   "geomap",
+}
+
+// validateDashboardDeprecatedPanels checks the panels of the dashboard at plan time, according to the provider's `dashboard_deprecated_panels` attribute.
+func validateDashboardDeprecatedPanels(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*common.Client)
+	if !ok || (client.DashboardDeprecatedPanels != common.DashboardDeprecatedPanelsWarn && client.DashboardDeprecatedPanels != common.DashboardDeprecatedPanelsFail) {
+		return nil
+	}
+
+	panels := findDeprecatedDashboardPanels(d.GetRawConfig())
+	if len(panels) == 0 {
+		return nil
+	}
+	if client.DashboardDeprecatedPanels == common.DashboardDeprecatedPanelsFail {
+		return fmt.Errorf("the dashboard uses deprecated panels: %s", strings.Join(panels, "; "))
+	}
+	logPlanWarning("the dashboard uses deprecated panels: %s", strings.Join(panels, "; "))
+	return nil
+}
+
+// dashboardDeprecatedPanelsWarnings returns a warning listing the deprecated panels of the dashboard, if the provider is in `warn` mode.
+func dashboardDeprecatedPanelsWarnings(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := meta.(*common.Client)
+	if !ok || client.DashboardDeprecatedPanels != common.DashboardDeprecatedPanelsWarn {
+		return nil
+	}
+
+	panels := findDeprecatedDashboardPanels(d.GetRawConfig())
+	if len(panels) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "The dashboard uses deprecated panels",
+		Detail:   strings.Join(panels, "\n"),
+	}}
+}
+
+// findDeprecatedDashboardPanels lists the deprecated panels of the `config_json` and `panels_json` attributes of the given config.
+// Values that aren't known yet are skipped.
+func findDeprecatedDashboardPanels(config cty.Value) []string {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	var jsonModels []string
+	if v := config.GetAttr("config_json"); v.IsKnown() && !v.IsNull() {
+		jsonModels = append(jsonModels, v.AsString())
+	}
+	if v := config.GetAttr("panels_json"); v.IsKnown() && !v.IsNull() {
+		for it := v.ElementIterator(); it.Next(); {
+			if _, panel := it.Element(); panel.IsKnown() && !panel.IsNull() {
+				jsonModels = append(jsonModels, `{"panels": [`+panel.AsString()+`]}`)
+			}
+		}
+	}
+
+	var found []string
+	for _, model := range jsonModels {
+		var dashboard map[string]interface{}
+		if err := json.Unmarshal([]byte(model), &dashboard); err != nil {
+			continue // Invalid JSON is reported by the attribute validation
+		}
+		found = append(found, deprecatedPanelsOf(dashboard)...)
+	}
+	sort.Strings(found)
+	return found
+}
+
+// deprecatedPanelsOf walks the panels of a dashboard model, including the panels of collapsed rows and of the legacy `rows` schema.
+func deprecatedPanelsOf(model map[string]interface{}) []string {
+	var found []string
+	var walk func(panels interface{})
+	walk = func(panels interface{}) {
+		list, _ := panels.([]interface{})
+		for _, p := range list {
+			panel, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			panelType, _ := panel["type"].(string)
+			if replacement, ok := deprecatedPanelTypes[panelType]; ok {
+				title, _ := panel["title"].(string)
+				found = append(found, fmt.Sprintf("panel %q is of type %q, use %q instead", title, panelType, replacement))
+			}
+			walk(panel["panels"])
+		}
+	}
+
+	walk(model["panels"])
+	rows, _ := model["rows"].([]interface{})
+	for _, r := range rows {
+		if row, ok := r.(map[string]interface{}); ok {
+			walk(row["panels"])
+		}
+	}
+	return found
+}
@@ -0,0 +1,234 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// dashboardJSONPathSegmentRegexp matches the segments of a JSONPath after the root `$`: `.name`, `.*`, `[0]`, `[*]` or `['name']`.
+var dashboardJSONPathSegmentRegexp = regexp.MustCompile(`^(?:\.([^.\[\]]+)|\[(\d+|\*)\]|\['((?:[^'\\]|\\.)*)'\])`)
+
+// dashboardJSONPath is a path to values of a dashboard model, parsed from a JSON pointer or a JSONPath.
+type dashboardJSONPath []dashboardJSONPathSegment
+
+// dashboardJSONPathSegment is an object key or an array index, or a wildcard matching all the keys or indexes.
+type dashboardJSONPathSegment struct {
+	key      string
+	wildcard bool
+}
+
+// parseDashboardJSONPath parses a JSON pointer (RFC 6901), e.g. `/panels/0/fieldConfig`, or a JSONPath made of names, indexes and wildcards,
+// e.g. `$.panels[*].fieldConfig`. Filters and recursive descent aren't supported.
+func parseDashboardJSONPath(path string) (dashboardJSONPath, error) {
+	switch {
+	case strings.HasPrefix(path, "/"):
+		var segments dashboardJSONPath
+		for _, s := range strings.Split(path[1:], "/") {
+			// `~1` is unescaped before `~0`, so that `~01` is `~1`
+			segments = append(segments, dashboardJSONPathSegment{key: strings.ReplaceAll(strings.ReplaceAll(s, "~1", "/"), "~0", "~")})
+		}
+		return segments, nil
+	case strings.HasPrefix(path, "$"):
+		var segments dashboardJSONPath
+		for rest := path[1:]; rest != ""; {
+			match := dashboardJSONPathSegmentRegexp.FindStringSubmatch(rest)
+			if match == nil {
+				return nil, fmt.Errorf("invalid JSONPath %q: unsupported expression at %q", path, rest)
+			}
+			switch {
+			case match[1] == "*" || match[2] == "*":
+				segments = append(segments, dashboardJSONPathSegment{wildcard: true})
+			case match[1] != "":
+				segments = append(segments, dashboardJSONPathSegment{key: match[1]})
+			case match[2] != "":
+				segments = append(segments, dashboardJSONPathSegment{key: match[2]})
+			default:
+				segments = append(segments, dashboardJSONPathSegment{key: strings.ReplaceAll(strings.ReplaceAll(match[3], `\'`, `'`), `\\`, `\`)})
+			}
+			rest = rest[len(match[0]):]
+		}
+		if len(segments) == 0 {
+			return nil, fmt.Errorf("invalid JSONPath %q: the whole dashboard can't be ignored", path)
+		}
+		return segments, nil
+	default:
+		return nil, fmt.Errorf("invalid path %q: must be a JSON pointer starting with `/` or a JSONPath starting with `$`", path)
+	}
+}
+
+// remove removes the values at the path from a dashboard model. Array elements are replaced by null, so that the indexes of the other elements don't change.
+func (p dashboardJSONPath) remove(node interface{}) {
+	p.walk(node, nil, false, func(container, _ interface{}, key string) {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			delete(c, key)
+		case []interface{}:
+			if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(c) {
+				c[i] = nil
+			}
+		}
+	})
+}
+
+// copyFrom sets the values at the path in a dashboard model to the values in another model, `src`.
+// Object keys missing from `src` are removed, and the objects missing from the model on the way to the values of `src` are created.
+func (p dashboardJSONPath) copyFrom(dst, src interface{}) {
+	p.walk(dst, src, true, func(container, srcContainer interface{}, key string) {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			srcMap, _ := srcContainer.(map[string]interface{})
+			if v, ok := srcMap[key]; ok {
+				c[key] = v
+			} else {
+				delete(c, key)
+			}
+		case []interface{}:
+			srcSlice, _ := srcContainer.([]interface{})
+			if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(c) && i < len(srcSlice) {
+				c[i] = srcSlice[i]
+			}
+		}
+	})
+}
+
+// walk calls `apply` with the containers of the values at the path, in `node` and in the parallel `src` model if any, and the keys of the values.
+// If `create` is set, the objects of `src` missing from `node` are created empty.
+func (p dashboardJSONPath) walk(node, src interface{}, create bool, apply func(container, srcContainer interface{}, key string)) {
+	if len(p) == 0 {
+		return
+	}
+	for _, key := range p.keys(node, src) {
+		if len(p) == 1 {
+			apply(node, src, key)
+			continue
+		}
+		child, srcChild := dashboardJSONChild(node, key), dashboardJSONChild(src, key)
+		if nodeMap, ok := node.(map[string]interface{}); ok && child == nil && create {
+			if _, ok := srcChild.(map[string]interface{}); ok {
+				child = map[string]interface{}{}
+				nodeMap[key] = child
+			}
+		}
+		p[1:].walk(child, srcChild, create, apply)
+	}
+}
+
+// keys returns the keys of the containers matched by the first segment of the path.
+// A wildcard matches the keys of both containers, since keys can be added or removed by the copy.
+func (p dashboardJSONPath) keys(node, src interface{}) []string {
+	if !p[0].wildcard {
+		return []string{p[0].key}
+	}
+	seen := map[string]bool{}
+	var keys []string
+	for _, container := range []interface{}{node, src} {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			for k := range c {
+				if !seen[k] {
+					seen[k] = true
+					keys = append(keys, k)
+				}
+			}
+		case []interface{}:
+			for i := range c {
+				if k := strconv.Itoa(i); !seen[k] {
+					seen[k] = true
+					keys = append(keys, k)
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// dashboardJSONChild returns the value at a key of an object or an array, or nil.
+func dashboardJSONChild(node interface{}, key string) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		return n[key]
+	case []interface{}:
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(n) {
+			return n[i]
+		}
+	}
+	return nil
+}
+
+// dashboardIgnoredJSONPaths returns the parsed `ignored_json_paths` of a dashboard. Invalid paths are rejected by the validation of the attribute.
+func dashboardIgnoredJSONPaths(paths []interface{}) []dashboardJSONPath {
+	var parsed []dashboardJSONPath
+	for _, path := range paths {
+		if p, err := parseDashboardJSONPath(path.(string)); err == nil {
+			parsed = append(parsed, p)
+		}
+	}
+	return parsed
+}
+
+// withoutDashboardIgnoredJSONPaths returns the normalized JSON of a dashboard model without the values at the ignored paths.
+func withoutDashboardIgnoredJSONPaths(configJSON string, paths []dashboardJSONPath) string {
+	model, err := UnmarshalDashboardConfigJSON(configJSON)
+	if err != nil {
+		return configJSON
+	}
+	for _, p := range paths {
+		p.remove(model)
+	}
+	return NormalizeDashboardConfigJSON(model)
+}
+
+// suppressDashboardIgnoredJSONPaths keeps the `config_json` of the state when it only differs from the configured one at the paths of `ignored_json_paths`.
+// This is done in the diff rather than with a DiffSuppressFunc, to also apply to the JSON set from `config_url`.
+// The paths are rejected when only the hash of the JSON is stored, since the ignored values can't be removed from a hash: the diff would never be suppressed.
+func suppressDashboardIgnoredJSONPaths(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	paths := dashboardIgnoredJSONPaths(d.Get("ignored_json_paths").([]interface{}))
+	if len(paths) > 0 && StoreDashboardSHA256 {
+		return fmt.Errorf("`ignored_json_paths` can't be used when the provider's `store_dashboard_sha256` is set: the state only has the hash of the dashboard JSON, so the ignored values can't be compared")
+	}
+	if d.Id() == "" || len(paths) == 0 || !d.NewValueKnown("config_json") || !d.HasChange("config_json") {
+		return nil
+	}
+	old, new := d.GetChange("config_json")
+	if common.SHA256Regexp.MatchString(old.(string)) {
+		// The state only has the hash of the JSON
+		return nil
+	}
+	if withoutDashboardIgnoredJSONPaths(old.(string), paths) != withoutDashboardIgnoredJSONPaths(new.(string), paths) {
+		return nil
+	}
+	return d.SetNew("config_json", old)
+}
+
+// preserveDashboardIgnoredJSONPaths sets the values at the paths of `ignored_json_paths` in the model of the dashboard to save to the values of the dashboard in Grafana.
+func preserveDashboardIgnoredJSONPaths(client *goapi.GrafanaHTTPAPI, d *schema.ResourceData, model map[string]interface{}) error {
+	paths := dashboardIgnoredJSONPaths(d.Get("ignored_json_paths").([]interface{}))
+	if len(paths) == 0 {
+		return nil
+	}
+	_, uid := SplitOrgResourceID(d.Id())
+	resp, err := client.Dashboards.GetDashboardByUID(uid)
+	if err != nil {
+		return fmt.Errorf("failed to read dashboard %q to preserve its ignored JSON paths: %w", uid, err)
+	}
+	for _, p := range paths {
+		p.copyFrom(model, resp.Payload.Dashboard)
+	}
+	return nil
+}
+
+// validateDashboardJSONPath is the ValidateFunc of the paths of `ignored_json_paths`.
+func validateDashboardJSONPath(i interface{}, k string) ([]string, []error) {
+	if _, err := parseDashboardJSONPath(i.(string)); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
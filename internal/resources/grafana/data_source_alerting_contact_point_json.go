@@ -0,0 +1,135 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// secureSettingMarker replaces the secure fields of a notifier to find the settings they are sent in.
+const secureSettingMarker = "__terraform_secure_setting__"
+
+func DatasourceContactPointJSON() *schema.Resource {
+	contactPoint := ResourceContactPoint()
+	resourceSchema := contactPoint.Schema
+	delete(resourceSchema, "org_id")
+	delete(resourceSchema, "disable_provenance")
+	delete(resourceSchema, "ignore_ui_changes")
+	resourceSchema["name"].ForceNew = false
+	resourceSchema["json"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: "The notifiers of the contact point as the JSON array of objects submitted to the contact points API, one per notifier. " +
+			"Secure settings are replaced by `[REDACTED]`, like in the export of the contact point by Grafana.",
+	}
+
+	return &schema.Resource{
+		ReadContext: readContactPointJSON,
+
+		Description: `
+Renders the JSON that a ` + "`grafana_contact_point`" + ` resource with the same arguments submits to Grafana for each of its notifiers,
+to compare it with the export of the contact point by Grafana when a notifier doesn't behave as expected.
+No request is made to Grafana.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/export-alerting-resources/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#contact-points)
+`,
+
+		Schema: resourceSchema,
+	}
+}
+
+func readContactPointJSON(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	points := []*models.EmbeddedContactPoint{}
+	for _, n := range notifiers {
+		set, ok := d.GetOk(n.meta().field)
+		if !ok {
+			continue
+		}
+		for _, raw := range set.(*schema.Set).List() {
+			point := unpackPointConfig(n, copyNotifierConfig(raw), name)
+			settings := point.Settings.(map[string]interface{})
+			for _, key := range secureSettingKeys(n, raw.(map[string]interface{}), name) {
+				if _, ok := settings[key]; ok {
+					settings[key] = redactedSettingValue
+				}
+			}
+			redactNestedSecureSettings(settings)
+			points = append(points, point)
+		}
+	}
+
+	encoded, err := json.MarshalIndent(points, "", "  ")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	d.Set("json", string(encoded))
+	return nil
+}
+
+// secureSettingKeys returns the keys of the settings submitted for the secure fields of a notifier, and for its `secure_settings`.
+// The keys are found by unpacking the notifier with a marker in place of the values of the secure fields.
+func secureSettingKeys(n notifier, config map[string]interface{}, name string) []string {
+	marked := copyNotifierConfig(config)
+	for _, field := range n.meta().secureFields {
+		if v, ok := marked[field].(string); ok && v != "" {
+			marked[field] = secureSettingMarker
+		}
+	}
+
+	var keys []string
+	for key, value := range unpackPointConfig(n, marked, name).Settings.(map[string]interface{}) {
+		if value == secureSettingMarker {
+			keys = append(keys, key)
+		}
+	}
+	for key := range config["secure_settings"].(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// redactNestedSecureSettings redacts the secure settings nested in other settings: the TLS configuration of the MQTT notifier,
+// the header values of the webhook notifier and the AWS keys of the SNS notifier.
+func redactNestedSecureSettings(settings map[string]interface{}) {
+	if tls, ok := settings["tlsConfig"].(map[string]interface{}); ok {
+		for _, key := range mqttTLSSecureFields {
+			if _, ok := tls[key]; ok {
+				tls[key] = redactedSettingValue
+			}
+		}
+	}
+	if headers, ok := settings["headers"].(map[string]interface{}); ok {
+		for key := range headers {
+			headers[key] = redactedSettingValue
+		}
+	}
+	if sigv4, ok := settings["sigv4"].(map[string]interface{}); ok {
+		for _, key := range []string{"access_key", "secret_key"} {
+			if _, ok := sigv4[key]; ok {
+				sigv4[key] = redactedSettingValue
+			}
+		}
+	}
+}
+
+// copyNotifierConfig copies the top-level attributes of a notifier, and its settings maps, which are modified when it's unpacked.
+func copyNotifierConfig(raw interface{}) map[string]interface{} {
+	config := map[string]interface{}{}
+	for k, v := range raw.(map[string]interface{}) {
+		if m, ok := v.(map[string]interface{}); ok {
+			copied := make(map[string]interface{}, len(m))
+			for mk, mv := range m {
+				copied[mk] = mv
+			}
+			v = copied
+		}
+		config[k] = v
+	}
+	return config
+}
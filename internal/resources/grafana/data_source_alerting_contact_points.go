@@ -0,0 +1,140 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// redactedSettingValue is the value returned by the provisioning API in place of secure settings.
+const redactedSettingValue = "[REDACTED]"
+
+func DatasourceContactPoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readContactPoints,
+
+		Description: `
+Lists the contact points of a Grafana organization, along with the non-secure settings of their integrations.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/fundamentals/contact-points/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#contact-points)
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return contact points whose name starts with this prefix.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the matching contact points, sorted.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"contact_points": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching contact points, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the contact point.",
+						},
+						"integration": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The integrations (notifiers) of the contact point.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The UID of the integration.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the integration. For example, `email` or `slack`.",
+									},
+									"disable_resolve_message": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether resolved notifications are disabled.",
+									},
+									"settings_json": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The settings of the integration, as JSON. Secure settings are omitted.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readContactPoints(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+
+	points, err := listContactPoints(client, meta, orgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prefix := d.Get("name_prefix").(string)
+	integrations := map[string][]interface{}{}
+	for _, p := range points {
+		if !strings.HasPrefix(p.Name, prefix) {
+			continue
+		}
+
+		settings := map[string]interface{}{}
+		if s, ok := p.Settings.(map[string]interface{}); ok {
+			for k, v := range s {
+				if v != redactedSettingValue {
+					settings[k] = v
+				}
+			}
+		}
+		settingsJSON, err := json.Marshal(settings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		integrations[p.Name] = append(integrations[p.Name], map[string]interface{}{
+			"uid":                     p.UID,
+			"type":                    *p.Type,
+			"disable_resolve_message": p.DisableResolveMessage,
+			"settings_json":           string(settingsJSON),
+		})
+	}
+
+	names := make([]string, 0, len(integrations))
+	for name := range integrations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	contactPoints := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		contactPoints = append(contactPoints, map[string]interface{}{
+			"name":        name,
+			"integration": integrations[name],
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "contact_points:"+prefix))
+	d.Set("names", names)
+	return diag.FromErr(d.Set("contact_points", contactPoints))
+}
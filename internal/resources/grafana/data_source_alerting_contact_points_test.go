@@ -0,0 +1,36 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceContactPoints_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var points models.ContactPoints
+
+	// TODO: Make parallelizable
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingContactPointCheckExists.destroyed(&points, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_contact_points/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingContactPointCheckExists.exists("grafana_contact_point.team_a", &points),
+					resource.TestCheckResourceAttr("data.grafana_contact_points.team_a", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_contact_points.team_a", "names.0", "team-a-email"),
+					resource.TestCheckResourceAttr("data.grafana_contact_points.team_a", "contact_points.0.name", "team-a-email"),
+					resource.TestCheckResourceAttr("data.grafana_contact_points.team_a", "contact_points.0.integration.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_contact_points.team_a", "contact_points.0.integration.0.type", "email"),
+					resource.TestMatchResourceAttr("data.grafana_contact_points.team_a", "contact_points.0.integration.0.settings_json", regexp.MustCompile(`"addresses":"team-a@company.org"`)),
+				),
+			},
+		},
+	})
+}
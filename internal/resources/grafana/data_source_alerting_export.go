@@ -0,0 +1,247 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// alertingExport is the JSON export of the contact points or the notification policy of an organization.
+type alertingExport struct {
+	ContactPoints []struct {
+		Name      string `json:"name"`
+		Receivers []struct {
+			UID                   string          `json:"uid"`
+			Type                  string          `json:"type"`
+			DisableResolveMessage bool            `json:"disableResolveMessage"`
+			Settings              json.RawMessage `json:"settings"`
+		} `json:"receivers"`
+	} `json:"contactPoints"`
+	Policies []map[string]interface{} `json:"policies"`
+}
+
+func DatasourceAlertingExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readAlertingExport,
+
+		Description: `
+Exports the current alerting configuration of a Grafana organization, as provisioned: its contact points, notification policy tree, message templates and mute timings.
+This allows copying the configuration to another organization or Grafana instance, e.g. to seed a disaster recovery region from the primary one.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/export-alerting-resources/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/)
+
+This data source requires Grafana 10.1.0 or later.
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"decrypt": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Export the secure settings of the contact points in clear text. This requires the permission to read the secrets of provisioned alerting resources (the Admin role by default). " +
+					"Otherwise, secure settings are exported as `[REDACTED]`.",
+			},
+			"contact_points": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The contact points of the organization, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the contact point.",
+						},
+						"integration": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The integrations (notifiers) of the contact point.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The UID of the integration.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the integration. For example, `email` or `slack`.",
+									},
+									"disable_resolve_message": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether resolved notifications are disabled.",
+									},
+									"settings_json": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Sensitive:   true,
+										Description: "The settings of the integration, as JSON, including its secure settings.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"notification_policy_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The notification policy tree of the organization, as JSON, in the format of the notification policies of Grafana's file provisioning.",
+			},
+			"message_templates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The message templates of the organization, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the message template.",
+						},
+						"template": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The content of the message template.",
+						},
+					},
+				},
+			},
+			"mute_timings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mute timings of the organization, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the mute timing.",
+						},
+						"intervals_json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time intervals of the mute timing, as JSON.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readAlertingExport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+
+	var export, policyExport alertingExport
+	query := url.Values{"format": {"json"}, "decrypt": {strconv.FormatBool(d.Get("decrypt").(bool))}}
+	if err := getAlertingExport(ctx, meta.(*common.Client), orgID, "/api/v1/provisioning/contact-points/export", query, &export); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := getAlertingExport(ctx, meta.(*common.Client), orgID, "/api/v1/provisioning/policies/export", url.Values{"format": {"json"}}, &policyExport); err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(export.ContactPoints, func(i, j int) bool { return export.ContactPoints[i].Name < export.ContactPoints[j].Name })
+	contactPoints := make([]interface{}, 0, len(export.ContactPoints))
+	for _, p := range export.ContactPoints {
+		integrations := make([]interface{}, 0, len(p.Receivers))
+		for _, r := range p.Receivers {
+			settings := "{}"
+			if len(r.Settings) > 0 {
+				settings = string(r.Settings)
+			}
+			integrations = append(integrations, map[string]interface{}{
+				"uid":                     r.UID,
+				"type":                    r.Type,
+				"disable_resolve_message": r.DisableResolveMessage,
+				"settings_json":           settings,
+			})
+		}
+		contactPoints = append(contactPoints, map[string]interface{}{
+			"name":        p.Name,
+			"integration": integrations,
+		})
+	}
+
+	policyJSON := ""
+	if len(policyExport.Policies) > 0 {
+		// The org ID of the export isn't part of the policy tree
+		policy := policyExport.Policies[0]
+		delete(policy, "orgId")
+		encoded, err := json.Marshal(policy)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policyJSON = string(encoded)
+	}
+
+	templatesResp, err := client.Provisioning.GetTemplates()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templates := make([]interface{}, 0, len(templatesResp.Payload))
+	for _, t := range templatesResp.Payload {
+		templates = append(templates, map[string]interface{}{
+			"name":     t.Name,
+			"template": t.Template,
+		})
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].(map[string]interface{})["name"].(string) < templates[j].(map[string]interface{})["name"].(string)
+	})
+
+	muteTimingsResp, err := client.Provisioning.GetMuteTimings()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	muteTimings := make([]interface{}, 0, len(muteTimingsResp.Payload))
+	for _, mt := range muteTimingsResp.Payload {
+		intervals, err := json.Marshal(mt.TimeIntervals)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		muteTimings = append(muteTimings, map[string]interface{}{
+			"name":           mt.Name,
+			"intervals_json": string(intervals),
+		})
+	}
+	sort.Slice(muteTimings, func(i, j int) bool {
+		return muteTimings[i].(map[string]interface{})["name"].(string) < muteTimings[j].(map[string]interface{})["name"].(string)
+	})
+
+	d.SetId(MakeOrgResourceID(orgID, "alerting_export"))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("contact_points", contactPoints)
+	d.Set("notification_policy_json", policyJSON)
+	d.Set("message_templates", templates)
+	d.Set("mute_timings", muteTimings)
+	return nil
+}
+
+// getAlertingExport decodes the export of alerting resources in JSON. The OpenAPI client can't set the format of all the exports.
+func getAlertingExport(ctx context.Context, client *common.Client, orgID int64, path string, query url.Values, export *alertingExport) error {
+	status, body, err := grafanaAPIRequest(ctx, client, orgID, http.MethodGet, path, query, "", nil)
+	if err != nil {
+		return err
+	}
+	if status >= 400 {
+		return fmt.Errorf("failed to export %s: status: %d, body: %s", path, status, body)
+	}
+	if err := json.Unmarshal(body, export); err != nil {
+		return fmt.Errorf("failed to decode the export of %s: %w", path, err)
+	}
+	return nil
+}
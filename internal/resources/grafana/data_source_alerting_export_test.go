@@ -0,0 +1,32 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceAlertingExport_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.1.0")
+
+	name := "data.grafana_alerting_export.current"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_alerting_export/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "contact_points.0.name"),
+					resource.TestCheckResourceAttrSet(name, "contact_points.0.integration.0.type"),
+					resource.TestMatchResourceAttr(name, "notification_policy_json", regexp.MustCompile(`"receiver":`)),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "message_templates.*", map[string]string{
+						"name": "My Template",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,304 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// ruleExport is a rule of the JSON export of a rule group. The export isn't supported by the OpenAPI client, which can't decode its durations.
+type ruleExport struct {
+	Title       string            `json:"title"`
+	Condition   string            `json:"condition"`
+	Data        []ruleQueryExport `json:"data"`
+	For         string            `json:"for"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type ruleQueryExport struct {
+	RefID             string `json:"refId"`
+	DatasourceUID     string `json:"datasourceUid"`
+	RelativeTimeRange struct {
+		From int64 `json:"from"`
+	} `json:"relativeTimeRange"`
+	Model map[string]interface{} `json:"model"`
+}
+
+type ruleGroupExport struct {
+	Groups []struct {
+		Name     string       `json:"name"`
+		Interval string       `json:"interval"`
+		Rules    []ruleExport `json:"rules"`
+	} `json:"groups"`
+}
+
+// prometheusRuleGroups is the format of Prometheus rule files.
+type prometheusRuleGroups struct {
+	Groups []prometheusRuleGroup `yaml:"groups"`
+}
+
+type prometheusRuleGroup struct {
+	Name     string           `yaml:"name"`
+	Interval string           `yaml:"interval,omitempty"`
+	Rules    []prometheusRule `yaml:"rules"`
+}
+
+type prometheusRule struct {
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// prometheusOverTimeFunctions are the PromQL functions equivalent to the functions of reduce expressions, over the time range of their input.
+var prometheusOverTimeFunctions = map[string]string{
+	"mean":   "avg_over_time(%s)",
+	"min":    "min_over_time(%s)",
+	"max":    "max_over_time(%s)",
+	"sum":    "sum_over_time(%s)",
+	"count":  "count_over_time(%s)",
+	"median": "quantile_over_time(0.5, %s)",
+}
+
+// prometheusDatasourceTypes are the types of the data sources whose queries are PromQL expressions.
+var prometheusDatasourceTypes = map[string]bool{
+	"prometheus":                          true,
+	"grafana-amazonprometheus-datasource": true,
+	"grafana-azureprometheus-datasource":  true,
+}
+
+var mathReferenceRegexp = regexp.MustCompile(`\$\{?([A-Za-z0-9_]+)\}?`)
+
+func DatasourceRuleGroupPrometheusExport() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Renders a rule group in the format of Prometheus rule files, from the export of the group by Grafana,
+so that tools validating Prometheus rules (e.g. ` + "`promtool check rules`" + ` or pint) can be run against rules managed by Terraform.
+
+Rules are converted from their condition: queries must be made to Prometheus data sources, with a PromQL ` + "`expr`" + ` in their model, and the math, reduce and threshold expressions
+computed from them are converted to PromQL. Reduce expressions other than ` + "`last`" + ` are converted to ` + "`<function>_over_time`" + ` functions over the time range of their input.
+Rules with other expressions (resample, classic conditions) can't be converted.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/export-alerting-resources/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/)
+`,
+		ReadContext: readRuleGroupPrometheusExport,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"folder_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the folder of the rule group.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the rule group.",
+			},
+			"skip_unsupported_rules": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the rules which can't be converted are left out of `yaml` and listed in `skipped_rules`, instead of failing the read.",
+			},
+			"yaml": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rule group in the format of Prometheus rule files.",
+			},
+			"skipped_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the rules which couldn't be converted, when `skip_unsupported_rules` is set.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func readRuleGroupPrometheusExport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	key := AlertRuleGroupKey{FolderUID: d.Get("folder_uid").(string), Name: d.Get("name").(string)}
+
+	path := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s/export", url.PathEscape(key.FolderUID), url.PathEscape(key.Name))
+	status, body, err := grafanaAPIRequest(ctx, meta.(*common.Client), orgID, http.MethodGet, path, url.Values{"format": {"json"}}, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 {
+		return diag.Errorf("failed to export rule group %q: status: %d, body: %s", key.Name, status, body)
+	}
+	var export ruleGroupExport
+	if err := json.Unmarshal(body, &export); err != nil {
+		return diag.Errorf("failed to decode the export of rule group %q: %v", key.Name, err)
+	}
+	if len(export.Groups) != 1 {
+		return diag.Errorf("expected the export of rule group %q to contain a single group, got %d", key.Name, len(export.Groups))
+	}
+
+	// The types of the data sources are looked up once per data source
+	datasourceTypes := map[string]string{}
+	datasourceType := func(uid string) (string, error) {
+		if t, ok := datasourceTypes[uid]; ok {
+			return t, nil
+		}
+		resp, err := client.Datasources.GetDataSourceByUID(uid)
+		if err != nil {
+			return "", fmt.Errorf("failed to read data source %q: %w", uid, err)
+		}
+		datasourceTypes[uid] = resp.Payload.Type
+		return resp.Payload.Type, nil
+	}
+
+	group := export.Groups[0]
+	promGroup := prometheusRuleGroup{Name: group.Name, Interval: group.Interval, Rules: []prometheusRule{}}
+	skipped := []string{}
+	for _, rule := range group.Rules {
+		expr, err := ruleStagePromQL(rule.Data, rule.Condition, 0, datasourceType)
+		if err != nil {
+			if !d.Get("skip_unsupported_rules").(bool) {
+				return diag.Errorf("rule %q can't be converted to a Prometheus rule: %v", rule.Title, err)
+			}
+			skipped = append(skipped, rule.Title)
+			continue
+		}
+		promRule := prometheusRule{
+			Alert:       rule.Title,
+			Expr:        expr,
+			Labels:      rule.Labels,
+			Annotations: rule.Annotations,
+		}
+		if rule.For != "0s" {
+			promRule.For = rule.For
+		}
+		promGroup.Rules = append(promGroup.Rules, promRule)
+	}
+
+	rendered, err := yaml.Marshal(prometheusRuleGroups{Groups: []prometheusRuleGroup{promGroup}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, packGroupID(key)))
+	d.Set("yaml", string(rendered))
+	d.Set("skipped_rules", skipped)
+	return nil
+}
+
+// ruleStagePromQL converts the stage of a rule with the given ref ID, and the stages it's computed from, to a PromQL expression.
+// datasourceType returns the type of a data source from its UID: only the queries of Prometheus data sources are converted.
+func ruleStagePromQL(stages []ruleQueryExport, refID string, depth int, datasourceType func(uid string) (string, error)) (string, error) {
+	if depth > len(stages) {
+		return "", fmt.Errorf("the stages of the rule reference each other in a loop")
+	}
+	var stage *ruleQueryExport
+	for i := range stages {
+		if stages[i].RefID == refID {
+			stage = &stages[i]
+		}
+	}
+	if stage == nil {
+		return "", fmt.Errorf("stage %q not found", refID)
+	}
+
+	if stage.DatasourceUID != expressionDatasourceUID {
+		t, err := datasourceType(stage.DatasourceUID)
+		if err != nil {
+			return "", err
+		}
+		if !prometheusDatasourceTypes[t] {
+			return "", fmt.Errorf("stage %q queries a %s data source, not a Prometheus one", refID, t)
+		}
+		expr, _ := stage.Model["expr"].(string)
+		if expr == "" {
+			return "", fmt.Errorf("the model of stage %q has no PromQL `expr`", refID)
+		}
+		return expr, nil
+	}
+
+	exprType, _ := stage.Model["type"].(string)
+	expression, _ := stage.Model["expression"].(string)
+	switch exprType {
+	case "math":
+		var err error
+		converted := mathReferenceRegexp.ReplaceAllStringFunc(expression, func(ref string) string {
+			input, inputErr := ruleStagePromQL(stages, mathReferenceRegexp.FindStringSubmatch(ref)[1], depth+1, datasourceType)
+			if inputErr != nil && err == nil {
+				err = inputErr
+			}
+			return "(" + input + ")"
+		})
+		return converted, err
+	case "reduce":
+		input, err := ruleStagePromQL(stages, expression, depth+1, datasourceType)
+		if err != nil {
+			return "", err
+		}
+		reducer, _ := stage.Model["reducer"].(string)
+		if reducer == "last" {
+			return input, nil
+		}
+		function, ok := prometheusOverTimeFunctions[reducer]
+		if !ok {
+			return "", fmt.Errorf("the reducer %q of stage %q has no PromQL equivalent", reducer, refID)
+		}
+		var rangeSeconds int64
+		for _, s := range stages {
+			if s.RefID == expression {
+				rangeSeconds = s.RelativeTimeRange.From
+			}
+		}
+		if rangeSeconds <= 0 {
+			return "", fmt.Errorf("stage %q reduces stage %q, which has no time range", refID, expression)
+		}
+		return fmt.Sprintf(function, fmt.Sprintf("(%s)[%ds:]", input, rangeSeconds)), nil
+	case "threshold":
+		input, err := ruleStagePromQL(stages, expression, depth+1, datasourceType)
+		if err != nil {
+			return "", err
+		}
+		conditions, _ := stage.Model["conditions"].([]interface{})
+		if len(conditions) != 1 {
+			return "", fmt.Errorf("expected stage %q to have a single condition", refID)
+		}
+		condition, _ := conditions[0].(map[string]interface{})
+		evaluator, _ := condition["evaluator"].(map[string]interface{})
+		evaluatorType, _ := evaluator["type"].(string)
+		params, _ := evaluator["params"].([]interface{})
+		values := make([]string, 0, len(params))
+		for _, p := range params {
+			value, ok := p.(float64)
+			if !ok {
+				return "", fmt.Errorf("invalid threshold of stage %q: %v", refID, p)
+			}
+			values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+		}
+		switch {
+		case evaluatorType == "gt" && len(values) >= 1:
+			return fmt.Sprintf("(%s) > %s", input, values[0]), nil
+		case evaluatorType == "lt" && len(values) >= 1:
+			return fmt.Sprintf("(%s) < %s", input, values[0]), nil
+		case evaluatorType == "within_range" && len(values) >= 2:
+			return fmt.Sprintf("((%s) > %s) < %s", input, values[0], values[1]), nil
+		case evaluatorType == "outside_range" && len(values) >= 2:
+			return fmt.Sprintf("(%s) < %s or (%s) > %s", input, values[0], input, values[1]), nil
+		}
+		return "", fmt.Errorf("the threshold %q of stage %q has no PromQL equivalent", evaluatorType, refID)
+	}
+	return "", fmt.Errorf("%s expressions (stage %q) have no PromQL equivalent", strings.ReplaceAll(exprType, "_", " "), refID)
+}
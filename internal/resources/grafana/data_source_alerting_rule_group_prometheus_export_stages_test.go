@@ -0,0 +1,155 @@
+package grafana
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRuleStagePromQL(t *testing.T) {
+	query := func(refID, datasourceUID, expr string, from int64) ruleQueryExport {
+		q := ruleQueryExport{RefID: refID, DatasourceUID: datasourceUID, Model: map[string]interface{}{"expr": expr}}
+		q.RelativeTimeRange.From = from
+		return q
+	}
+	expression := func(refID string, model map[string]interface{}) ruleQueryExport {
+		return ruleQueryExport{RefID: refID, DatasourceUID: expressionDatasourceUID, Model: model}
+	}
+	threshold := func(refID, input, evaluatorType string, params ...interface{}) ruleQueryExport {
+		return expression(refID, map[string]interface{}{
+			"type":       "threshold",
+			"expression": input,
+			"conditions": []interface{}{
+				map[string]interface{}{"evaluator": map[string]interface{}{"type": evaluatorType, "params": params}},
+			},
+		})
+	}
+	datasourceTypes := map[string]string{"prom": "prometheus", "loki": "loki"}
+	datasourceType := func(uid string) (string, error) {
+		if t, ok := datasourceTypes[uid]; ok {
+			return t, nil
+		}
+		return "", fmt.Errorf("data source %q not found", uid)
+	}
+
+	for _, tc := range []struct {
+		name          string
+		stages        []ruleQueryExport
+		condition     string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:      "query",
+			stages:    []ruleQueryExport{query("A", "prom", "up", 600)},
+			condition: "A",
+			expected:  "up",
+		},
+		{
+			name: "reduce and threshold",
+			stages: []ruleQueryExport{
+				query("A", "prom", "rate(errors[5m])", 600),
+				expression("B", map[string]interface{}{"type": "reduce", "expression": "A", "reducer": "mean"}),
+				threshold("C", "B", "gt", 0.5),
+			},
+			condition: "C",
+			expected:  "(avg_over_time((rate(errors[5m]))[600s:])) > 0.5",
+		},
+		{
+			name: "last reducer and range threshold",
+			stages: []ruleQueryExport{
+				query("A", "prom", "up", 600),
+				expression("B", map[string]interface{}{"type": "reduce", "expression": "A", "reducer": "last"}),
+				threshold("C", "B", "outside_range", 1.0, 2.0),
+			},
+			condition: "C",
+			expected:  "(up) < 1 or (up) > 2",
+		},
+		{
+			name: "math",
+			stages: []ruleQueryExport{
+				query("A", "prom", "errors", 600),
+				query("B", "prom", "requests", 600),
+				expression("C", map[string]interface{}{"type": "math", "expression": "$A / ${B} > 0.1"}),
+			},
+			condition: "C",
+			expected:  "(errors) / (requests) > 0.1",
+		},
+		{
+			name: "loop",
+			stages: []ruleQueryExport{
+				expression("A", map[string]interface{}{"type": "math", "expression": "$B"}),
+				expression("B", map[string]interface{}{"type": "math", "expression": "$A"}),
+			},
+			condition:     "A",
+			expectedError: "the stages of the rule reference each other in a loop",
+		},
+		{
+			name:          "missing stage",
+			stages:        []ruleQueryExport{expression("A", map[string]interface{}{"type": "math", "expression": "$B * 2"})},
+			condition:     "A",
+			expectedError: `stage "B" not found`,
+		},
+		{
+			name:          "non-Prometheus data source",
+			stages:        []ruleQueryExport{query("A", "loki", `count_over_time({job="app"}[5m])`, 600)},
+			condition:     "A",
+			expectedError: `stage "A" queries a loki data source, not a Prometheus one`,
+		},
+		{
+			name:          "unknown data source",
+			stages:        []ruleQueryExport{query("A", "missing", "up", 600)},
+			condition:     "A",
+			expectedError: `data source "missing" not found`,
+		},
+		{
+			name:          "query without expr",
+			stages:        []ruleQueryExport{query("A", "prom", "", 600)},
+			condition:     "A",
+			expectedError: "has no PromQL `expr`",
+		},
+		{
+			name: "reduce of a query without time range",
+			stages: []ruleQueryExport{
+				query("A", "prom", "up", 0),
+				expression("B", map[string]interface{}{"type": "reduce", "expression": "A", "reducer": "max"}),
+			},
+			condition:     "B",
+			expectedError: `stage "B" reduces stage "A", which has no time range`,
+		},
+		{
+			name: "unsupported reducer",
+			stages: []ruleQueryExport{
+				query("A", "prom", "up", 600),
+				expression("B", map[string]interface{}{"type": "reduce", "expression": "A", "reducer": "stddev"}),
+			},
+			condition:     "B",
+			expectedError: `the reducer "stddev" of stage "B" has no PromQL equivalent`,
+		},
+		{
+			name: "classic condition",
+			stages: []ruleQueryExport{
+				query("A", "prom", "up", 600),
+				expression("B", map[string]interface{}{"type": "classic_conditions"}),
+			},
+			condition:     "B",
+			expectedError: `classic conditions expressions (stage "B") have no PromQL equivalent`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := ruleStagePromQL(tc.stages, tc.condition, 0, datasourceType)
+			if tc.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+					t.Fatalf("expected an error containing %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if expr != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, expr)
+			}
+		})
+	}
+}
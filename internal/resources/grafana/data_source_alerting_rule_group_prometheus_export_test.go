@@ -0,0 +1,77 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceRuleGroupPrometheusExport_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+	name := "data.grafana_rule_group_prometheus_export.my_rule_group"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_rule_group_prometheus_export/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_rule_group", &group),
+					resource.TestMatchResourceAttr(name, "yaml", regexp.MustCompile(`- name: My Rule Group\n\s+interval: 1m\n`)),
+					resource.TestMatchResourceAttr(name, "yaml", regexp.MustCompile(`- alert: High request latency\n`)),
+					resource.TestMatchResourceAttr(name, "yaml", regexp.MustCompile(`expr: \(histogram_quantile\(0\.99, .*\)\) > 0\.5\n`)),
+					resource.TestMatchResourceAttr(name, "yaml", regexp.MustCompile(`for: 5m\n`)),
+					resource.TestMatchResourceAttr(name, "yaml", regexp.MustCompile(`severity: warning\n`)),
+					resource.TestCheckResourceAttr(name, "skipped_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceRuleGroupPrometheusExport_unsupported(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+	config := testutils.TestAccExample(t, "resources/grafana_rule_group/_acc_expressions.tf")
+	// The condition is computed from a resample expression, which has no PromQL equivalent
+	datasource := func(skip bool) string {
+		skipUnsupported := "false"
+		if skip {
+			skipUnsupported = "true"
+		}
+		return config + `
+data "grafana_rule_group_prometheus_export" "test" {
+  folder_uid             = grafana_rule_group.my_expressions_rule.folder_uid
+  name                   = grafana_rule_group.my_expressions_rule.name
+  skip_unsupported_rules = ` + skipUnsupported + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config:      datasource(false),
+				ExpectError: regexp.MustCompile(`rule "My Expressions Rule" can't be converted to a Prometheus rule: resample expressions \(stage "B"\) have no PromQL equivalent`),
+			},
+			{
+				Config: datasource(true),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_expressions_rule", &group),
+					resource.TestCheckResourceAttr("data.grafana_rule_group_prometheus_export.test", "skipped_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_rule_group_prometheus_export.test", "skipped_rules.0", "My Expressions Rule"),
+					resource.TestMatchResourceAttr("data.grafana_rule_group_prometheus_export.test", "yaml", regexp.MustCompile(`rules: \[\]\n`)),
+				),
+			},
+		},
+	})
+}
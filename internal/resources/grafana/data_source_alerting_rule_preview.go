@@ -0,0 +1,199 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// rulePreviewExport is a rule of the JSON export of a rule group, with its queries kept as exported so that they can be sent back to Grafana.
+type rulePreviewExport struct {
+	Title       string            `json:"title"`
+	Condition   string            `json:"condition"`
+	Data        []json.RawMessage `json:"data"`
+	For         string            `json:"for"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type rulePreviewGroupExport struct {
+	Groups []struct {
+		Rules []rulePreviewExport `json:"rules"`
+	} `json:"groups"`
+}
+
+// rulePreviewRequest is the body of the rule testing endpoint, which the rule editor of Grafana uses to preview rules.
+type rulePreviewRequest struct {
+	Rule struct {
+		GrafanaAlert struct {
+			Title     string            `json:"title"`
+			Condition string            `json:"condition"`
+			Data      []json.RawMessage `json:"data"`
+		} `json:"grafana_alert"`
+		For         string            `json:"for,omitempty"`
+		Labels      map[string]string `json:"labels,omitempty"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"rule"`
+	FolderUID string `json:"folderUid"`
+	RuleGroup string `json:"ruleGroup"`
+}
+
+// rulePreviewAlert is an alert instance returned by the rule testing endpoint, with its labels and annotations rendered.
+type rulePreviewAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+func DatasourceRulePreview() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Evaluates a rule of a rule group and renders the templates of its labels and annotations for each of the resulting alert instances,
+like the preview of the rule editor of Grafana. This can be used to check the templated annotations of rules managed by Terraform, e.g. with a ` + "`check`" + ` block.
+
+The rule is evaluated each time the data source is read, with the queries, labels and annotations of the rule as stored in Grafana.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/alerting-rules/templates/)
+`,
+		ReadContext: readRulePreview,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"folder_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the folder of the rule group.",
+			},
+			"rule_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the rule group.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the rule to preview.",
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The alert instances resulting from the evaluation of the rule, sorted by their labels.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The rendered labels of the instance, including the labels added by Grafana.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The rendered annotations of the instance.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readRulePreview(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	client := meta.(*common.Client)
+	key := AlertRuleGroupKey{FolderUID: d.Get("folder_uid").(string), Name: d.Get("rule_group").(string)}
+	name := d.Get("name").(string)
+
+	path := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s/export", url.PathEscape(key.FolderUID), url.PathEscape(key.Name))
+	status, body, err := grafanaAPIRequest(ctx, client, orgID, http.MethodGet, path, url.Values{"format": {"json"}}, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 {
+		return diag.Errorf("failed to export rule group %q: status: %d, body: %s", key.Name, status, body)
+	}
+	var export rulePreviewGroupExport
+	if err := json.Unmarshal(body, &export); err != nil {
+		return diag.Errorf("failed to decode the export of rule group %q: %v", key.Name, err)
+	}
+
+	req, err := newRulePreviewRequest(export, key, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	status, body, err = grafanaAPIRequest(ctx, client, orgID, http.MethodPost, "/api/v1/rule/test/grafana", nil, "application/json", reqBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 {
+		return diag.Errorf("failed to preview rule %q: status: %d, body: %s", name, status, body)
+	}
+	var alerts []rulePreviewAlert
+	if err := json.Unmarshal(body, &alerts); err != nil {
+		return diag.Errorf("failed to decode the preview of rule %q: %v", name, err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, packGroupID(key)+groupIDSeparator+name))
+	d.Set("instances", flattenRulePreviewAlerts(alerts))
+	return nil
+}
+
+// newRulePreviewRequest builds the request previewing the rule with the given name, from the export of its group.
+func newRulePreviewRequest(export rulePreviewGroupExport, key AlertRuleGroupKey, name string) (*rulePreviewRequest, error) {
+	if len(export.Groups) != 1 {
+		return nil, fmt.Errorf("expected the export of rule group %q to contain a single group, got %d", key.Name, len(export.Groups))
+	}
+	for _, rule := range export.Groups[0].Rules {
+		if rule.Title != name {
+			continue
+		}
+		req := &rulePreviewRequest{FolderUID: key.FolderUID, RuleGroup: key.Name}
+		req.Rule.GrafanaAlert.Title = rule.Title
+		req.Rule.GrafanaAlert.Condition = rule.Condition
+		req.Rule.GrafanaAlert.Data = rule.Data
+		req.Rule.For = rule.For
+		req.Rule.Labels = rule.Labels
+		req.Rule.Annotations = rule.Annotations
+		return req, nil
+	}
+	return nil, fmt.Errorf("rule %q not found in rule group %q", name, key.Name)
+}
+
+// flattenRulePreviewAlerts sorts the alert instances by their labels, so that the order of the instances is stable across reads.
+func flattenRulePreviewAlerts(alerts []rulePreviewAlert) []interface{} {
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return rulePreviewLabelsKey(alerts[i].Labels) < rulePreviewLabelsKey(alerts[j].Labels)
+	})
+	instances := make([]interface{}, 0, len(alerts))
+	for _, alert := range alerts {
+		instances = append(instances, map[string]interface{}{
+			"labels":      alert.Labels,
+			"annotations": alert.Annotations,
+		})
+	}
+	return instances
+}
+
+func rulePreviewLabelsKey(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	key := ""
+	for _, name := range names {
+		key += name + "=" + labels[name] + "\x00"
+	}
+	return key
+}
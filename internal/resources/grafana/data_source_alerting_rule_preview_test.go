@@ -0,0 +1,45 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceRulePreview_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+	name := "data.grafana_rule_preview.my_rule"
+	config := testutils.TestAccExample(t, "data-sources/grafana_rule_preview/data-source.tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_rule_group", &group),
+					resource.TestCheckResourceAttr(name, "instances.#", "1"),
+					resource.TestCheckResourceAttr(name, "instances.0.labels.severity", "warning"),
+					resource.TestCheckResourceAttr(name, "instances.0.labels.alertname", "My Rule"),
+					resource.TestCheckResourceAttr(name, "instances.0.annotations.summary", "The value is 4 (warning)"),
+				),
+			},
+			{
+				Config: config + `
+data "grafana_rule_preview" "missing" {
+  folder_uid = grafana_rule_group.my_rule_group.folder_uid
+  rule_group = grafana_rule_group.my_rule_group.name
+  name       = "Missing Rule"
+}
+`,
+				ExpectError: regexp.MustCompile(`rule "Missing Rule" not found in rule group "My Rule Group"`),
+			},
+		},
+	})
+}
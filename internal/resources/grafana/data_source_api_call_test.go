@@ -0,0 +1,37 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceAPICall_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_api_call/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_api_call.health", "status_code", "200"),
+					resource.TestCheckResourceAttrSet("data.grafana_api_call.health", "response_body"),
+					resource.TestMatchResourceAttr("data.grafana_api_call.health", "response_exports.version", regexp.MustCompile(`^\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttr("data.grafana_api_call.current_org", "response_exports.name", "Main Org."),
+				),
+			},
+			// The data source can't modify anything
+			{
+				Config: `
+data "grafana_api_call" "post" {
+  method = "POST"
+  path   = "/api/folders"
+}`,
+				ExpectError: regexp.MustCompile(`expected method to be one of \["GET"\], got POST`),
+			},
+		},
+	})
+}
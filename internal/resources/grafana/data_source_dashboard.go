@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/search"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,15 +28,15 @@ func DatasourceDashboard() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      -1,
-				ExactlyOneOf: []string{"dashboard_id", "uid"},
-				Description:  "The numerical ID of the Grafana dashboard. Specify either this or `uid`.",
+				ExactlyOneOf: []string{"dashboard_id", "uid", "title"},
+				Description:  "The numerical ID of the Grafana dashboard. Specify either this, `uid` or `title`.",
 			},
 			"uid": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "",
-				ExactlyOneOf: []string{"dashboard_id", "uid"},
-				Description:  "The uid of the Grafana dashboard. Specify either this or `dashboard_id`.",
+				ExactlyOneOf: []string{"dashboard_id", "uid", "title"},
+				Description:  "The uid of the Grafana dashboard. Specify either this, `dashboard_id` or `title`.",
 			},
 			"config_json": {
 				Type:        schema.TypeString,
@@ -46,15 +49,24 @@ func DatasourceDashboard() *schema.Resource {
 				Description: "The numerical version of the Grafana dashboard.",
 			},
 			"title": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The title of the Grafana dashboard.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"dashboard_id", "uid", "title"},
+				Description:  "The title of the Grafana dashboard. Specify either this, `dashboard_id` or `uid`. When looking up a dashboard by title, set `folder_uid` if the title isn't unique across folders.",
 			},
 			"folder": {
 				Type:        schema.TypeInt,
 				Computed:    true,
 				Description: "The numerical ID of the folder where the Grafana dashboard is found.",
 			},
+			"folder_uid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"title"},
+				Description:  "The UID of the folder where the Grafana dashboard is found. Can be set along with `title` to look up a dashboard by title in a given folder.",
+			},
 			"is_starred": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -70,37 +82,37 @@ func DatasourceDashboard() *schema.Resource {
 				Computed:    true,
 				Description: "The full URL of the dashboard.",
 			},
+			"render_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of a PNG image of the dashboard, rendered by the Grafana image renderer. Query parameters such as `from`, `to`, `width` and `height` can be appended to it.",
+			},
 		},
 	}
 }
 
 func dataSourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
-	// get UID from ID if specified
+	// get UID from ID or title if specified
 	id := d.Get("dashboard_id").(int)
 	uid := d.Get("uid").(string)
+	if title := d.Get("title").(string); uid == "" && title != "" {
+		var err error
+		if uid, err = findDashboardUIDByTitle(client, title, d.Get("folder_uid").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if uid == "" {
 		if id < 1 {
-			return diag.FromErr(fmt.Errorf("must specify either `dashboard_id` or `uid`"))
+			return diag.FromErr(fmt.Errorf("must specify either `dashboard_id`, `uid` or `title`"))
 		}
 
-		searchType := "dash-db"
-		params := search.NewSearchParams().WithType(&searchType).WithDashboardIds([]int64{int64(id)})
-		resp, err := client.Search.Search(params)
-		if err != nil {
+		var err error
+		if uid, err = dashboardUIDFromID(client, int64(id)); err != nil {
 			return diag.FromErr(err)
 		}
-		for _, d := range resp.GetPayload() {
-			if d.ID == int64(id) {
-				uid = d.UID
-				break
-			}
-		}
-		if uid == "" {
-			return diag.FromErr(fmt.Errorf("no dashboard with id %d", id))
-		}
 	}
 
 	resp, err := client.Dashboards.GetDashboardByUID(uid)
@@ -121,9 +133,42 @@ func dataSourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("version", int64(model["version"].(float64)))
 	d.Set("title", model["title"].(string))
 	d.Set("folder", dashboard.Meta.FolderID)
+	d.Set("folder_uid", dashboard.Meta.FolderUID)
 	d.Set("is_starred", dashboard.Meta.IsStarred)
 	d.Set("slug", dashboard.Meta.Slug)
 	d.Set("url", metaClient.GrafanaSubpath(dashboard.Meta.URL))
+	d.Set("render_url", dashboardViewURL(metaClient, dashboard.Meta.URL, "render/d", url.Values{"orgId": {strconv.FormatInt(orgID, 10)}}))
 
 	return nil
 }
+
+// findDashboardUIDByTitle searches for a dashboard with the exact given title.
+// If folderUID is empty, the dashboard is searched in all folders and the title must be unique.
+func findDashboardUIDByTitle(client *goapi.GrafanaHTTPAPI, title, folderUID string) (string, error) {
+	searchType := "dash-db"
+	params := search.NewSearchParams().WithType(&searchType).WithQuery(&title)
+	if folderUID != "" {
+		params.SetFolderUIDs([]string{folderUID})
+	}
+	resp, err := client.Search.Search(params)
+	if err != nil {
+		return "", err
+	}
+
+	var uids []string
+	for _, hit := range resp.GetPayload() {
+		// The search is a substring match, only keep exact matches
+		if hit.Title == title && (folderUID == "" || hit.FolderUID == folderUID) {
+			uids = append(uids, hit.UID)
+		}
+	}
+	switch {
+	case len(uids) == 0 && folderUID != "":
+		return "", fmt.Errorf("no dashboard with title %q in folder %q", title, folderUID)
+	case len(uids) == 0:
+		return "", fmt.Errorf("no dashboard with title %q", title)
+	case len(uids) > 1:
+		return "", fmt.Errorf("found %d dashboards with title %q, set `folder_uid` to select one of them: %v", len(uids), title, uids)
+	}
+	return uids[0], nil
+}
@@ -0,0 +1,113 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// libraryPanelExcludedFields are the fields of a dashboard panel that aren't part of the model of a library panel,
+// since they're specific to the panel's place in the dashboard.
+var libraryPanelExcludedFields = []string{"gridPos", "id", "libraryPanel"}
+
+func DatasourceDashboardPanel() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Extracts a panel from the JSON model of a dashboard, as the model of a library panel.
+The ` + "`model_json`" + ` attribute can be used as the ` + "`model_json`" + ` of a ` + "`grafana_library_panel`" + ` resource, to turn a panel copied across dashboards into a library panel.
+
+The dashboard is either read from Grafana, by UID, or given as JSON, e.g. the ` + "`config_json`" + ` of a ` + "`grafana_dashboard`" + ` resource.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/build-dashboards/manage-library-panels/)
+`,
+		ReadContext: readDashboardPanel,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"dashboard_uid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"dashboard_uid", "config_json"},
+				Description:  "The UID of the dashboard to read from Grafana.",
+			},
+			"config_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "The JSON model of the dashboard.",
+			},
+			"panel_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the panel, including the panels of collapsed rows.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The title of the panel.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the panel, e.g. `timeseries`.",
+			},
+			"model_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON model of the library panel, i.e. the panel without its `id`, `gridPos` and `libraryPanel` fields.",
+			},
+		},
+	}
+}
+
+func readDashboardPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	panelID := d.Get("panel_id").(int)
+
+	var model map[string]interface{}
+	dashboardRef := "the dashboard of config_json"
+	if uid := d.Get("dashboard_uid").(string); uid != "" {
+		resp, err := client.Dashboards.GetDashboardByUID(uid)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		model, _ = resp.GetPayload().Dashboard.(map[string]interface{})
+		dashboardRef = fmt.Sprintf("dashboard %q", uid)
+		d.SetId(MakeOrgResourceID(orgID, fmt.Sprintf("%s:%d", uid, panelID)))
+	} else {
+		if err := json.Unmarshal([]byte(d.Get("config_json").(string)), &model); err != nil {
+			return diag.FromErr(err)
+		}
+		uid, _ := model["uid"].(string)
+		d.SetId(MakeOrgResourceID(orgID, fmt.Sprintf("%s:%d", uid, panelID)))
+	}
+
+	panel := findDashboardPanel(model, panelID)
+	if panel == nil {
+		return diag.Errorf("%s has no panel with ID %d", dashboardRef, panelID)
+	}
+	panelType, _ := panel["type"].(string)
+	if panelType == "row" {
+		return diag.Errorf("panel %d of %s is a row, which can't be a library panel", panelID, dashboardRef)
+	}
+
+	libraryPanel := make(map[string]interface{}, len(panel))
+	for k, v := range panel {
+		libraryPanel[k] = v
+	}
+	for _, field := range libraryPanelExcludedFields {
+		delete(libraryPanel, field)
+	}
+
+	title, _ := panel["title"].(string)
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("title", title)
+	d.Set("type", panelType)
+	d.Set("model_json", normalizeLibraryPanelModelJSON(libraryPanel))
+
+	return nil
+}
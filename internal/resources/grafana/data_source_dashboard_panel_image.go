@@ -0,0 +1,162 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DatasourceDashboardPanelImage() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Builds the URLs of a dashboard panel: the URL of a PNG image of the panel rendered by the Grafana image renderer, and the URL of the panel alone, e.g. for embedding it in an iframe.
+The dashboard and its panel are checked to exist, but the image isn't rendered: the image renderer must be installed for the image URL to work.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/)
+`,
+		ReadContext: readDashboardPanelImage,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"dashboard_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the dashboard.",
+			},
+			"panel_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the panel, e.g. the `id` of a panel of the dashboard's `config_json`.",
+			},
+			"from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "now-6h",
+				Description: "The start of the time range, e.g. `now-1h` or a timestamp in milliseconds.",
+			},
+			"to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "now",
+				Description: "The end of the time range, e.g. `now` or a timestamp in milliseconds.",
+			},
+			"width": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The width of the image, in pixels.",
+			},
+			"height": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      500,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The height of the image, in pixels.",
+			},
+			"theme": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"light", "dark"}, false),
+				Description:  "The theme of the image, `light` or `dark`. Defaults to the theme of the organization.",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The timezone of the image, e.g. `utc` or `Europe/Paris`. Defaults to the timezone of the dashboard.",
+			},
+			"variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Values of the dashboard's template variables, by variable name.",
+			},
+			"panel_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the panel alone, with the same time range and variables.",
+			},
+			"render_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the PNG image of the panel.",
+			},
+		},
+	}
+}
+
+func readDashboardPanelImage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	uid := d.Get("dashboard_uid").(string)
+	panelID := d.Get("panel_id").(int)
+
+	resp, err := client.Dashboards.GetDashboardByUID(uid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dashboard := resp.GetPayload()
+	model, _ := dashboard.Dashboard.(map[string]interface{})
+	if !dashboardHasPanel(model, panelID) {
+		return diag.Errorf("dashboard %q has no panel with ID %d", uid, panelID)
+	}
+
+	query := url.Values{
+		"orgId":   {strconv.FormatInt(orgID, 10)},
+		"panelId": {strconv.Itoa(panelID)},
+		"from":    {d.Get("from").(string)},
+		"to":      {d.Get("to").(string)},
+	}
+	if theme := d.Get("theme").(string); theme != "" {
+		query.Set("theme", theme)
+	}
+	if timezone := d.Get("timezone").(string); timezone != "" {
+		query.Set("tz", timezone)
+	}
+	for name, value := range d.Get("variables").(map[string]interface{}) {
+		query.Set("var-"+name, value.(string))
+	}
+
+	metaClient := meta.(*common.Client)
+	d.SetId(MakeOrgResourceID(orgID, fmt.Sprintf("%s:%d", uid, panelID)))
+	d.Set("panel_url", dashboardViewURL(metaClient, dashboard.Meta.URL, "d-solo", query))
+	query.Set("width", strconv.Itoa(d.Get("width").(int)))
+	query.Set("height", strconv.Itoa(d.Get("height").(int)))
+	d.Set("render_url", dashboardViewURL(metaClient, dashboard.Meta.URL, "render/d-solo", query))
+
+	return nil
+}
+
+// dashboardHasPanel returns whether the dashboard model has a panel with the given ID, including the panels of collapsed rows.
+func dashboardHasPanel(model map[string]interface{}, panelID int) bool {
+	return findDashboardPanel(model, panelID) != nil
+}
+
+// findDashboardPanel returns the panel of the dashboard model with the given ID, including the panels of collapsed rows, or nil if there is none.
+func findDashboardPanel(model map[string]interface{}, panelID int) map[string]interface{} {
+	panels, _ := model["panels"].([]interface{})
+	for _, p := range panels {
+		panel, _ := p.(map[string]interface{})
+		if id, ok := panel["id"].(float64); ok && int(id) == panelID {
+			return panel
+		}
+		if found := findDashboardPanel(panel, panelID); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// dashboardViewURL returns the full URL of a view of a dashboard (e.g. `render/d` for the image of the dashboard),
+// from the URL of the dashboard returned by Grafana (`/d/{uid}/{slug}`).
+func dashboardViewURL(client *common.Client, dashboardURL, view string, query url.Values) string {
+	path := strings.TrimPrefix(dashboardURL, client.GrafanaAPIURLParsed.Path)
+	u := client.GrafanaAPIURLParsed.JoinPath(view, strings.TrimPrefix(path, "/d/"))
+	u.RawQuery = query.Encode()
+	return u.String()
+}
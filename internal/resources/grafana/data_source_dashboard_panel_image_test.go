@@ -0,0 +1,42 @@
+package grafana_test
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDashboardPanelImage_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	grafanaURL := strings.TrimRight(os.Getenv("GRAFANA_URL"), "/")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_dashboard_panel_image/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel_image.requests", "panel_url",
+						grafanaURL+"/d-solo/production-overview-images/production-overview?from=now-24h&orgId=1&panelId=2&theme=light&to=now"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel_image.requests", "render_url",
+						grafanaURL+"/render/d-solo/production-overview-images/production-overview?from=now-24h&height=400&orgId=1&panelId=2&theme=light&to=now&width=800"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_dashboard_panel_image/data-source.tf", map[string]string{
+					"panel_id      = 2": "panel_id      = 3",
+				}),
+				ExpectError: regexp.MustCompile(`dashboard "production-overview-images" has no panel with ID 3`),
+			},
+		},
+	})
+}
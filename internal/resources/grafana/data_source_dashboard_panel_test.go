@@ -0,0 +1,80 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDashboardPanel_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=8.0.0")
+
+	var dashboard models.DashboardFullWithMeta
+	var panel models.LibraryElementResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			dashboardCheckExists.destroyed(&dashboard, nil),
+			libraryPanelCheckExists.destroyed(&panel, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_dashboard_panel/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					libraryPanelCheckExists.exists("grafana_library_panel.requests", &panel),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel.requests", "title", "Requests"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel.requests", "type", "timeseries"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel.requests", "model_json", `{"description":"","title":"Requests","type":"timeseries"}`),
+					resource.TestCheckResourceAttr("grafana_library_panel.requests", "name", "Requests"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_dashboard_panel/data-source.tf", map[string]string{
+					"panel_id      = 2": "panel_id      = 3",
+				}),
+				ExpectError: regexp.MustCompile(`dashboard "production-overview-panels" has no panel with ID 3`),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceDashboardPanel_configJSON(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "grafana_dashboard_panel" "test" {
+  config_json = jsonencode({
+    uid = "panels"
+    panels = [{
+      id        = 1
+      type      = "row"
+      collapsed = true
+      panels = [{
+        id      = 4
+        title   = "Errors"
+        type    = "stat"
+        gridPos = { x = 0, y = 1, h = 4, w = 6 }
+        options = { colorMode = "background" }
+      }]
+    }]
+  })
+  panel_id = 4
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel.test", "title", "Errors"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel.test", "type", "stat"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_panel.test", "model_json", `{"description":"","options":{"colorMode":"background"},"title":"Errors","type":"stat"}`),
+				),
+			},
+		},
+	})
+}
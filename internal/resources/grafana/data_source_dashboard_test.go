@@ -39,6 +39,21 @@ func TestAccDatasourceDashboard_basic(t *testing.T) {
 		resource.TestCheckResourceAttr(
 			"data.grafana_dashboard.from_uid", "url", strings.TrimRight(os.Getenv("GRAFANA_URL"), "/")+"/d/test-ds-dashboard-uid/production-overview",
 		),
+		resource.TestCheckResourceAttr(
+			"data.grafana_dashboard.from_uid", "render_url", strings.TrimRight(os.Getenv("GRAFANA_URL"), "/")+"/render/d/test-ds-dashboard-uid/production-overview?orgId=1",
+		),
+		resource.TestCheckResourceAttr(
+			"data.grafana_dashboard.from_title", "uid", "test-ds-dashboard-uid",
+		),
+		resource.TestCheckResourceAttr(
+			"data.grafana_dashboard.from_title", "folder_uid", "test-ds-dashboard-folder-uid",
+		),
+		resource.TestCheckResourceAttrPair(
+			"data.grafana_dashboard.from_title", "version", "grafana_dashboard.test", "version",
+		),
+		resource.TestCheckResourceAttrSet(
+			"data.grafana_dashboard.from_title", "config_json",
+		),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -35,12 +35,15 @@ func DatasourceDatasource() *schema.Resource {
 			},
 			"secure_json_data_encoded": nil,
 			"http_headers":             nil,
+			"azure_auth":               nil,
+			"gcp_auth":                 nil,
+			"aws_auth":                 nil,
 		}),
 	}
 }
 
 func datasourceDatasourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var resp interface{ GetPayload() *models.DataSource }
 	var err error
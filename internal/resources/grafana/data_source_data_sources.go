@@ -0,0 +1,90 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceDatasources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readDatasources,
+		Description: `
+Lists the data sources of an organization, e.g. to discover the data sources created by Grafana Cloud integrations.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the data sources of this type, e.g. `prometheus`.",
+			},
+			"data_sources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The data sources, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data source's unique identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data source's name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data source's type.",
+						},
+						"is_default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the data source is the default data source of the organization.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readDatasources(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+
+	resp, err := client.Datasources.GetDataSources()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.SliceStable(resp.Payload, func(i, j int) bool {
+		return strings.ToLower(resp.Payload[i].Name) < strings.ToLower(resp.Payload[j].Name)
+	})
+
+	dsType := d.Get("type").(string)
+	dataSources := make([]interface{}, 0, len(resp.Payload))
+	for _, ds := range resp.Payload {
+		if dsType != "" && ds.Type != dsType {
+			continue
+		}
+		dataSources = append(dataSources, map[string]interface{}{
+			"uid":        ds.UID,
+			"name":       ds.Name,
+			"type":       ds.Type,
+			"is_default": ds.IsDefault,
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "data_sources:"+dsType))
+	return diag.FromErr(d.Set("data_sources", dataSources))
+}
@@ -0,0 +1,39 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDatasources_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var prometheus models.DataSource
+	var loki models.DataSource
+
+	// TODO: Make parallelizable
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			datasourceCheckExists.destroyed(&prometheus, nil),
+			datasourceCheckExists.destroyed(&loki, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_data_sources/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.prometheus", &prometheus),
+					datasourceCheckExists.exists("grafana_data_source.loki", &loki),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.0.name", "prometheus-ds-test"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.0.type", "prometheus"),
+					resource.TestCheckResourceAttrPair("data.grafana_data_sources.prometheus", "data_sources.0.uid", "grafana_data_source.prometheus", "uid"),
+					resource.TestCheckResourceAttr("data.grafana_data_sources.prometheus", "data_sources.0.is_default", "false"),
+				),
+			},
+		},
+	})
+}
@@ -2,14 +2,19 @@ package grafana
 
 import (
 	"context"
+	"strings"
 
 	"github.com/grafana/grafana-openapi-client-go/client/search"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// foldersSearchPageSize is the number of folders fetched by each search request.
+const foldersSearchPageSize = 1000
+
 func DatasourceFolders() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: readFolders,
@@ -18,12 +23,38 @@ func DatasourceFolders() *schema.Resource {
 		},
 
 		Description: `
+Lists the folders of an organization that the provider's credentials can see, optionally filtered by permission, parent folder and title.
+The permission filter doesn't require admin credentials: it allows, for example, a team's service account to find the folders it can write to.
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/manage-dashboards/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/folder/)
 `,
 
 		Schema: map[string]*schema.Schema{
 			"org_id": orgIDAttribute(),
+			"permission": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"View", "Edit"}, false),
+				Description:  "Only list the folders on which the provider's credentials have this permission: `View` or `Edit`.",
+			},
+			"parent_folder_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the subfolders of the folder with this UID. Requires nested folders.",
+			},
+			"title_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the folders whose title starts with this prefix, case-insensitively.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of folders to list. All the matching folders are listed if 0.",
+			},
 			"folders": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -50,6 +81,11 @@ func DatasourceFolders() *schema.Resource {
 							Computed:    true,
 							Description: "The folder's URL",
 						},
+						"parent_uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UID of the parent folder. Empty for top level folders.",
+						},
 					},
 				},
 			},
@@ -59,22 +95,39 @@ func DatasourceFolders() *schema.Resource {
 
 func readFolders(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
+	limit := d.Get("limit").(int)
+	titlePrefix := strings.ToLower(d.Get("title_prefix").(string))
 	var folders []*models.Hit
 	var page int64 = 1
+	pageSize := int64(foldersSearchPageSize)
 	searchType := "dash-folder"
-	for {
-		params := search.NewSearchParams().WithType(&searchType).WithPage(&page)
+	for limit == 0 || len(folders) < limit {
+		params := search.NewSearchParams().WithType(&searchType).WithPage(&page).WithLimit(&pageSize)
+		if permission := d.Get("permission").(string); permission != "" {
+			params.SetPermission(&permission)
+		}
+		if parentUID := d.Get("parent_folder_uid").(string); parentUID != "" {
+			params.SetFolderUIDs([]string{parentUID})
+		}
+		if titlePrefix != "" {
+			// The search matches the query anywhere in the title
+			params.SetQuery(&titlePrefix)
+		}
 		resp, err := client.Search.Search(params)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		if len(resp.Payload) == 0 {
+
+		for _, folder := range resp.Payload {
+			if strings.HasPrefix(strings.ToLower(folder.Title), titlePrefix) && (limit == 0 || len(folders) < limit) {
+				folders = append(folders, folder)
+			}
+		}
+		if int64(len(resp.Payload)) < pageSize {
 			break
 		}
-
-		folders = append(folders, resp.Payload...)
 		page++
 	}
 
@@ -83,10 +136,11 @@ func readFolders(ctx context.Context, d *schema.ResourceData, meta interface{})
 	folderItems := make([]interface{}, 0)
 	for _, folder := range folders {
 		f := map[string]interface{}{
-			"title": folder.Title,
-			"id":    folder.ID,
-			"uid":   folder.UID,
-			"url":   metaClient.GrafanaSubpath(folder.URL),
+			"title":      folder.Title,
+			"id":         folder.ID,
+			"uid":        folder.UID,
+			"url":        metaClient.GrafanaSubpath(folder.URL),
+			"parent_uid": folder.FolderUID,
 		}
 		folderItems = append(folderItems, f)
 	}
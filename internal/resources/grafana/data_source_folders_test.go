@@ -34,6 +34,11 @@ func TestAccDatasourceFolders_basic(t *testing.T) {
 			"title": titleBase + "b",
 			"url":   fmt.Sprintf("%s/dashboards/f/%s/%s", strings.TrimRight(os.Getenv("GRAFANA_URL"), "/"), uidBase+"b", titleBase+"b"),
 		}),
+		resource.TestCheckResourceAttr("data.grafana_folders.editable", "folders.#", "1"),
+		resource.TestCheckTypeSetElemNestedAttrs("data.grafana_folders.editable", "folders.*", map[string]string{
+			"uid":        uidBase + "a",
+			"parent_uid": "",
+		}),
 	}
 
 	// TODO: Make parallelizable
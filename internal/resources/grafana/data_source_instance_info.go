@@ -0,0 +1,117 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/client/admin"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceInstanceInfo() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Reads the version, edition and enabled features of the Grafana instance, so that modules can adapt to the capabilities of the instance,
+e.g. with ` + "`count = data.grafana_instance_info.this.enterprise ? 1 : 0`" + ` on Grafana Enterprise resources.
+
+The database type is read from the settings of the instance, which requires the server admin role: it is empty for other users.
+`,
+		ReadContext: readInstanceInfo,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of Grafana, e.g. `10.2.3`.",
+			},
+			"commit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The commit Grafana was built from.",
+			},
+			"edition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The edition of Grafana, e.g. `Open Source` or `Enterprise`.",
+			},
+			"enterprise": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the instance runs Grafana Enterprise (or Grafana Cloud), with the Enterprise features available.",
+			},
+			"feature_toggles": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The feature toggles enabled on the instance, by name. Toggles enabled by default may be missing in older versions of Grafana.",
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+			"unified_alerting_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Grafana Alerting (unified alerting) is enabled, rather than legacy alerting.",
+			},
+			"database_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the database of Grafana, e.g. `sqlite3`, `mysql` or `postgres`. Empty if the provider's credentials don't have the server admin role.",
+			},
+		},
+	}
+}
+
+func readInstanceInfo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.Client)
+
+	// The frontend settings aren't supported by the Grafana OpenAPI client
+	status, body, err := grafanaAPIRequest(ctx, client, 0, http.MethodGet, "/api/frontend/settings", nil, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 {
+		return diag.Errorf("failed to read the frontend settings of Grafana: status: %d, body: %s", status, body)
+	}
+	var settings struct {
+		BuildInfo struct {
+			Version string `json:"version"`
+			Commit  string `json:"commit"`
+			Edition string `json:"edition"`
+		} `json:"buildInfo"`
+		FeatureToggles         map[string]bool `json:"featureToggles"`
+		UnifiedAlertingEnabled bool            `json:"unifiedAlertingEnabled"`
+	}
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return diag.Errorf("failed to decode the frontend settings of Grafana: %v", err)
+	}
+
+	databaseType := ""
+	resp, err := OAPIGlobalClient(ctx, meta).Admin.AdminGetSettings()
+	var forbidden *admin.AdminGetSettingsForbidden
+	var unauthorized *admin.AdminGetSettingsUnauthorized
+	switch {
+	case err == nil:
+		databaseType = resp.Payload["database"]["type"]
+	case errors.As(err, &forbidden), errors.As(err, &unauthorized):
+	default:
+		return diag.Errorf("failed to read the settings of Grafana: %v", err)
+	}
+
+	featureToggles := settings.FeatureToggles
+	if featureToggles == nil {
+		featureToggles = map[string]bool{}
+	}
+
+	d.SetId(client.GrafanaAPIURL)
+	d.Set("version", settings.BuildInfo.Version)
+	d.Set("commit", settings.BuildInfo.Commit)
+	d.Set("edition", settings.BuildInfo.Edition)
+	d.Set("enterprise", strings.Contains(strings.ToLower(settings.BuildInfo.Edition), "enterprise"))
+	d.Set("feature_toggles", featureToggles)
+	d.Set("unified_alerting_enabled", settings.UnifiedAlertingEnabled)
+	d.Set("database_type", databaseType)
+
+	return nil
+}
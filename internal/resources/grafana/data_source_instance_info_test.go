@@ -0,0 +1,29 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceInstanceInfo_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_instance_info/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.grafana_instance_info.this", "version", regexp.MustCompile(`^\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttrSet("data.grafana_instance_info.this", "edition"),
+					resource.TestCheckResourceAttrSet("data.grafana_instance_info.this", "commit"),
+					resource.TestCheckResourceAttr("data.grafana_instance_info.this", "unified_alerting_enabled", "true"),
+					resource.TestMatchResourceAttr("data.grafana_instance_info.this", "database_type", regexp.MustCompile(`^(sqlite3|mysql|postgres)$`)),
+				),
+			},
+		},
+	})
+}
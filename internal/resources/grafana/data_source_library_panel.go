@@ -13,7 +13,14 @@ func DatasourceLibraryPanel() *schema.Resource {
 		Description: "Data source for retrieving a single library panel by name or uid.",
 		ReadContext: dataSourceLibraryPanelRead,
 		Schema: common.CloneResourceSchemaForDatasource(ResourceLibraryPanel(), map[string]*schema.Schema{
-			"org_id": orgIDAttribute(),
+			"org_id":        orgIDAttribute(),
+			"config_url":    nil,
+			"config_sha256": nil,
+			"model_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON model for the library panel.",
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -29,7 +36,7 @@ func DatasourceLibraryPanel() *schema.Resource {
 }
 
 func dataSourceLibraryPanelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	uid := d.Get("uid").(string)
 
 	if uid == "" {
@@ -52,7 +52,7 @@ func DatasourceOrganization() *schema.Resource {
 }
 
 func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	name := d.Get("name").(string)
 
 	org, err := client.Orgs.GetOrgByName(name)
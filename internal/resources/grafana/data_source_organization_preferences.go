@@ -23,7 +23,7 @@ func DatasourceOrganizationPreferences() *schema.Resource {
 }
 
 func dataSourceOrganizationPreferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	resp, err := client.OrgPreferences.GetOrgPreferences()
 	if err != nil {
 		return diag.FromErr(err)
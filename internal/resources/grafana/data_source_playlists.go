@@ -0,0 +1,91 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/client/playlists"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourcePlaylists() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readPlaylists,
+		Description: `
+Lists the playlists of an organization, e.g. to find the IDs of the playlists created in the UI.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/create-manage-playlists/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/playlist/)
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"query": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the playlists whose name contains this string.",
+			},
+			"playlists": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The playlists, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The playlist's ID, which can be used to import it in the `grafana_playlist` resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The playlist's name.",
+						},
+						"interval": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The playlist's interval.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readPlaylists(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+
+	params := playlists.NewSearchPlaylistsParams()
+	query := d.Get("query").(string)
+	if query != "" {
+		params.SetQuery(&query)
+	}
+	resp, err := client.Playlists.SearchPlaylists(params)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.SliceStable(resp.Payload, func(i, j int) bool {
+		return strings.ToLower(resp.Payload[i].Name) < strings.ToLower(resp.Payload[j].Name)
+	})
+
+	result := make([]interface{}, 0, len(resp.Payload))
+	for _, playlist := range resp.Payload {
+		id := playlist.UID
+		if id == "" {
+			id = strconv.FormatInt(playlist.ID, 10)
+		}
+		result = append(result, map[string]interface{}{
+			"id":       id,
+			"name":     playlist.Name,
+			"interval": playlist.Interval,
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "playlists:"+query))
+	return diag.FromErr(d.Set("playlists", result))
+}
@@ -0,0 +1,33 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourcePlaylists_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var playlist models.Playlist
+
+	// TODO: Make parallelizable
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      playlistCheckExists.destroyed(&playlist, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_playlists/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					playlistCheckExists.exists("grafana_playlist.test", &playlist),
+					resource.TestCheckResourceAttr("data.grafana_playlists.mine", "playlists.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_playlists.mine", "playlists.0.name", "My Playlist!"),
+					resource.TestCheckResourceAttr("data.grafana_playlists.mine", "playlists.0.interval", "5m"),
+					resource.TestCheckResourceAttrPtr("data.grafana_playlists.mine", "playlists.0.id", &playlist.UID),
+				),
+			},
+		},
+	})
+}
@@ -30,7 +30,7 @@ func DatasourceRole() *schema.Resource {
 }
 
 func dataSourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	resp, err := client.AccessControl.ListRoles(access_control.NewListRolesParams(), nil)
 	if err != nil {
 		return diag.FromErr(err)
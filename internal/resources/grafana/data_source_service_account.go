@@ -28,7 +28,7 @@ func DatasourceServiceAccount() *schema.Resource {
 }
 
 func DatasourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	name := d.Get("name").(string)
 	var page int64 = 0
 	for {
@@ -2,7 +2,9 @@ package grafana
 
 import (
 	"context"
+	"strconv"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/teams"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,12 +32,47 @@ func DatasourceTeam() *schema.Resource {
 				Description: "Whether to read the team sync settings. This is only available in Grafana Enterprise.",
 			},
 			"ignore_externally_synced_members": nil,
+			"member_details": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All the members of the team, including the ones synced from an external auth provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the user.",
+						},
+						"login": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login of the user.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email of the user.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the user.",
+						},
+						"auth_labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The auth providers through which the user was synced into the team. Empty if the user was added manually.",
+						},
+					},
+				},
+			},
 		}),
 	}
 }
 
 func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 	name := d.Get("name").(string)
 
 	params := teams.NewSearchTeamsParams().WithName(&name)
@@ -47,9 +84,33 @@ func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	for _, r := range searchTeam.Teams {
 		if r.Name == name {
-			return readTeamFromID(client, r.ID, d, d.Get("read_team_sync").(bool))
+			if diags := readTeamFromID(client, r.ID, d, d.Get("read_team_sync").(bool)); diags.HasError() {
+				return diags
+			}
+			return readTeamMemberDetails(client, r.ID, d)
 		}
 	}
 
 	return diag.Errorf("no team with name %q", name)
 }
+
+func readTeamMemberDetails(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.ResourceData) diag.Diagnostics {
+	resp, err := client.Teams.GetTeamMembers(strconv.FormatInt(teamID, 10))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := []interface{}{}
+	for _, member := range resp.GetPayload() {
+		members = append(members, map[string]interface{}{
+			"user_id":     member.UserID,
+			"login":       member.Login,
+			"email":       member.Email,
+			"name":        member.Name,
+			"auth_labels": member.Labels,
+		})
+	}
+	d.Set("member_details", members)
+
+	return nil
+}
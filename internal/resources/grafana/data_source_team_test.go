@@ -1,10 +1,12 @@
 package grafana_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -35,6 +37,33 @@ func TestAccDatasourceTeam_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceTeam_memberDetails(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var team models.TeamDTO
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      teamCheckExists.destroyed(&team, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceTeamMemberDetails(name),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.test", &team),
+					resource.TestCheckResourceAttr("data.grafana_team.from_name", "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_team.from_name", "member_details.*", map[string]string{
+						"login": name,
+						"email": name + "@example.com",
+						"name":  "Team Member",
+					}),
+					resource.TestCheckResourceAttrPair("data.grafana_team.from_name", "team_uid", "grafana_team.test", "team_uid"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDatasourceTeam_teamSync(t *testing.T) {
 	testutils.CheckEnterpriseTestsEnabled(t)
 
@@ -64,3 +93,23 @@ func TestAccDatasourceTeam_teamSync(t *testing.T) {
 		},
 	})
 }
+
+func testAccDatasourceTeamMemberDetails(name string) string {
+	return fmt.Sprintf(`
+resource "grafana_user" "test" {
+	login    = "%[1]s"
+	email    = "%[1]s@example.com"
+	name     = "Team Member"
+	password = "my-password"
+}
+
+resource "grafana_team" "test" {
+	name    = "%[1]s"
+	members = [grafana_user.test.email]
+}
+
+data "grafana_team" "from_name" {
+	name = grafana_team.test.name
+}
+`, name)
+}
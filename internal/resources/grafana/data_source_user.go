@@ -3,8 +3,11 @@ package grafana
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,6 +20,8 @@ func DatasourceUser() *schema.Resource {
 
 This data source uses Grafana's admin APIs for reading users which
 does not currently work with API Tokens. You must use basic auth.
+
+The user can be looked up by ID, email or login. The organizations the user is a member of are returned with the user's role in each of them.
 `,
 		ReadContext: dataSourceUserRead,
 		Schema: map[string]*schema.Schema{
@@ -30,7 +35,7 @@ does not currently work with API Tokens. You must use basic auth.
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "",
-				Description: "The email address of the Grafana user.",
+				Description: "The email address of the Grafana user. If `login` is also set, the user must match both.",
 			},
 			"login": {
 				Type:        schema.TypeString,
@@ -48,40 +53,115 @@ does not currently work with API Tokens. You must use basic auth.
 				Computed:    true,
 				Description: "Whether the user is an admin.",
 			},
+			"orgs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The organizations the user is a member of.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"org_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the organization.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the organization.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the user in the organization. One of `Viewer`, `Editor`, `Admin` or `None`.",
+						},
+					},
+				},
+			},
+			"org_roles": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the IDs of the organizations the user is a member of to the user's role in them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
 func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta) // Users are global/org-agnostic
+	client := OAPIGlobalClient(ctx, meta) // Users are global/org-agnostic
 
 	var resp interface{ GetPayload() *models.UserProfileDTO }
 	var err error
 
-	emailOrLogin := d.Get("email").(string)
-	if emailOrLogin == "" {
-		emailOrLogin = d.Get("login").(string)
+	id := d.Get("user_id").(int)
+	email := d.Get("email").(string)
+	login := d.Get("login").(string)
+
+	var lookup []string
+	if email != "" {
+		lookup = append(lookup, fmt.Sprintf("email %q", email))
+	}
+	if login != "" {
+		lookup = append(lookup, fmt.Sprintf("login %q", login))
 	}
 
-	if id := d.Get("user_id").(int); id >= 0 {
+	if id >= 0 {
+		lookup = []string{fmt.Sprintf("ID %d", id)}
 		resp, err = client.Users.GetUserByID(int64(id))
-	} else if emailOrLogin != "" {
-		resp, err = client.Users.GetUserByLoginOrEmail(emailOrLogin)
+	} else if email != "" {
+		resp, err = client.Users.GetUserByLoginOrEmail(email)
+	} else if login != "" {
+		resp, err = client.Users.GetUserByLoginOrEmail(login)
 	} else {
-		err = fmt.Errorf("must specify one of user_id, email, or login")
+		return diag.Errorf("must specify one of user_id, email, or login")
 	}
 
 	if err != nil {
+		if common.IsNotFoundError(err) {
+			return userNotFoundDiag(lookup)
+		}
 		return diag.FromErr(err)
 	}
 
+	// The lookup endpoint matches both the email and the login of the users, make sure the right attributes matched
 	user := resp.GetPayload()
+	if id < 0 && ((email != "" && !strings.EqualFold(user.Email, email)) || (login != "" && user.Login != login)) {
+		return userNotFoundDiag(lookup)
+	}
+
+	orgsResp, err := client.Users.GetUserOrgList(user.ID)
+	if err != nil {
+		return diag.Errorf("failed to get the organizations of user %d: %s", user.ID, err)
+	}
+	orgs := make([]interface{}, 0, len(orgsResp.Payload))
+	orgRoles := map[string]interface{}{}
+	for _, org := range orgsResp.Payload {
+		orgs = append(orgs, map[string]interface{}{
+			"org_id": org.OrgID,
+			"name":   org.Name,
+			"role":   org.Role,
+		})
+		orgRoles[strconv.FormatInt(org.OrgID, 10)] = org.Role
+	}
+
 	d.SetId(fmt.Sprintf("%d", user.ID))
 	d.Set("user_id", user.ID)
 	d.Set("email", user.Email)
 	d.Set("name", user.Name)
 	d.Set("login", user.Login)
 	d.Set("is_admin", user.IsGrafanaAdmin)
+	d.Set("orgs", orgs)
+	d.Set("org_roles", orgRoles)
 
 	return nil
 }
+
+func userNotFoundDiag(lookup []string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("user with %s not found", strings.Join(lookup, " and ")),
+		Detail:   "No Grafana user matches the given attributes. Users are searched across all organizations, which requires basic auth with a server admin.",
+	}}
+}
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -33,6 +34,21 @@ func TestAccDatasourceUser_basic(t *testing.T) {
 			resource.TestCheckResourceAttr(
 				"data.grafana_user."+rName, "is_admin", "true",
 			),
+			resource.TestCheckResourceAttr(
+				"data.grafana_user."+rName, "orgs.#", "1",
+			),
+			resource.TestCheckResourceAttr(
+				"data.grafana_user."+rName, "orgs.0.org_id", "1",
+			),
+			resource.TestCheckResourceAttr(
+				"data.grafana_user."+rName, "orgs.0.name", "Main Org.",
+			),
+			resource.TestCheckResourceAttrSet(
+				"data.grafana_user."+rName, "orgs.0.role",
+			),
+			resource.TestCheckResourceAttrPair(
+				"data.grafana_user."+rName, "org_roles.1", "data.grafana_user."+rName, "orgs.0.role",
+			),
 		)
 	}
 
@@ -44,6 +60,20 @@ func TestAccDatasourceUser_basic(t *testing.T) {
 				Config: testutils.TestAccExample(t, "data-sources/grafana_user/data-source.tf"),
 				Check:  resource.ComposeTestCheckFunc(checks...),
 			},
+			{
+				Config: `data "grafana_user" "test" {
+  login = "test-datasource-does-not-exist"
+}`,
+				ExpectError: regexp.MustCompile(`user with login "test-datasource-does-not-exist" not found`),
+			},
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_user/data-source.tf") + `
+data "grafana_user" "mismatch" {
+  email = grafana_user.test.email
+  login = "another-login"
+}`,
+				ExpectError: regexp.MustCompile(`user with email "test.datasource@example.com" and login "another-login" not found`),
+			},
 		},
 	})
 }
@@ -2,14 +2,24 @@ package grafana
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/users"
 	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const usersSearchPageSize = 1000
+
 func DatasourceUsers() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: readUsers,
@@ -23,9 +33,30 @@ func DatasourceUsers() *schema.Resource {
 		
 This data source uses Grafana's admin APIs for reading users which
 does not currently work with API Tokens. You must use basic auth.
+
+The users can be filtered, e.g. to select the users who log in through an SSO provider, and their emails can be used as the members of a team.
+Each apply then adds the new matching users to the team and removes the ones that don't match anymore. The plan lists these changes beforehand.
 		`,
 
 		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the users whose login, email or name contains this string (case insensitive). The users are searched by Grafana.",
+			},
+			"auth_label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the users who log in with this authentication method, as labelled in the Grafana UI (e.g. `OAuth`, `Okta`, `SAML`, `LDAP`). Unlike `query`, this filter is applied by the provider, since Grafana can't search users by authentication method.",
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The emails of the users. They can be used as the `members` of a `grafana_team` resource.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"users": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -57,6 +88,14 @@ does not currently work with API Tokens. You must use basic auth.
 							Computed:    true,
 							Description: "Whether the user is admin or not.",
 						},
+						"auth_labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The authentication methods the user logs in with.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -65,24 +104,66 @@ does not currently work with API Tokens. You must use basic auth.
 }
 
 func readUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta) // Users are global/org-agnostic
-	allUsers, err := getAllUsers(client)
+	client := meta.(*common.Client)
+	matching, err := searchUsers(ctx, client, d.Get("query").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	// The search API can't filter the users by authentication method
+	if authLabel := d.Get("auth_label").(string); authLabel != "" {
+		matching = slices.DeleteFunc(matching, func(user *models.UserSearchHitDTO) bool {
+			return !slices.ContainsFunc(user.AuthLabels, func(l string) bool { return strings.EqualFold(l, authLabel) })
+		})
+	}
+	emails := []string{}
+	for _, user := range matching {
+		emails = append(emails, user.Email)
+	}
+
 	d.SetId("grafana_users")
-	return diag.FromErr(d.Set("users", flattenUsers(allUsers)))
+	d.Set("emails", emails)
+	return diag.FromErr(d.Set("users", flattenUsers(matching)))
+}
+
+// searchUsers lists the users whose login, email or name contains the query, all the users if it's empty.
+// Users are global/org-agnostic, so the request isn't scoped to an org (no org ID header is sent).
+func searchUsers(ctx context.Context, client *common.Client, query string) ([]*models.UserSearchHitDTO, error) {
+	var users []*models.UserSearchHitDTO
+	params := url.Values{"perpage": {strconv.Itoa(usersSearchPageSize)}}
+	if query != "" {
+		params.Set("query", query)
+	}
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+		status, body, err := grafanaAPIRequest(ctx, client, -1, http.MethodGet, "/api/users/search", params, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		if status >= 400 {
+			return nil, fmt.Errorf("failed to search users: status: %d, body: %s", status, body)
+		}
+		var result models.SearchUserQueryResult
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode the users: %w", err)
+		}
+		users = append(users, result.Users...)
+		if len(result.Users) < usersSearchPageSize || int64(len(users)) >= result.TotalCount {
+			return users, nil
+		}
+	}
 }
 
 func flattenUsers(items []*models.UserSearchHitDTO) []interface{} {
 	userItems := make([]interface{}, 0)
 	for _, user := range items {
 		f := map[string]interface{}{
-			"id":       user.ID,
-			"login":    user.Login,
-			"name":     user.Name,
-			"email":    user.Email,
-			"is_admin": user.IsAdmin,
+			"id":          user.ID,
+			"login":       user.Login,
+			"name":        user.Name,
+			"email":       user.Email,
+			"is_admin":    user.IsAdmin,
+			"auth_labels": user.AuthLabels,
 		}
 		userItems = append(userItems, f)
 	}
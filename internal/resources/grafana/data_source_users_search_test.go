@@ -0,0 +1,64 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+func TestSearchUsers(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/users/search" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if orgID := r.Header.Get("X-Grafana-Org-Id"); orgID != "" {
+			t.Errorf("unexpected org ID %s", orgID)
+		}
+		queries = append(queries, r.URL.Query())
+		// The first page is full, the second one has the last user
+		page := r.URL.Query().Get("page")
+		count := usersSearchPageSize
+		if page == "2" {
+			count = 1
+		}
+		users := ""
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				users += ","
+			}
+			users += fmt.Sprintf(`{"login":"user-%s-%d"}`, page, i)
+		}
+		fmt.Fprintf(w, `{"totalCount":%d,"users":[%s]}`, usersSearchPageSize+1, users)
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	client := &common.Client{
+		GrafanaAPIURLParsed:  serverURL,
+		GrafanaAPIConfig:     &goapi.TransportConfig{OrgID: 1},
+		GrafanaHTTPTransport: http.DefaultTransport,
+	}
+
+	users, err := searchUsers(context.Background(), client, "team-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != usersSearchPageSize+1 {
+		t.Errorf("expected %d users, got %d", usersSearchPageSize+1, len(users))
+	}
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(queries))
+	}
+	for i, query := range queries {
+		if query.Get("query") != "team-a" || query.Get("page") != fmt.Sprint(i+1) || query.Get("perpage") != fmt.Sprint(usersSearchPageSize) {
+			t.Errorf("unexpected query %v", query)
+		}
+	}
+}
@@ -22,6 +22,15 @@ func TestAccDatasourceUsers_basic(t *testing.T) {
 				"login": "test-grafana-users",
 				"email": "all_users@example.com",
 			}),
+		resource.TestCheckResourceAttr("data.grafana_users.filtered", "users.#", "1"),
+		resource.TestCheckTypeSetElemNestedAttrs(
+			"data.grafana_users.filtered", "users.*", map[string]string{
+				"login": "test-grafana-users",
+			}),
+		resource.TestCheckResourceAttr("data.grafana_users.filtered", "emails.#", "1"),
+		resource.TestCheckTypeSetElemAttr("data.grafana_users.filtered", "emails.*", "all_users@example.com"),
+		resource.TestCheckResourceAttr("grafana_team.filtered_users", "members.#", "1"),
+		resource.TestCheckTypeSetElemAttr("grafana_team.filtered_users", "members.*", "all_users@example.com"),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
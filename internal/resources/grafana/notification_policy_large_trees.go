@@ -0,0 +1,138 @@
+package grafana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// notificationPolicyLargeTreeSize is the number of policies above which a tree is reported as large.
+	// Grafana validates and persists the whole tree on each update, which may take longer than the timeouts of the proxies in front of it.
+	notificationPolicyLargeTreeSize = 1000
+
+	notificationPolicyPutTimeout        = 10 * time.Minute
+	notificationPolicyPutInitialBackoff = 5 * time.Second
+	notificationPolicyPutMaxBackoff     = time.Minute
+)
+
+// putPolicyTree replaces the policy tree. Large trees may time out while Grafana keeps applying them,
+// so transient failures are retried with an exponential backoff, and the tree is read before each retry:
+// if it already matches the desired tree, the update is considered successful.
+func putPolicyTree(ctx context.Context, client *goapi.GrafanaHTTPAPI, params *provisioning.PutPolicyTreeParams) error {
+	deadline := time.Now().Add(notificationPolicyPutTimeout)
+	backoff := notificationPolicyPutInitialBackoff
+	for {
+		_, err := client.Provisioning.PutPolicyTree(params)
+		if err == nil || !isTransientPolicyTreeError(err) {
+			return err
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return fmt.Errorf("failed to update the notification policy tree after %s: %w", notificationPolicyPutTimeout, err)
+		}
+		log.Printf("[WARN] failed to update the notification policy tree, checking whether it was applied in %s: %v", backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		if resp, getErr := client.Provisioning.GetPolicyTree(); getErr == nil && policyTreesEqual(resp.Payload, params.Body) {
+			log.Printf("[INFO] the notification policy tree was applied despite the failed request")
+			return nil
+		}
+
+		backoff *= 2
+		if backoff > notificationPolicyPutMaxBackoff {
+			backoff = notificationPolicyPutMaxBackoff
+		}
+	}
+}
+
+// isTransientPolicyTreeError returns whether an error of a policy tree update may be caused by the time taken to apply the tree,
+// i.e. a timeout or a 502, 503 or 504 status returned by a proxy. Other errors, e.g. an invalid tree, aren't retried.
+func isTransientPolicyTreeError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var codeErr interface{ IsCode(int) bool }
+	if !errors.As(err, &codeErr) {
+		return false
+	}
+	return codeErr.IsCode(http.StatusBadGateway) || codeErr.IsCode(http.StatusServiceUnavailable) || codeErr.IsCode(http.StatusGatewayTimeout)
+}
+
+// policyTreesEqual compares the fields of two policy trees that are managed by the provider.
+func policyTreesEqual(a, b *models.Route) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return policyTreeFingerprint(a) == policyTreeFingerprint(b)
+}
+
+// policyTreeFingerprint returns a string that identifies the managed fields of a policy and its nested policies.
+// The matchers are sorted, as their order doesn't matter.
+func policyTreeFingerprint(r *models.Route) string {
+	matchers := make([]string, 0, len(r.ObjectMatchers))
+	for _, m := range r.ObjectMatchers {
+		matchers = append(matchers, fmt.Sprintf("%q", []string(m)))
+	}
+	sort.Strings(matchers)
+
+	children := make([]string, 0, len(r.Routes))
+	for _, child := range r.Routes {
+		children = append(children, policyTreeFingerprint(child))
+	}
+
+	return fmt.Sprintf("{%q %q %q %q %q %t [%s] %q [%s]}",
+		r.Receiver, r.GroupBy, r.GroupWait, r.GroupInterval, r.RepeatInterval, r.Continue,
+		strings.Join(matchers, " "), r.MuteTimeIntervals, strings.Join(children, " "))
+}
+
+// countPolicies returns the number of policies of the given `policy` blocks, including the nested ones.
+func countPolicies(policies []interface{}) int {
+	count := len(policies)
+	for _, p := range policies {
+		if policy, ok := p.(map[string]interface{}); ok {
+			if nested, ok := policy["policy"].([]interface{}); ok {
+				count += countPolicies(nested)
+			}
+		}
+	}
+	return count
+}
+
+// warnLargeNotificationPolicy logs a warning at plan time when the policy tree is large.
+func warnLargeNotificationPolicy(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if count := countPolicies(d.Get("policy").([]interface{})); count > notificationPolicyLargeTreeSize {
+		logPlanWarning("the notification policy tree has %d policies, updating it may take several minutes", count)
+	}
+	return nil
+}
+
+// largeNotificationPolicyWarnings returns a warning when the policy tree is large.
+func largeNotificationPolicyWarnings(data *schema.ResourceData) diag.Diagnostics {
+	count := countPolicies(data.Get("policy").([]interface{}))
+	if count <= notificationPolicyLargeTreeSize {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "The notification policy tree is large",
+		Detail: fmt.Sprintf("The notification policy tree has %d policies, more than %d. Grafana validates and stores the whole tree on each update, which may take several minutes. "+
+			"Failed updates are retried until the tree is applied. Consider grouping the policies with regular expression matchers.", count, notificationPolicyLargeTreeSize),
+	}}
+}
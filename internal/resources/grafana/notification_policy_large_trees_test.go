@@ -0,0 +1,30 @@
+package grafana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+)
+
+func TestIsTransientPolicyTreeError(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		expected bool
+	}{
+		{err: context.DeadlineExceeded, expected: true},
+		{err: runtime.NewAPIError("PutPolicyTree", nil, 502), expected: true},
+		{err: runtime.NewAPIError("PutPolicyTree", nil, 503), expected: true},
+		{err: fmt.Errorf("wrapped: %w", runtime.NewAPIError("PutPolicyTree", nil, 504)), expected: true},
+		{err: runtime.NewAPIError("PutPolicyTree", nil, 500), expected: false},
+		{err: provisioning.NewPutPolicyTreeBadRequest(), expected: false},
+		{err: errors.New("invalid tree"), expected: false},
+	} {
+		if actual := isTransientPolicyTreeError(tc.err); actual != tc.expected {
+			t.Errorf("expected %t for %v, got %t", tc.expected, tc.err, actual)
+		}
+	}
+}
@@ -1,9 +1,9 @@
 package grafana
 
 import (
+	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 
@@ -30,43 +30,33 @@ func MakeOrgResourceID(orgID int64, resourceID interface{}) string {
 
 // SplitOrgResourceID splits into two parts (org ID and resource ID) the ID of an org-scoped resource
 func SplitOrgResourceID(id string) (int64, string) {
-	if strings.ContainsRune(id, ':') {
-		parts := strings.SplitN(id, ":", 2)
-		orgID, _ := strconv.ParseInt(parts[0], 10, 64)
-		return orgID, parts[1]
-	}
-
-	return 0, id
+	return common.SplitOrgResourceID(id)
 }
 
 // OAPIClientFromExistingOrgResource creates a client from the ID of an org-scoped resource
 // Those IDs are in the <orgID>:<resourceID> format
-func OAPIClientFromExistingOrgResource(meta interface{}, id string) (*goapi.GrafanaHTTPAPI, int64, string) {
-	orgID, restOfID := SplitOrgResourceID(id)
-	client := meta.(*common.Client).GrafanaOAPI.Clone()
-	if orgID == 0 {
-		orgID = client.OrgID()
-	} else if orgID > 0 {
-		client = client.WithOrgID(orgID)
-	}
-	return client, orgID, restOfID
+// The client's calls are bound to the given context.
+func OAPIClientFromExistingOrgResource(ctx context.Context, meta interface{}, id string) (*goapi.GrafanaHTTPAPI, int64, string) {
+	return common.OAPIClientFromExistingOrgResource(ctx, meta, id)
 }
 
 // OAPIClientFromNewOrgResource creates an OpenAPI client from the `org_id` attribute of a resource
 // This client is meant to be used in `Create` functions when the ID hasn't already been baked into the resource ID
-func OAPIClientFromNewOrgResource(meta interface{}, d *schema.ResourceData) (*goapi.GrafanaHTTPAPI, int64) {
+// The client's calls are bound to the given context.
+func OAPIClientFromNewOrgResource(ctx context.Context, meta interface{}, d *schema.ResourceData) (*goapi.GrafanaHTTPAPI, int64) {
 	orgID := parseOrgID(d)
 	client := meta.(*common.Client).GrafanaOAPI.Clone()
 	if orgID == 0 {
 		orgID = client.OrgID()
 	} else if orgID > 0 {
-		client = client.WithOrgID(orgID)
+		client = meta.(*common.Client).GrafanaOAPIWithOrgID(orgID)
 	}
-	return client, orgID
+	return common.OAPIWithContext(ctx, client), orgID
 }
 
-func OAPIGlobalClient(meta interface{}) *goapi.GrafanaHTTPAPI {
-	return meta.(*common.Client).GrafanaOAPI.Clone().WithOrgID(0)
+// OAPIGlobalClient creates a client that isn't scoped to an organization. Its calls are bound to the given context.
+func OAPIGlobalClient(ctx context.Context, meta interface{}) *goapi.GrafanaHTTPAPI {
+	return common.OAPIWithContext(ctx, meta.(*common.Client).GrafanaOAPIWithOrgID(0))
 }
 
 func parseOrgID(d *schema.ResourceData) int64 {
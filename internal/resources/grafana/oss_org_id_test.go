@@ -1,10 +1,21 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"regexp"
 	"strconv"
+	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -38,3 +49,73 @@ func checkResourceIsInOrg(resourceName, orgResourceName string) resource.TestChe
 		return nil
 	}
 }
+
+// The clients scoped to an organization, or to none, are created with a new transport. Their requests must still be signed.
+func TestOAPIClientsAreSigned(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	cfg := goapi.TransportConfig{
+		Host:      serverURL.Host,
+		BasePath:  "/api",
+		Schemes:   []string{serverURL.Scheme},
+		BasicAuth: url.UserPassword("admin", "admin"),
+		OrgID:     1,
+	}
+	client := &common.Client{
+		GrafanaOAPI:      goapi.NewHTTPClientWithConfig(strfmt.Default, &cfg),
+		GrafanaAPIConfig: &cfg,
+		GrafanaRequestSigner: &common.RequestSigner{
+			AWSRegion:  "eu-west-1",
+			AWSService: "execute-api",
+			AWSCredentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+				return aws.Credentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}, nil
+			}),
+			OIDCToken:  "oidc-token",
+			OIDCHeader: "Proxy-Authorization",
+		},
+	}
+	client.GrafanaRequestSigner.WrapOAPI(client.GrafanaOAPI)
+
+	checkSigned := func(desc, orgID string) {
+		t.Helper()
+		if received == nil {
+			t.Fatalf("%s: no request received", desc)
+		}
+		if got := received.Header.Get("Proxy-Authorization"); got != "Bearer oidc-token" {
+			t.Errorf("%s: expected the OIDC token in the Proxy-Authorization header, got %q", desc, got)
+		}
+		if got := received.URL.Query().Get("X-Amz-Signature"); got == "" {
+			t.Errorf("%s: expected the request to be signed with SigV4, got query %q", desc, received.URL.RawQuery)
+		}
+		if got := received.Header.Get("X-Grafana-Org-Id"); got != orgID {
+			t.Errorf("%s: expected org ID %q, got %q", desc, orgID, got)
+		}
+		received = nil
+	}
+
+	if _, err := grafana.OAPIGlobalClient(context.Background(), client).Admin.AdminGetSettings(); err != nil {
+		t.Fatal(err)
+	}
+	checkSigned("global client", "")
+
+	orgClient, _, uid := grafana.OAPIClientFromExistingOrgResource(context.Background(), client, "2:folder-uid")
+	if _, err := orgClient.Folders.GetFolderByUID(uid); err != nil {
+		t.Fatal(err)
+	}
+	checkSigned("org client", "2")
+
+	// The original client is still signed and scoped to its organization
+	if _, err := client.GrafanaOAPI.Folders.GetFolderByUID("folder-uid"); err != nil {
+		t.Fatal(err)
+	}
+	checkSigned("provider client", "1")
+}
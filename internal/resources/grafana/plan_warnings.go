@@ -0,0 +1,9 @@
+package grafana
+
+import "log"
+
+// logPlanWarning logs a warning found by a CustomizeDiff function.
+// Plan time warnings aren't supported by CustomizeDiff, so the resources also return them as diagnostics from their create and update functions.
+func logPlanWarning(format string, args ...interface{}) {
+	log.Printf("[WARN] "+format, args...)
+}
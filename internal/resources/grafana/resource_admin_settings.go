@@ -0,0 +1,220 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// adminSettingsSAMLSection is the only section of the Grafana configuration which can be updated at runtime.
+const adminSettingsSAMLSection = "auth.saml"
+
+// adminSettingsSAMLKeys are the keys of the SAML section which can be set in the `saml` attribute.
+// Grafana ignores the other keys, which would then show up as drift forever, so they are rejected by the plan.
+var adminSettingsSAMLKeys = []string{
+	"allow_idp_initiated",
+	"allow_sign_up",
+	"allowed_organizations",
+	"assertion_attribute_email",
+	"assertion_attribute_groups",
+	"assertion_attribute_login",
+	"assertion_attribute_name",
+	"assertion_attribute_org",
+	"assertion_attribute_role",
+	"auto_login",
+	"enabled",
+	"idp_metadata",
+	"idp_metadata_url",
+	"max_issue_delay",
+	"metadata_valid_duration",
+	"name_id_format",
+	"org_mapping",
+	"relay_state",
+	"role_values_admin",
+	"role_values_editor",
+	"role_values_grafana_admin",
+	"signature_algorithm",
+	"single_logout",
+	"skip_org_role_sync",
+}
+
+// adminSettingsSAMLSecureKeys are the keys of the SAML section which Grafana redacts when they are read.
+// They are set in the sensitive `secure_saml` attribute.
+var adminSettingsSAMLSecureKeys = []string{"certificate", "private_key"}
+
+// adminSettingsUpdate is the body of the update of the settings of Grafana. The Grafana OpenAPI client doesn't implement it.
+type adminSettingsUpdate struct {
+	Updates  map[string]map[string]string `json:"updates"`
+	Removals map[string][]string          `json:"removals"`
+}
+
+func ResourceAdminSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Updates the SAML settings of the Grafana instance at runtime, overriding the values of the ` + "`[auth.saml]`" + ` section of its configuration file.
+Grafana only supports updating the SAML settings at runtime.
+Only the keys set in this resource are managed: the other SAML settings are left as they are, and don't show up as drift.
+
+Removing a key, or destroying this resource, removes the runtime value of the setting, so that Grafana uses the value of its configuration file again.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-security/configure-authentication/saml/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/admin/#update-settings)
+
+This resource represents an instance-scoped resource and uses Grafana's admin APIs.
+It does not work with API tokens or service accounts which are org-scoped.
+You must use basic auth.
+
+**Note:** This resource is available only with Grafana Enterprise 11.+.
+`,
+
+		CreateContext: updateAdminSettings,
+		ReadContext:   readAdminSettings,
+		UpdateContext: updateAdminSettings,
+		DeleteContext: deleteAdminSettings,
+
+		Schema: map[string]*schema.Schema{
+			"saml": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: "The settings of the `[auth.saml]` section to update, by key, e.g. `enabled`. " +
+					"Only these keys are read back from Grafana. The valid keys are: `" + strings.Join(adminSettingsSAMLKeys, "`, `") + "`.",
+				ValidateDiagFunc: validateAdminSettingsKeys(adminSettingsSAMLKeys),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"secure_saml": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Description: "The secret settings of the `[auth.saml]` section to update, by key. " +
+					"Grafana redacts them, so they aren't read back. The valid keys are: `" + strings.Join(adminSettingsSAMLSecureKeys, "`, `") + "`.",
+				ValidateDiagFunc: validateAdminSettingsKeys(adminSettingsSAMLSecureKeys),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func readAdminSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resp, err := OAPIGlobalClient(ctx, meta).Admin.AdminGetSettings()
+	if err != nil {
+		return diag.Errorf("failed to read the settings of Grafana: %v", err)
+	}
+
+	managed := d.Get("saml").(map[string]interface{})
+	values := map[string]interface{}{}
+	for key, stateValue := range managed {
+		value, ok := resp.Payload[adminSettingsSAMLSection][key]
+		switch {
+		case !ok:
+			// The setting is reported as drift
+		case isRedactedAdminSetting(value):
+			values[key] = stateValue
+		default:
+			values[key] = value
+		}
+	}
+	d.Set("saml", values)
+	return nil
+}
+
+func updateAdminSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	update := adminSettingsUpdate{Updates: map[string]map[string]string{}, Removals: map[string][]string{}}
+	for _, attr := range []string{"saml", "secure_saml"} {
+		old, new := d.GetChange(attr)
+		update.add(adminSettingsSAMLSection, old.(map[string]interface{}), new.(map[string]interface{}))
+	}
+	if diags := putAdminSettings(ctx, meta.(*common.Client), update); diags != nil {
+		return diags
+	}
+
+	d.SetId("admin_settings")
+	return readAdminSettings(ctx, d, meta)
+}
+
+func deleteAdminSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	update := adminSettingsUpdate{Updates: map[string]map[string]string{}, Removals: map[string][]string{}}
+	for _, attr := range []string{"saml", "secure_saml"} {
+		update.add(adminSettingsSAMLSection, d.Get(attr).(map[string]interface{}), nil)
+	}
+	if len(update.Removals) == 0 {
+		return nil
+	}
+	return putAdminSettings(ctx, meta.(*common.Client), update)
+}
+
+func putAdminSettings(ctx context.Context, client *common.Client, update adminSettingsUpdate) diag.Diagnostics {
+	body, err := json.Marshal(update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	status, respBody, err := grafanaAPIRequest(ctx, client, 0, http.MethodPut, "/api/admin/settings", nil, "application/json", body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 {
+		return diag.Errorf("failed to update the settings of Grafana: status: %d, body: %s", status, respBody)
+	}
+	return nil
+}
+
+// add adds the new settings of a section to the update, and removes the old ones which aren't set anymore.
+func (u *adminSettingsUpdate) add(section string, old, new map[string]interface{}) {
+	for key, value := range new {
+		if u.Updates[section] == nil {
+			u.Updates[section] = map[string]string{}
+		}
+		u.Updates[section][key] = value.(string)
+	}
+	if removals := removedAdminSettings(old, new); len(removals) > 0 {
+		u.Removals[section] = append(u.Removals[section], removals...)
+	}
+}
+
+// validateAdminSettingsKeys checks that the keys of a map of settings can be updated at runtime.
+func validateAdminSettingsKeys(allowed []string) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+		for key := range i.(map[string]interface{}) {
+			if !slices.Contains(allowed, key) {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       fmt.Sprintf("invalid key %q", key),
+					Detail:        "Grafana can't update this setting at runtime. Valid keys are: " + strings.Join(allowed, ", "),
+					AttributePath: path,
+				})
+			}
+		}
+		return diags
+	}
+}
+
+// removedAdminSettings returns the keys of the old settings which aren't in the new ones, sorted alphabetically.
+func removedAdminSettings(old, new map[string]interface{}) []string {
+	var removed []string
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// isRedactedAdminSetting returns whether the value of a setting is redacted by Grafana, i.e. replaced by asterisks.
+func isRedactedAdminSetting(value string) bool {
+	return value != "" && strings.Trim(value, "*") == ""
+}
@@ -0,0 +1,92 @@
+package grafana_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAdminSettings_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=11.0.0")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      checkAdminSetting("auth.saml", "single_logout", "false"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminSettingsConfig(`single_logout = "true"`),
+				Check: resource.ComposeTestCheckFunc(
+					checkAdminSetting("auth.saml", "single_logout", "true"),
+					resource.TestCheckResourceAttr("grafana_admin_settings.test", "saml.%", "1"),
+					resource.TestCheckResourceAttr("grafana_admin_settings.test", "saml.single_logout", "true"),
+				),
+			},
+			{
+				Config: testAccAdminSettingsConfig(`single_logout = "true"
+    name_id_format = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"`),
+				Check: resource.ComposeTestCheckFunc(
+					checkAdminSetting("auth.saml", "single_logout", "true"),
+					checkAdminSetting("auth.saml", "name_id_format", "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"),
+					resource.TestCheckResourceAttr("grafana_admin_settings.test", "saml.%", "2"),
+				),
+			},
+			// Removing a key resets the setting to the value of the configuration file
+			{
+				Config: testAccAdminSettingsConfig(`name_id_format = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"`),
+				Check: resource.ComposeTestCheckFunc(
+					checkAdminSetting("auth.saml", "single_logout", "false"),
+					resource.TestCheckResourceAttr("grafana_admin_settings.test", "saml.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdminSettings_invalidKeys(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=11.0.0")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAdminSettingsConfig(`not_a_setting = "true"`),
+				ExpectError: regexp.MustCompile(`invalid key "not_a_setting"`),
+			},
+			// Secrets are set in secure_saml
+			{
+				Config:      testAccAdminSettingsConfig(`private_key = "secret"`),
+				ExpectError: regexp.MustCompile(`invalid key "private_key"`),
+			},
+		},
+	})
+}
+
+// checkAdminSetting checks the value of a setting of the Grafana instance.
+func checkAdminSetting(section, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutils.Provider.Meta().(*common.Client).GrafanaOAPI.WithOrgID(0)
+		resp, err := client.Admin.AdminGetSettings()
+		if err != nil {
+			return err
+		}
+		if actual := resp.Payload[section][key]; actual != expected {
+			return fmt.Errorf("expected setting %s.%s to be %q, got %q", section, key, expected, actual)
+		}
+		return nil
+	}
+}
+
+func testAccAdminSettingsConfig(saml string) string {
+	return fmt.Sprintf(`
+resource "grafana_admin_settings" "test" {
+  saml = {
+    %s
+  }
+}
+`, saml)
+}
@@ -0,0 +1,156 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// The Alertmanagers Grafana sends alerts to, by default.
+const defaultAlertmanagersChoice = "all"
+
+// alertingAdminConfig is the alerting admin configuration of an organization. The Grafana OpenAPI client doesn't implement the admin configuration endpoints.
+type alertingAdminConfig struct {
+	AlertmanagersChoice string `json:"alertmanagersChoice"`
+}
+
+func ResourceAlertingAdminConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Sets which Alertmanagers the alerts of Grafana-managed alert rules are sent to: the internal Alertmanager of Grafana, the external Alertmanagers, or both.
+
+External Alertmanagers are Alertmanager data sources with the ` + "`handleGrafanaManagedAlerts`" + ` option (` + "`Receive Grafana Alerts`" + ` in the UI), which can be managed with the ` + "`grafana_data_source`" + ` resource.
+This allows hybrid setups, where Grafana alerts are forwarded to an existing Alertmanager.
+
+On deletion, the configuration is reset to Grafana's default, which sends alerts to all Alertmanagers.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/configure-alertmanager/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/)
+
+This resource requires Grafana 9.2.0 or later.
+`,
+
+		CreateContext: common.WithAlertingMutex[schema.CreateContextFunc](putAlertingAdminConfig),
+		ReadContext:   readAlertingAdminConfig,
+		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](putAlertingAdminConfig),
+		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteAlertingAdminConfig),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"send_alerts_to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The Alertmanagers to send alerts to. Available values are `all` (the internal and external Alertmanagers), `internal` and `external`.",
+				ValidateFunc: validation.StringInSlice([]string{"all", "internal", "external"}, false),
+			},
+			"active_alertmanagers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The URLs of the external Alertmanagers discovered by Grafana. Grafana discovers the Alertmanager data sources periodically, so new data sources may not be listed yet.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func readAlertingAdminConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.Client)
+	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	status, body, err := grafanaAPIRequest(ctx, client, orgID, http.MethodGet, "/api/v1/ngalert/admin_config", nil, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	config := alertingAdminConfig{AlertmanagersChoice: defaultAlertmanagersChoice}
+	switch {
+	case status == http.StatusNotFound:
+		// The organization doesn't have an admin configuration yet, Grafana uses its default
+	case status >= 400:
+		return diag.Errorf("failed to read the alerting admin configuration: status: %d, body: %s", status, body)
+	default:
+		if err := json.Unmarshal(body, &config); err != nil {
+			return diag.Errorf("failed to decode the alerting admin configuration: %s", err)
+		}
+	}
+
+	alertmanagers, err := activeAlertmanagers(ctx, client, orgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("org_id", d.Id())
+	d.Set("send_alerts_to", config.AlertmanagersChoice)
+	d.Set("active_alertmanagers", alertmanagers)
+	return nil
+}
+
+func putAlertingAdminConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+
+	body, err := json.Marshal(alertingAdminConfig{AlertmanagersChoice: d.Get("send_alerts_to").(string)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	status, respBody, err := grafanaAPIRequest(ctx, meta.(*common.Client), orgID, http.MethodPost, "/api/v1/ngalert/admin_config", nil, "application/json", body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 {
+		return diag.Errorf("failed to update the alerting admin configuration: status: %d, body: %s", status, respBody)
+	}
+
+	d.SetId(strconv.FormatInt(orgID, 10))
+	return readAlertingAdminConfig(ctx, d, meta)
+}
+
+func deleteAlertingAdminConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	status, body, err := grafanaAPIRequest(ctx, meta.(*common.Client), orgID, http.MethodDelete, "/api/v1/ngalert/admin_config", nil, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 && status != http.StatusNotFound {
+		return diag.Errorf("failed to delete the alerting admin configuration: status: %d, body: %s", status, body)
+	}
+	return nil
+}
+
+// activeAlertmanagers returns the URLs of the external Alertmanagers discovered by Grafana for an organization.
+func activeAlertmanagers(ctx context.Context, client *common.Client, orgID int64) ([]string, error) {
+	status, body, err := grafanaAPIRequest(ctx, client, orgID, http.MethodGet, "/api/v1/ngalert/alertmanagers", nil, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if status >= 400 {
+		return nil, fmt.Errorf("failed to list the external Alertmanagers: status: %d, body: %s", status, body)
+	}
+	var resp struct {
+		Data struct {
+			ActiveAlertManagers []struct {
+				URL string `json:"url"`
+			} `json:"activeAlertManagers"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode the external Alertmanagers: %w", err)
+	}
+	urls := make([]string, 0, len(resp.Data.ActiveAlertManagers))
+	for _, am := range resp.Data.ActiveAlertManagers {
+		urls = append(urls, am.URL)
+	}
+	return urls, nil
+}
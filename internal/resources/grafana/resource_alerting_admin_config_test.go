@@ -0,0 +1,47 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccAlertingAdminConfig_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.2.0")
+
+	// TODO: Make parallizable
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_alerting_admin_config/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_alerting_admin_config.config", "id", "1"),
+					resource.TestCheckResourceAttr("grafana_alerting_admin_config.config", "org_id", "1"),
+					resource.TestCheckResourceAttr("grafana_alerting_admin_config.config", "send_alerts_to", "external"),
+				),
+			},
+			{
+				ResourceName:            "grafana_alerting_admin_config.config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"active_alertmanagers"},
+			},
+			{
+				Config: testAccAlertingAdminConfig("internal"),
+				Check:  resource.TestCheckResourceAttr("grafana_alerting_admin_config.config", "send_alerts_to", "internal"),
+			},
+		},
+	})
+}
+
+func testAccAlertingAdminConfig(sendAlertsTo string) string {
+	return fmt.Sprintf(`
+	resource "grafana_alerting_admin_config" "config" {
+		send_alerts_to = %q
+	  }
+	`, sendAlertsTo)
+}
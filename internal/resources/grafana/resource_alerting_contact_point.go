@@ -2,15 +2,20 @@ package grafana
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -23,14 +28,17 @@ var notifiers = []notifier{
 	discordNotifier{},
 	emailNotifier{},
 	googleChatNotifier{},
+	jiraNotifier{},
 	kafkaNotifier{},
 	lineNotifier{},
+	mqttNotifier{},
 	oncallNotifier{},
 	opsGenieNotifier{},
 	pagerDutyNotifier{},
 	pushoverNotifier{},
 	sensugoNotifier{},
 	slackNotifier{},
+	snsNotifier{},
 	teamsNotifier{},
 	telegramNotifier{},
 	threemaNotifier{},
@@ -45,6 +53,8 @@ func ResourceContactPoint() *schema.Resource {
 		Description: `
 Manages Grafana Alerting contact points.
 
+A contact point must be managed by a single resource, with all its notifiers: two resources with the same name and organization are rejected at plan time, as they would delete each other's notifiers.
+
 * [Official documentation](https://grafana.com/docs/grafana/next/alerting/fundamentals/contact-points/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#contact-points)
 
@@ -54,6 +64,7 @@ This resource requires Grafana 9.1.0 or later.
 		ReadContext:   readContactPoint,
 		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](updateContactPoint),
 		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteContactPoint),
+		CustomizeDiff: customdiff.All(validateContactPointDuplicateName, validateContactPointImages, validateContactPointURLs, validateContactPointSNS),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -68,6 +79,13 @@ This resource requires Grafana 9.1.0 or later.
 				Required:    true,
 				Description: "The name of the contact point.",
 			},
+			"disable_provenance": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow modifying the contact point from other sources than Terraform or the Grafana API.",
+			},
+			"ignore_ui_changes": ignoreUIChangesAttribute("contact point", "The configuration of its notifiers"),
 		},
 	}
 
@@ -91,15 +109,20 @@ This resource requires Grafana 9.1.0 or later.
 }
 
 func readContactPoint(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
-	// First, try to fetch the contact point by name.
-	// If that fails, try to fetch it by the UID of its notifiers.
-	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams().WithName(&name))
+	// First, try to find the contact point by name.
+	// If that fails, try to find it by the UID of its notifiers.
+	allPoints, err := listContactPoints(client, meta, orgID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	points := resp.Payload
+	var points models.ContactPoints
+	for _, p := range allPoints {
+		if p.Name == name {
+			points = append(points, p)
+		}
+	}
 	if len(points) == 0 {
 		// If the contact point was not found by name, try to fetch it by UID.
 		// This is a deprecated ID format (uid;uid2;uid3)
@@ -108,11 +131,7 @@ func readContactPoint(ctx context.Context, data *schema.ResourceData, meta inter
 		for _, uid := range strings.Split(data.Id(), ";") {
 			uidsMap[uid] = false
 		}
-		resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		for i, p := range resp.Payload {
+		for i, p := range allPoints {
 			if _, ok := uidsMap[p.UID]; !ok {
 				continue
 			}
@@ -134,10 +153,10 @@ func readContactPoint(ctx context.Context, data *schema.ResourceData, meta inter
 	if len(points) == 0 {
 		return common.WarnMissing("contact point", data)
 	}
-
-	if err := packContactPoints(points, data); err != nil {
+	if err := packContactPoints(points, data, ignoreUIChanges(data, points[0].Provenance)); err != nil {
 		return diag.FromErr(err)
 	}
+	data.Set("disable_provenance", points[0].Provenance == "")
 	data.Set("org_id", strconv.FormatInt(orgID, 10))
 	data.SetId(MakeOrgResourceID(orgID, points[0].Name))
 
@@ -145,7 +164,11 @@ func readContactPoint(ctx context.Context, data *schema.ResourceData, meta inter
 }
 
 func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
+
+	if diags := probeContactPointURLs(ctx, data, meta); diags.HasError() {
+		return diags
+	}
 
 	ps := unpackContactPoints(data)
 
@@ -169,6 +192,10 @@ func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta int
 		if uid = p.tfState["uid"].(string); uid != "" {
 			// If the contact point already has a UID, update it.
 			params := provisioning.NewPutContactpointParams().WithUID(uid).WithBody(p.gfState)
+			if data.Get("disable_provenance").(bool) {
+				disabled := "disabled"
+				params.SetXDisableProvenance(&disabled)
+			}
 			if _, err := client.Provisioning.PutContactpoint(params); err != nil {
 				return diag.FromErr(err)
 			}
@@ -177,7 +204,12 @@ func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta int
 			// Retry if the API returns 500 because it may be that the alertmanager is not ready in the org yet.
 			// The alertmanager is provisioned asynchronously when the org is created.
 			err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
-				resp, err := client.Provisioning.PostContactpoints(provisioning.NewPostContactpointsParams().WithBody(p.gfState))
+				params := provisioning.NewPostContactpointsParams().WithBody(p.gfState)
+				if data.Get("disable_provenance").(bool) {
+					disabled := "disabled"
+					params.SetXDisableProvenance(&disabled)
+				}
+				resp, err := client.Provisioning.PostContactpoints(params)
 				if orgID > 1 && err != nil && err.(*runtime.APIError).IsCode(500) {
 					return retry.RetryableError(err)
 				} else if err != nil {
@@ -206,12 +238,14 @@ func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta int
 		}
 	}
 
+	meta.(*common.Client).InvalidateList(contactPointsListKey(orgID))
 	data.SetId(MakeOrgResourceID(orgID, data.Get("name").(string)))
-	return readContactPoint(ctx, data, meta)
+	return append(contactPointImagesWarnings(data, meta), readContactPoint(ctx, data, meta)...)
 }
 
 func deleteContactPoint(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
+	defer meta.(*common.Client).InvalidateList(contactPointsListKey(orgID))
 
 	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams().WithName(&name))
 	if err, shouldReturn := common.CheckReadError("contact point", data, err); shouldReturn {
@@ -227,6 +261,69 @@ func deleteContactPoint(ctx context.Context, data *schema.ResourceData, meta int
 	return nil
 }
 
+// validateContactPointDuplicateName checks that the contact point isn't managed by another resource of the configuration planned in the same run.
+// The resources of a contact point each delete the notifiers of the others, so they would never converge.
+// Resources are told apart by their configuration: duplicates with the exact same configuration aren't detected.
+func validateContactPointDuplicateName(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*common.Client)
+	if !ok || !d.NewValueKnown("name") || !d.NewValueKnown("org_id") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	config := sha256.Sum256([]byte(d.GetRawConfig().GoString()))
+	if !client.ClaimContactPointName(d.Get("org_id").(string), name, hex.EncodeToString(config[:])) {
+		return fmt.Errorf("contact point %q is managed by more than one grafana_contact_point resource of the organization: they would delete each other's notifiers. Merge their notifiers into a single resource", name)
+	}
+	return nil
+}
+
+// validateContactPointSNS checks that the `sns` notifiers have a destination, and that their access key is set with its secret key.
+// Values unknown at plan time are skipped.
+func validateContactPointSNS(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	sns := config.GetAttr("sns")
+	if sns.IsNull() || !sns.IsKnown() {
+		return nil
+	}
+	isSet := func(value cty.Value) bool {
+		return !value.IsNull() && (!value.IsKnown() || value.AsString() != "")
+	}
+	for it := sns.ElementIterator(); it.Next(); {
+		_, notifier := it.Element()
+		if notifier.IsNull() || !notifier.IsKnown() {
+			continue
+		}
+		if !isSet(notifier.GetAttr("topic_arn")) && !isSet(notifier.GetAttr("target_arn")) && !isSet(notifier.GetAttr("phone_number")) {
+			return fmt.Errorf("the sns notifier of contact point %q requires one of the \"topic_arn\", \"target_arn\" or \"phone_number\" attributes", d.Get("name").(string))
+		}
+		if isSet(notifier.GetAttr("access_key")) != isSet(notifier.GetAttr("secret_key")) {
+			return fmt.Errorf("the \"access_key\" and \"secret_key\" attributes of the sns notifier of contact point %q must be set together", d.Get("name").(string))
+		}
+	}
+	return nil
+}
+
+// contactPointsListKey is the key of the memoized list of the contact points of an organization.
+func contactPointsListKey(orgID int64) string {
+	return fmt.Sprintf("contact_points:%d", orgID)
+}
+
+// listContactPoints lists the contact points of an organization. The list is only fetched once per plan or apply,
+// rather than once per contact point, and must be invalidated when contact points are modified.
+func listContactPoints(client *goapi.GrafanaHTTPAPI, meta interface{}, orgID int64) (models.ContactPoints, error) {
+	return common.MemoizeList(meta.(*common.Client), contactPointsListKey(orgID), func() (models.ContactPoints, error) {
+		resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
+		if err != nil {
+			return nil, err
+		}
+		return resp.Payload, nil
+	})
+}
+
 func unpackContactPoints(data *schema.ResourceData) []statePair {
 	result := make([]statePair, 0)
 	name := data.Get("name").(string)
@@ -247,6 +344,10 @@ func unpackContactPoints(data *schema.ResourceData) []statePair {
 func unpackPointConfig(n notifier, data interface{}, name string) *models.EmbeddedContactPoint {
 	pt := n.unpack(data, name)
 	settings := pt.Settings.(map[string]interface{})
+	// Secure settings are sent along with the other settings, Grafana encrypts the ones it knows as secure for the notifier type
+	for k, v := range data.(map[string]interface{})["secure_settings"].(map[string]interface{}) {
+		settings[k] = v
+	}
 	// Treat settings like `omitempty`. Workaround for versions affected by https://github.com/grafana/grafana/issues/55139
 	for k, v := range settings {
 		if v == "" {
@@ -256,17 +357,24 @@ func unpackPointConfig(n notifier, data interface{}, name string) *models.Embedd
 	return pt
 }
 
-func packContactPoints(ps []*models.EmbeddedContactPoint, data *schema.ResourceData) error {
+// packContactPoints sets the notifiers of the contact point in the state. If keepSettings is set, the notifiers already in the state
+// are kept as they are, rather than refreshed from Grafana: only the notifiers which aren't in the state yet, i.e. without a UID, are packed.
+func packContactPoints(ps []*models.EmbeddedContactPoint, data *schema.ResourceData, keepSettings bool) error {
 	pointsPerNotifier := map[notifier][]interface{}{}
 	for _, p := range ps {
 		data.Set("name", p.Name)
 
 		for _, n := range notifiers {
 			if *p.Type == n.meta().typeStr {
+				if state := getNotifierConfigFromStateWithUID(data, n, p.UID); keepSettings && state != nil {
+					pointsPerNotifier[n] = append(pointsPerNotifier[n], state)
+					continue
+				}
 				packed, err := n.pack(p, data)
 				if err != nil {
 					return err
 				}
+				packSecureSettings(packed.(map[string]interface{}), getNotifierConfigFromStateWithUID(data, n, p.UID))
 				pointsPerNotifier[n] = append(pointsPerNotifier[n], packed)
 				continue
 			}
@@ -299,6 +407,23 @@ func packSettings(p *models.EmbeddedContactPoint) map[string]interface{} {
 	return settings
 }
 
+// packSecureSettings reads the secure settings of a notifier from the state, since Grafana doesn't return them (or redacts them).
+// They are removed from the other settings returned by Grafana.
+func packSecureSettings(tfSettings, state map[string]interface{}) {
+	secureSettings := map[string]interface{}{}
+	if state != nil {
+		if v, ok := state["secure_settings"].(map[string]interface{}); ok {
+			secureSettings = v
+		}
+	}
+	if settings, ok := tfSettings["settings"].(map[string]interface{}); ok {
+		for k := range secureSettings {
+			delete(settings, k)
+		}
+	}
+	tfSettings["secure_settings"] = secureSettings
+}
+
 func commonNotifierResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -323,6 +448,16 @@ func commonNotifierResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"secure_settings": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Default:     map[string]interface{}{},
+				Description: "Additional custom properties to attach to the notifier, for secure fields that aren't in the notifier's schema. They are sent along with `settings` but are never read back from Grafana, so redacted values don't cause a diff.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -1,6 +1,7 @@
 package grafana
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -446,6 +447,167 @@ func (g googleChatNotifier) unpack(raw interface{}, name string) *models.Embedde
 	}
 }
 
+type jiraNotifier struct{}
+
+var _ notifier = (*jiraNotifier)(nil)
+
+func (j jiraNotifier) meta() notifierMeta {
+	return notifierMeta{
+		field:        "jira",
+		typeStr:      "jira",
+		desc:         "A contact point that creates and resolves issues in Jira.",
+		secureFields: []string{"user", "password", "api_token"},
+	}
+}
+
+func (j jiraNotifier) schema() *schema.Resource {
+	r := commonNotifierResource()
+	r.Schema["api_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The URL of the Jira REST API, e.g. `https://example.atlassian.net/rest/api/3`. Versions 2 and 3 of the API are supported.",
+	}
+	r.Schema["project"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The key of the project in which issues are created.",
+	}
+	r.Schema["issue_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of the issues created, e.g. `Bug` or `Task`.",
+	}
+	r.Schema["user"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The user to authenticate with, with `password` or `api_token`.",
+	}
+	r.Schema["password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The password of `user`.",
+	}
+	r.Schema["api_token"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The API token of `user`. Without `user`, the token is used as a bearer token, e.g. a personal access token of Jira Data Center.",
+	}
+	r.Schema["summary"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated summary of the issues.",
+	}
+	r.Schema["description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated description of the issues.",
+	}
+	r.Schema["labels"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The templated labels of the issues.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	r.Schema["priority"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "The templated priority of the issues, i.e. the name of a priority of the project. " +
+			"Use a template to map the alerts to priorities, e.g. `{{ if eq .CommonLabels.severity \"critical\" }}High{{ else }}Low{{ end }}`.",
+	}
+	r.Schema["dedup_key_field"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "The ID of a custom field of the issues, e.g. `10000`, in which the deduplication key of the alerts is stored to find their issue. " +
+			"If not set, the key is stored in a label of the issues.",
+	}
+	r.Schema["reopen_transition"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the transition which reopens the resolved issue of alerts firing again.",
+	}
+	r.Schema["reopen_duration"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "How long after their resolution issues are reopened instead of creating new ones, as a duration, e.g. `10m`.",
+	}
+	r.Schema["resolve_transition"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the transition which resolves the issue of resolved alerts. If not set, issues aren't resolved.",
+	}
+	r.Schema["wont_fix_resolution"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The resolution of the issues which mustn't be reopened, e.g. `Won't Do`.",
+	}
+	return r
+}
+
+func (j jiraNotifier) pack(p *models.EmbeddedContactPoint, data *schema.ResourceData) (interface{}, error) {
+	notifier := packCommonNotifierFields(p)
+	settings := p.Settings.(map[string]interface{})
+
+	packNotifierStringField(&settings, &notifier, "api_url", "api_url")
+	packNotifierStringField(&settings, &notifier, "project", "project")
+	packNotifierStringField(&settings, &notifier, "issue_type", "issue_type")
+	packNotifierStringField(&settings, &notifier, "user", "user")
+	packNotifierStringField(&settings, &notifier, "password", "password")
+	packNotifierStringField(&settings, &notifier, "api_token", "api_token")
+	packNotifierStringField(&settings, &notifier, "summary", "summary")
+	packNotifierStringField(&settings, &notifier, "description", "description")
+	if v, ok := settings["labels"]; ok && v != nil {
+		notifier["labels"] = v.([]interface{})
+		delete(settings, "labels")
+	}
+	packNotifierStringField(&settings, &notifier, "priority", "priority")
+	packNotifierStringField(&settings, &notifier, "dedup_key_field", "dedup_key_field")
+	packNotifierStringField(&settings, &notifier, "reopen_transition", "reopen_transition")
+	packNotifierStringField(&settings, &notifier, "reopen_duration", "reopen_duration")
+	packNotifierStringField(&settings, &notifier, "resolve_transition", "resolve_transition")
+	packNotifierStringField(&settings, &notifier, "wont_fix_resolution", "wont_fix_resolution")
+
+	packSecureFields(notifier, getNotifierConfigFromStateWithUID(data, j, p.UID), j.meta().secureFields)
+
+	notifier["settings"] = packSettings(p)
+	return notifier, nil
+}
+
+func (j jiraNotifier) unpack(raw interface{}, name string) *models.EmbeddedContactPoint {
+	json := raw.(map[string]interface{})
+	uid, disableResolve, settings := unpackCommonNotifierFields(json)
+
+	unpackNotifierStringField(&json, &settings, "api_url", "api_url")
+	unpackNotifierStringField(&json, &settings, "project", "project")
+	unpackNotifierStringField(&json, &settings, "issue_type", "issue_type")
+	unpackNotifierStringField(&json, &settings, "user", "user")
+	unpackNotifierStringField(&json, &settings, "password", "password")
+	unpackNotifierStringField(&json, &settings, "api_token", "api_token")
+	unpackNotifierStringField(&json, &settings, "summary", "summary")
+	unpackNotifierStringField(&json, &settings, "description", "description")
+	if v, ok := json["labels"].([]interface{}); ok && len(v) > 0 {
+		settings["labels"] = common.ListToStringSlice(v)
+	}
+	unpackNotifierStringField(&json, &settings, "priority", "priority")
+	unpackNotifierStringField(&json, &settings, "dedup_key_field", "dedup_key_field")
+	unpackNotifierStringField(&json, &settings, "reopen_transition", "reopen_transition")
+	unpackNotifierStringField(&json, &settings, "reopen_duration", "reopen_duration")
+	unpackNotifierStringField(&json, &settings, "resolve_transition", "resolve_transition")
+	unpackNotifierStringField(&json, &settings, "wont_fix_resolution", "wont_fix_resolution")
+
+	return &models.EmbeddedContactPoint{
+		UID:                   uid,
+		Name:                  name,
+		Type:                  common.Ref(j.meta().typeStr),
+		DisableResolveMessage: disableResolve,
+		Settings:              settings,
+	}
+}
+
 type kafkaNotifier struct{}
 
 var _ notifier = (*kafkaNotifier)(nil)
@@ -619,6 +781,215 @@ func (o lineNotifier) unpack(raw interface{}, name string) *models.EmbeddedConta
 	}
 }
 
+type mqttNotifier struct{}
+
+var _ notifier = (*mqttNotifier)(nil)
+
+func (m mqttNotifier) meta() notifierMeta {
+	return notifierMeta{
+		field:        "mqtt",
+		typeStr:      "mqtt",
+		desc:         "A contact point that publishes notifications to an MQTT broker.",
+		secureFields: []string{"password"},
+	}
+}
+
+func (m mqttNotifier) schema() *schema.Resource {
+	r := commonNotifierResource()
+	r.Schema["broker_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The URL of the MQTT broker, e.g. `tcp://mqtt.example.com:1883` or `ssl://mqtt.example.com:8883`.",
+	}
+	r.Schema["topic"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The topic to publish the notifications to.",
+	}
+	r.Schema["client_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The client ID to use when connecting to the broker. Defaults to a random ID.",
+	}
+	r.Schema["message_format"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"json", "text"}, false),
+		Description:  "The format of the published messages. Supported: json, text. Default: json.",
+	}
+	r.Schema["message"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated content of the message. Only used with the `text` message format.",
+	}
+	r.Schema["username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The username to use when connecting to the broker.",
+	}
+	r.Schema["password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The password to use when connecting to the broker.",
+	}
+	r.Schema["qos"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(0, 2),
+		Description:  "The quality of service level of the published messages: 0 (at most once), 1 (at least once) or 2 (exactly once). Default: 0.",
+	}
+	r.Schema["retain"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the broker retains the last message of the topic for new subscribers.",
+	}
+	r.Schema["tls_config"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The TLS configuration of the connection to the broker.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"insecure_skip_verify": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to skip the verification of the certificate of the broker.",
+				},
+				"ca_certificate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The PEM-encoded certificate of the CA that signed the certificate of the broker.",
+				},
+				"client_certificate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The PEM-encoded client certificate to authenticate with.",
+				},
+				"client_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The PEM-encoded key of the client certificate.",
+				},
+			},
+		},
+	}
+	return r
+}
+
+// mqttTLSSecureFields are the secure fields of the TLS configuration of the MQTT notifier, with their keys in Grafana's settings.
+var mqttTLSSecureFields = map[string]string{
+	"ca_certificate":     "caCertificate",
+	"client_certificate": "clientCertificate",
+	"client_key":         "clientKey",
+}
+
+func (m mqttNotifier) pack(p *models.EmbeddedContactPoint, data *schema.ResourceData) (interface{}, error) {
+	notifier := packCommonNotifierFields(p)
+	settings := p.Settings.(map[string]interface{})
+
+	packNotifierStringField(&settings, &notifier, "brokerUrl", "broker_url")
+	packNotifierStringField(&settings, &notifier, "topic", "topic")
+	packNotifierStringField(&settings, &notifier, "clientId", "client_id")
+	packNotifierStringField(&settings, &notifier, "messageFormat", "message_format")
+	packNotifierStringField(&settings, &notifier, "message", "message")
+	packNotifierStringField(&settings, &notifier, "username", "username")
+	packNotifierStringField(&settings, &notifier, "password", "password")
+	if v, ok := settings["qos"]; ok && v != nil {
+		switch typ := v.(type) {
+		case int:
+			notifier["qos"] = typ
+		case float64:
+			notifier["qos"] = int(typ)
+		case string:
+			qos, err := strconv.Atoi(typ)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse value of 'qos' to integer: %w", err)
+			}
+			notifier["qos"] = qos
+		default:
+			return nil, fmt.Errorf("unexpected type %T for 'qos': %v", typ, typ)
+		}
+		delete(settings, "qos")
+	}
+	if v, ok := settings["retain"]; ok && v != nil {
+		notifier["retain"] = v.(bool)
+		delete(settings, "retain")
+	}
+
+	state := getNotifierConfigFromStateWithUID(data, m, p.UID)
+	var stateTLS map[string]interface{}
+	if state != nil {
+		if v, ok := state["tls_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			stateTLS = v[0].(map[string]interface{})
+		}
+	}
+	if v, ok := settings["tlsConfig"]; (ok && v != nil) || stateTLS != nil {
+		tls := map[string]interface{}{}
+		if gfTLS, ok := v.(map[string]interface{}); ok {
+			if v, ok := gfTLS["insecureSkipVerify"]; ok && v != nil {
+				tls["insecure_skip_verify"] = v.(bool)
+			}
+		}
+		// Grafana doesn't return the secure fields of the TLS configuration, they are read from the state
+		for tfKey := range mqttTLSSecureFields {
+			if v, ok := stateTLS[tfKey]; ok && v != nil {
+				tls[tfKey] = v.(string)
+			}
+		}
+		notifier["tls_config"] = []interface{}{tls}
+		delete(settings, "tlsConfig")
+	}
+
+	packSecureFields(notifier, state, m.meta().secureFields)
+
+	notifier["settings"] = packSettings(p)
+	return notifier, nil
+}
+
+func (m mqttNotifier) unpack(raw interface{}, name string) *models.EmbeddedContactPoint {
+	json := raw.(map[string]interface{})
+	uid, disableResolve, settings := unpackCommonNotifierFields(json)
+
+	unpackNotifierStringField(&json, &settings, "broker_url", "brokerUrl")
+	unpackNotifierStringField(&json, &settings, "topic", "topic")
+	unpackNotifierStringField(&json, &settings, "client_id", "clientId")
+	unpackNotifierStringField(&json, &settings, "message_format", "messageFormat")
+	unpackNotifierStringField(&json, &settings, "message", "message")
+	unpackNotifierStringField(&json, &settings, "username", "username")
+	unpackNotifierStringField(&json, &settings, "password", "password")
+	if v, ok := json["qos"]; ok && v != nil {
+		settings["qos"] = v.(int)
+	}
+	if v, ok := json["retain"]; ok && v != nil {
+		settings["retain"] = v.(bool)
+	}
+	if v, ok := json["tls_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfTLS := v[0].(map[string]interface{})
+		tls := map[string]interface{}{}
+		if v, ok := tfTLS["insecure_skip_verify"]; ok && v != nil {
+			tls["insecureSkipVerify"] = v.(bool)
+		}
+		for tfKey, gfKey := range mqttTLSSecureFields {
+			if v, ok := tfTLS[tfKey].(string); ok && v != "" {
+				tls[gfKey] = v
+			}
+		}
+		settings["tlsConfig"] = tls
+	}
+
+	return &models.EmbeddedContactPoint{
+		UID:                   uid,
+		Name:                  name,
+		Type:                  common.Ref(m.meta().typeStr),
+		DisableResolveMessage: disableResolve,
+		Settings:              settings,
+	}
+}
+
 type oncallNotifier struct {
 }
 
@@ -714,7 +1085,6 @@ func (w oncallNotifier) pack(p *models.EmbeddedContactPoint, data *schema.Resour
 		}
 		delete(settings, "maxAlerts")
 	}
-
 	packSecureFields(notifier, getNotifierConfigFromStateWithUID(data, w, p.UID), w.meta().secureFields)
 
 	notifier["settings"] = packSettings(p)
@@ -1555,6 +1925,157 @@ func (s slackNotifier) unpack(raw interface{}, name string) *models.EmbeddedCont
 	}
 }
 
+type snsNotifier struct{}
+
+var _ notifier = (*snsNotifier)(nil)
+
+// snsSigV4Fields maps the attributes of the sns notifier to the keys of its `sigv4` settings.
+var snsSigV4Fields = map[string]string{
+	"region":     "region",
+	"access_key": "access_key",
+	"secret_key": "secret_key",
+	"profile":    "profile",
+	"role_arn":   "role_arn",
+}
+
+func (s snsNotifier) meta() notifierMeta {
+	return notifierMeta{
+		field:        "sns",
+		typeStr:      "sns",
+		desc:         "A contact point that sends notifications to Amazon SNS.",
+		secureFields: []string{"access_key", "secret_key"},
+	}
+}
+
+func (s snsNotifier) schema() *schema.Resource {
+	r := commonNotifierResource()
+	r.Schema["topic_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The ARN of the Amazon SNS topic to send notifications to. One of `topic_arn`, `target_arn` or `phone_number` is required.",
+	}
+	r.Schema["target_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The ARN of the mobile platform endpoint to send notifications to.",
+	}
+	r.Schema["phone_number"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The phone number, in E.164 format, to send notifications to by SMS.",
+	}
+	r.Schema["api_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The URL of the Amazon SNS API. Defaults to the endpoint of the region.",
+	}
+	r.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The AWS region of the topic. Defaults to the region of the AWS environment of Grafana.",
+	}
+	r.Schema["access_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The AWS access key ID used to authenticate with Amazon SNS. Defaults to the credentials of the AWS environment of Grafana.",
+	}
+	r.Schema["secret_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The AWS secret access key used to authenticate with Amazon SNS. Required with `access_key`.",
+	}
+	r.Schema["profile"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The named AWS profile used to authenticate with Amazon SNS.",
+	}
+	r.Schema["role_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The ARN of the role to assume to send notifications to Amazon SNS.",
+	}
+	r.Schema["subject"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated subject line of the message.",
+	}
+	r.Schema["message"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The templated message.",
+	}
+	r.Schema["attributes"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Description: "The message attributes of the SNS notifications.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	return r
+}
+
+func (s snsNotifier) pack(p *models.EmbeddedContactPoint, data *schema.ResourceData) (interface{}, error) {
+	notifier := packCommonNotifierFields(p)
+	settings := p.Settings.(map[string]interface{})
+
+	packNotifierStringField(&settings, &notifier, "topic_arn", "topic_arn")
+	packNotifierStringField(&settings, &notifier, "target_arn", "target_arn")
+	packNotifierStringField(&settings, &notifier, "phone_number", "phone_number")
+	packNotifierStringField(&settings, &notifier, "api_url", "api_url")
+	packNotifierStringField(&settings, &notifier, "subject", "subject")
+	packNotifierStringField(&settings, &notifier, "message", "message")
+	if v, ok := settings["attributes"].(map[string]interface{}); ok {
+		notifier["attributes"] = v
+		delete(settings, "attributes")
+	}
+	if sigv4, ok := settings["sigv4"].(map[string]interface{}); ok {
+		for tfKey, gfKey := range snsSigV4Fields {
+			packNotifierStringField(&sigv4, &notifier, gfKey, tfKey)
+		}
+		delete(settings, "sigv4")
+	}
+
+	packSecureFields(notifier, getNotifierConfigFromStateWithUID(data, s, p.UID), s.meta().secureFields)
+
+	notifier["settings"] = packSettings(p)
+	return notifier, nil
+}
+
+func (s snsNotifier) unpack(raw interface{}, name string) *models.EmbeddedContactPoint {
+	json := raw.(map[string]interface{})
+	uid, disableResolve, settings := unpackCommonNotifierFields(json)
+
+	unpackNotifierStringField(&json, &settings, "topic_arn", "topic_arn")
+	unpackNotifierStringField(&json, &settings, "target_arn", "target_arn")
+	unpackNotifierStringField(&json, &settings, "phone_number", "phone_number")
+	unpackNotifierStringField(&json, &settings, "api_url", "api_url")
+	unpackNotifierStringField(&json, &settings, "subject", "subject")
+	unpackNotifierStringField(&json, &settings, "message", "message")
+	if v, ok := json["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		settings["attributes"] = v
+	}
+	sigv4 := map[string]interface{}{}
+	for tfKey, gfKey := range snsSigV4Fields {
+		if v, ok := json[tfKey].(string); ok && v != "" {
+			sigv4[gfKey] = v
+		}
+	}
+	if len(sigv4) > 0 {
+		settings["sigv4"] = sigv4
+	}
+
+	return &models.EmbeddedContactPoint{
+		UID:                   uid,
+		Name:                  name,
+		Type:                  common.Ref(s.meta().typeStr),
+		DisableResolveMessage: disableResolve,
+		Settings:              settings,
+	}
+}
+
 type teamsNotifier struct{}
 
 var _ notifier = (*teamsNotifier)(nil)
@@ -2019,6 +2540,20 @@ func (w webhookNotifier) schema() *schema.Resource {
 		Optional:    true,
 		Description: "Templated title of the message.",
 	}
+	r.Schema["headers"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Custom HTTP headers to attach to the request. The values may hold credentials: they are sensitive, and the values redacted by Grafana are read from the state.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	r.Schema["payload_template"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Custom template of the request body, replacing the default payload. The `title` and `message` templates are available to it. The other fields of the payload, e.g. its variables, can be set as JSON in the `payload` key of `settings`.",
+	}
 	return r
 }
 
@@ -2051,10 +2586,34 @@ func (w webhookNotifier) pack(p *models.EmbeddedContactPoint, data *schema.Resou
 		}
 		delete(settings, "maxAlerts")
 	}
+	state := getNotifierConfigFromStateWithUID(data, w, p.UID)
+	if v, ok := settings["headers"]; ok && v != nil {
+		notifier["headers"] = packWebhookHeaders(v.(map[string]interface{}), state)
+		delete(settings, "headers")
+	} else if v, ok := state["headers"].(map[string]interface{}); ok && len(v) > 0 {
+		// Grafana may leave out the headers if they are all secure
+		notifier["headers"] = v
+	}
+	payload, _ := settings["payload"].(map[string]interface{})
+	if template, ok := payload["template"].(string); ok {
+		notifier["payload_template"] = template
+		delete(payload, "template")
+	}
+	if len(payload) == 0 {
+		delete(settings, "payload")
+	}
 
-	packSecureFields(notifier, getNotifierConfigFromStateWithUID(data, w, p.UID), w.meta().secureFields)
+	packSecureFields(notifier, state, w.meta().secureFields)
 
 	notifier["settings"] = packSettings(p)
+	if len(payload) > 0 {
+		// The other fields of the payload, e.g. its variables, are kept in the settings as JSON
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		notifier["settings"].(map[string]interface{})["payload"] = string(encoded)
+	}
 	return notifier, nil
 }
 
@@ -2080,6 +2639,11 @@ func (w webhookNotifier) unpack(raw interface{}, name string) *models.EmbeddedCo
 			panic(fmt.Sprintf("unexpected type for maxAlerts: %v", typ))
 		}
 	}
+	if v, ok := json["headers"].(map[string]interface{}); ok && len(v) > 0 {
+		settings["headers"] = v
+	}
+	template, _ := json["payload_template"].(string)
+	unpackWebhookPayload(settings, template)
 
 	return &models.EmbeddedContactPoint{
 		UID:                   uid,
@@ -2090,6 +2654,37 @@ func (w webhookNotifier) unpack(raw interface{}, name string) *models.EmbeddedCo
 	}
 }
 
+// unpackWebhookPayload sets the payload of the settings of a webhook notifier from its template, and from the other fields
+// of the payload (e.g. its variables) kept as JSON in the settings. An invalid JSON is sent as is, and rejected by Grafana.
+func unpackWebhookPayload(settings map[string]interface{}, template string) {
+	payload := map[string]interface{}{}
+	if v, ok := settings["payload"].(string); ok {
+		if err := json.Unmarshal([]byte(v), &payload); err != nil {
+			return
+		}
+	}
+	if template != "" {
+		payload["template"] = template
+	}
+	if len(payload) > 0 {
+		settings["payload"] = payload
+	}
+}
+
+// packWebhookHeaders returns the headers of a webhook notifier returned by Grafana, with the redacted values read from the state.
+func packWebhookHeaders(headers, state map[string]interface{}) map[string]interface{} {
+	stateHeaders, _ := state["headers"].(map[string]interface{})
+	packed := make(map[string]interface{}, len(headers))
+	for k, v := range headers {
+		value, _ := v.(string)
+		if stateValue, ok := stateHeaders[k]; ok && value == redactedSettingValue {
+			value = stateValue.(string)
+		}
+		packed[k] = value
+	}
+	return packed
+}
+
 type wecomNotifier struct{}
 
 var _ notifier = (*wecomNotifier)(nil)
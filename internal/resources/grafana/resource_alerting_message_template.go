@@ -62,7 +62,7 @@ This resource requires Grafana 9.1.0 or later.
 }
 
 func readMessageTemplate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	resp, err := client.Provisioning.GetTemplate(name)
 	if err, shouldReturn := common.CheckReadError("message template", data, err); shouldReturn {
@@ -78,7 +78,7 @@ func readMessageTemplate(ctx context.Context, data *schema.ResourceData, meta in
 }
 
 func putMessageTemplate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
 
 	name := data.Get("name").(string)
 	content := data.Get("template").(string)
@@ -112,7 +112,7 @@ func putMessageTemplate(ctx context.Context, data *schema.ResourceData, meta int
 }
 
 func deleteMessageTemplate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	_, err := client.Provisioning.DeleteTemplate(name)
 	diag, _ := common.CheckReadError("message template", data, err)
@@ -3,14 +3,17 @@ package grafana
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceMuteTiming() *schema.Resource {
@@ -31,6 +34,7 @@ This resource requires Grafana 9.1.0 or later.
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateMuteTimingTimeRanges,
 
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -61,14 +65,16 @@ This resource requires Grafana 9.1.0 or later.
 								SchemaVersion: 0,
 								Schema: map[string]*schema.Schema{
 									"start": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The time, in hh:mm format, of when the interval should begin inclusively.",
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The time, in hh:mm format, of when the interval should begin inclusively.",
+										ValidateFunc: validation.StringMatch(muteTimingTimeRegexp, "must be a time in hh:mm format, between 00:00 and 24:00"),
 									},
 									"end": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The time, in hh:mm format, of when the interval should end exclusively.",
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The time, in hh:mm format, of when the interval should end exclusively.",
+										ValidateFunc: validation.StringMatch(muteTimingTimeRegexp, "must be a time in hh:mm format, between 00:00 and 24:00"),
 									},
 								},
 							},
@@ -78,7 +84,8 @@ This resource requires Grafana 9.1.0 or later.
 							Optional:    true,
 							Description: `An inclusive range of weekdays, e.g. "monday" or "tuesday:thursday".`,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validateMuteTimingRange("weekday", parseMuteTimingWeekday),
 							},
 						},
 						"days_of_month": {
@@ -86,7 +93,8 @@ This resource requires Grafana 9.1.0 or later.
 							Optional:    true,
 							Description: `An inclusive range of days, 1-31, within a month, e.g. "1" or "14:16". Negative values can be used to represent days counting from the end of a month, e.g. "-1".`,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validateMuteTimingDaysOfMonth,
 							},
 						},
 						"months": {
@@ -94,7 +102,8 @@ This resource requires Grafana 9.1.0 or later.
 							Optional:    true,
 							Description: `An inclusive range of months, either numerical or full calendar month, e.g. "1:3", "december", or "may:august".`,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validateMuteTimingRange("month", parseMuteTimingMonth),
 							},
 							DiffSuppressFunc: suppressMonthDiff,
 						},
@@ -103,13 +112,15 @@ This resource requires Grafana 9.1.0 or later.
 							Optional:    true,
 							Description: `A positive inclusive range of years, e.g. "2030" or "2025:2026".`,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validateMuteTimingRange("year", parseMuteTimingYear),
 							},
 						},
 						"location": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: `Provides the time zone for the time interval. Must be a location in the IANA time zone database, e.g "America/New_York"`,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  `Provides the time zone for the time interval. Must be a location in the IANA time zone database, e.g "America/New_York"`,
+							ValidateFunc: validateMuteTimingLocation,
 						},
 					},
 				},
@@ -119,7 +130,7 @@ This resource requires Grafana 9.1.0 or later.
 }
 
 func readMuteTiming(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	resp, err := client.Provisioning.GetMuteTiming(name)
 	if err, shouldReturn := common.CheckReadError("mute timing", data, err); shouldReturn {
@@ -135,7 +146,7 @@ func readMuteTiming(ctx context.Context, data *schema.ResourceData, meta interfa
 }
 
 func createMuteTiming(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
 
 	intervals := data.Get("intervals").([]interface{})
 	params := provisioning.NewPostMuteTimingParams().
@@ -153,7 +164,7 @@ func createMuteTiming(ctx context.Context, data *schema.ResourceData, meta inter
 }
 
 func updateMuteTiming(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	intervals := data.Get("intervals").([]interface{})
 	params := provisioning.NewPutMuteTimingParams().
@@ -171,7 +182,7 @@ func updateMuteTiming(ctx context.Context, data *schema.ResourceData, meta inter
 }
 
 func deleteMuteTiming(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	_, err := client.Provisioning.DeleteMuteTiming(name)
 	diag, _ := common.CheckReadError("mute timing", data, err)
@@ -179,24 +190,9 @@ func deleteMuteTiming(ctx context.Context, data *schema.ResourceData, meta inter
 }
 
 func suppressMonthDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
-	monthNums := map[string]int{
-		"january":   1,
-		"february":  2,
-		"march":     3,
-		"april":     4,
-		"may":       5,
-		"june":      6,
-		"july":      7,
-		"august":    8,
-		"september": 9,
-		"october":   10,
-		"november":  11,
-		"december":  12,
-	}
-
 	oldNormalized := oldValue
 	newNormalized := newValue
-	for k, v := range monthNums {
+	for k, v := range muteTimingMonths {
 		oldNormalized = strings.ReplaceAll(oldNormalized, k, fmt.Sprint(v))
 		newNormalized = strings.ReplaceAll(newNormalized, k, fmt.Sprint(v))
 	}
@@ -204,6 +200,146 @@ func suppressMonthDiff(k, oldValue, newValue string, d *schema.ResourceData) boo
 	return oldNormalized == newNormalized
 }
 
+// muteTimingTimeRegexp matches the times of the time ranges of mute timings, like Alertmanager.
+var muteTimingTimeRegexp = regexp.MustCompile(`^((([01][0-9])|(2[0-3])):[0-5][0-9])$|(^24:00$)`)
+
+var muteTimingWeekdays = map[string]int{
+	"sunday":    0,
+	"monday":    1,
+	"tuesday":   2,
+	"wednesday": 3,
+	"thursday":  4,
+	"friday":    5,
+	"saturday":  6,
+}
+
+var muteTimingMonths = map[string]int{
+	"january":   1,
+	"february":  2,
+	"march":     3,
+	"april":     4,
+	"may":       5,
+	"june":      6,
+	"july":      7,
+	"august":    8,
+	"september": 9,
+	"october":   10,
+	"november":  11,
+	"december":  12,
+}
+
+func parseMuteTimingWeekday(s string) (int, error) {
+	if day, ok := muteTimingWeekdays[strings.ToLower(s)]; ok {
+		return day, nil
+	}
+	return 0, fmt.Errorf("%q isn't a day of the week, e.g. \"monday\"", s)
+}
+
+func parseMuteTimingMonth(s string) (int, error) {
+	if month, ok := muteTimingMonths[strings.ToLower(s)]; ok {
+		return month, nil
+	}
+	if month, err := strconv.Atoi(s); err == nil && month >= 1 && month <= 12 {
+		return month, nil
+	}
+	return 0, fmt.Errorf("%q isn't a month, either between 1 and 12 or a full month name, e.g. \"may\"", s)
+}
+
+func parseMuteTimingYear(s string) (int, error) {
+	if year, err := strconv.Atoi(s); err == nil && year > 0 {
+		return year, nil
+	}
+	return 0, fmt.Errorf("%q isn't a positive year", s)
+}
+
+func parseMuteTimingDayOfMonth(s string) (int, error) {
+	if day, err := strconv.Atoi(s); err == nil && day != 0 && day >= -31 && day <= 31 {
+		return day, nil
+	}
+	return 0, fmt.Errorf("%q isn't a day of the month, between 1 and 31 or between -31 and -1", s)
+}
+
+// splitMuteTimingRange splits an inclusive range, e.g. "monday:friday", into its start and end. A single value is both.
+func splitMuteTimingRange(v string) (string, string) {
+	if start, end, ok := strings.Cut(v, ":"); ok {
+		return start, end
+	}
+	return v, v
+}
+
+// validateMuteTimingRange validates an inclusive range of weekdays, months or years: both values must be valid, and the start can't be after the end.
+func validateMuteTimingRange(kind string, parse func(string) (int, error)) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		startStr, endStr := splitMuteTimingRange(i.(string))
+		start, err := parse(startStr)
+		if err != nil {
+			return nil, []error{fmt.Errorf("invalid %s range in %s: %w", kind, k, err)}
+		}
+		end, err := parse(endStr)
+		if err != nil {
+			return nil, []error{fmt.Errorf("invalid %s range in %s: %w", kind, k, err)}
+		}
+		if start > end {
+			return nil, []error{fmt.Errorf("invalid %s range in %s: the start of %q is after its end", kind, k, i)}
+		}
+		return nil, nil
+	}
+}
+
+// validateMuteTimingDaysOfMonth validates an inclusive range of days of the month.
+// The start can't be after the end, unless they count from different ends of the month (e.g. "1:-1").
+func validateMuteTimingDaysOfMonth(i interface{}, k string) ([]string, []error) {
+	startStr, endStr := splitMuteTimingRange(i.(string))
+	start, err := parseMuteTimingDayOfMonth(startStr)
+	if err != nil {
+		return nil, []error{fmt.Errorf("invalid day of month range in %s: %w", k, err)}
+	}
+	end, err := parseMuteTimingDayOfMonth(endStr)
+	if err != nil {
+		return nil, []error{fmt.Errorf("invalid day of month range in %s: %w", k, err)}
+	}
+	if (start > 0) == (end > 0) && start > end {
+		return nil, []error{fmt.Errorf("invalid day of month range in %s: the start of %q is after its end", k, i)}
+	}
+	if start < 0 && end > 0 {
+		return nil, []error{fmt.Errorf("invalid day of month range in %s: %q starts from the end of the month and ends from its start", k, i)}
+	}
+	return nil, nil
+}
+
+func validateMuteTimingLocation(i interface{}, k string) ([]string, []error) {
+	v := i.(string)
+	if _, err := time.LoadLocation(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a location of the IANA time zone database, e.g. \"America/New_York\", got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// validateMuteTimingTimeRanges checks at plan time that the time ranges of the mute timing start before they end.
+func validateMuteTimingTimeRanges(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, interval := range d.Get("intervals").([]interface{}) {
+		interval, ok := interval.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for j, timeRange := range interval["times"].([]interface{}) {
+			timeRange, ok := timeRange.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// Unknown values are empty, and times in hh:mm format are ordered like strings
+			start, end := timeRange["start"].(string), timeRange["end"].(string)
+			if start == "" || end == "" {
+				continue
+			}
+			if start >= end {
+				return fmt.Errorf("intervals.%d.times.%d: the start (%s) must be before the end (%s)", i, j, start, end)
+			}
+		}
+	}
+	return nil
+}
+
 func packIntervals(nts []*models.TimeInterval) []interface{} {
 	if nts == nil {
 		return nil
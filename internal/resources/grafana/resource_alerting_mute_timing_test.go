@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -69,3 +70,69 @@ func TestAccMuteTiming_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccMuteTiming_invalidIntervals(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var steps []resource.TestStep
+	for _, tc := range []struct {
+		replace map[string]string
+		err     string
+	}{
+		{
+			replace: map[string]string{`"04:56"`: `"12:0"`},
+			err:     `must be a time in hh:mm format`,
+		},
+		{
+			replace: map[string]string{`"14:17"`: `"24:30"`},
+			err:     `must be a time in hh:mm format`,
+		},
+		{
+			replace: map[string]string{`"14:17"`: `"04:00"`},
+			err:     `the start \(04:56\) must be before the end \(04:00\)`,
+		},
+		{
+			replace: map[string]string{`"tuesday:thursday"`: `"tuesday:thrusday"`},
+			err:     `"thrusday" isn't a day of the week`,
+		},
+		{
+			replace: map[string]string{`"tuesday:thursday"`: `"thursday:tuesday"`},
+			err:     `the start of "thursday:tuesday" is after its end`,
+		},
+		{
+			replace: map[string]string{`"1:7"`: `"0:7"`},
+			err:     `"0" isn't a day of the month`,
+		},
+		{
+			replace: map[string]string{`"december"`: `"decmber"`},
+			err:     `"decmber" isn't a month`,
+		},
+		{
+			replace: map[string]string{`"2025:2026"`: `"2026:2025"`},
+			err:     `the start of "2026:2025" is after its end`,
+		},
+		{
+			replace: map[string]string{`"America/New_York"`: `"America/NewYork"`},
+			err:     `to be a location of the IANA time zone database`,
+		},
+	} {
+		steps = append(steps, resource.TestStep{
+			Config:      testutils.TestAccExampleWithReplace(t, "resources/grafana_mute_timing/resource.tf", tc.replace),
+			ExpectError: regexp.MustCompile(tc.err),
+		})
+	}
+	// Ranges of days counting from both ends of the month, and times ending at midnight are valid
+	steps = append(steps, resource.TestStep{
+		Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_mute_timing/resource.tf", map[string]string{
+			`"1:7"`:   `"1:-1"`,
+			`"14:17"`: `"24:00"`,
+		}),
+		PlanOnly:           true,
+		ExpectNonEmptyPlan: true,
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps:             steps,
+	})
+}
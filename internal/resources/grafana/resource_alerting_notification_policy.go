@@ -2,10 +2,13 @@ package grafana
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,6 +25,8 @@ Sets the global notification policy for Grafana.
 * [Official documentation](https://grafana.com/docs/grafana/latest/alerting/manage-notifications/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/)
 
+Updates of large trees may time out while Grafana keeps applying them: failed updates are retried with an exponential backoff, and the tree is read before each retry to check whether it was applied.
+
 This resource requires Grafana 9.1.0 or later.
 `,
 
@@ -29,6 +34,7 @@ This resource requires Grafana 9.1.0 or later.
 		ReadContext:   readNotificationPolicy,
 		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](putNotificationPolicy),
 		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteNotificationPolicy),
+		CustomizeDiff: customdiff.All(validateNotificationPolicyMatchers, warnLargeNotificationPolicy),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -41,6 +47,7 @@ This resource requires Grafana 9.1.0 or later.
 				Default:     false,
 				Description: "Allow modifying the notification policy from other sources than Terraform or the Grafana API.",
 			},
+			"ignore_ui_changes": ignoreUIChangesAttribute("notification policy", "The state"),
 			"contact_point": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -56,19 +63,22 @@ This resource requires Grafana 9.1.0 or later.
 				},
 			},
 			"group_wait": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Time to wait to buffer alerts of the same group before sending a notification. Default is 30 seconds.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: common.ValidatePrometheusDuration,
+				Description:      "Time to wait to buffer alerts of the same group before sending a notification. Inherited by the nested policies that don't set it. Default is 30 seconds.",
 			},
 			"group_interval": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Minimum time interval between two notifications for the same group. Default is 5 minutes.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: common.ValidatePrometheusDuration,
+				Description:      "Minimum time interval between two notifications for the same group. Inherited by the nested policies that don't set it. Default is 5 minutes.",
 			},
 			"repeat_interval": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Minimum time interval for re-sending a notification if an alert is still firing. Default is 4 hours.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: common.ValidatePrometheusDuration,
+				Description:      "Minimum time interval for re-sending a notification if an alert is still firing. Inherited by the nested policies that don't set it. Default is 4 hours.",
 			},
 
 			"policy": {
@@ -117,9 +127,10 @@ func policySchema(depth uint) *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"label": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The name of the label to match against.",
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the label to match against.",
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 						"match": {
 							Type:         schema.TypeString,
@@ -130,7 +141,7 @@ func policySchema(depth uint) *schema.Resource {
 						"value": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The label value to match against.",
+							Description: "The label value to match against. With the regex operators, it must be a valid regular expression, anchored at both ends.",
 						},
 					},
 				},
@@ -149,19 +160,22 @@ func policySchema(depth uint) *schema.Resource {
 				Description: "Whether to continue matching subsequent rules if an alert matches the current rule. Otherwise, the rule will be 'consumed' by the first policy to match it.",
 			},
 			"group_wait": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Time to wait to buffer alerts of the same group before sending a notification. Default is 30 seconds.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: common.ValidatePrometheusDuration,
+				Description:      "Time to wait to buffer alerts of the same group before sending a notification. Default is 30 seconds.",
 			},
 			"group_interval": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Minimum time interval between two notifications for the same group. Default is 5 minutes.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: common.ValidatePrometheusDuration,
+				Description:      "Minimum time interval between two notifications for the same group. Default is 5 minutes.",
 			},
 			"repeat_interval": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Minimum time interval for re-sending a notification if an alert is still firing. Default is 4 hours.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: common.ValidatePrometheusDuration,
+				Description:      "Minimum time interval for re-sending a notification if an alert is still firing. Default is 4 hours.",
 			},
 		},
 	}
@@ -179,12 +193,15 @@ func policySchema(depth uint) *schema.Resource {
 }
 
 func readNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta) // TODO: Support org-scoped policies
+	client := OAPIGlobalClient(ctx, meta) // TODO: Support org-scoped policies
 
 	resp, err := client.Provisioning.GetPolicyTree()
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if ignoreUIChanges(data, string(resp.Payload.Provenance)) {
+		return nil
+	}
 
 	packNotifPolicy(resp.Payload, data)
 	data.SetId(PolicySingletonID)
@@ -192,7 +209,7 @@ func readNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 }
 
 func putNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta) // TODO: Support org-scoped policies
+	client := OAPIGlobalClient(ctx, meta) // TODO: Support org-scoped policies
 
 	npt, err := unpackNotifPolicy(data)
 	if err != nil {
@@ -205,16 +222,17 @@ func putNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 		params.SetXDisableProvenance(&disabled)
 	}
 
-	if _, err := client.Provisioning.PutPolicyTree(params); err != nil {
+	if err := putPolicyTree(ctx, client, params); err != nil {
 		return diag.FromErr(err)
 	}
 
 	data.SetId(PolicySingletonID)
-	return readNotificationPolicy(ctx, data, meta)
+	diags := largeNotificationPolicyWarnings(data)
+	return append(diags, readNotificationPolicy(ctx, data, meta)...)
 }
 
 func deleteNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta) // TODO: Support org-scoped policies
+	client := OAPIGlobalClient(ctx, meta) // TODO: Support org-scoped policies
 
 	if _, err := client.Provisioning.ResetPolicyTree(); err != nil {
 		return diag.FromErr(err)
@@ -223,6 +241,41 @@ func deleteNotificationPolicy(ctx context.Context, data *schema.ResourceData, me
 	return diag.Diagnostics{}
 }
 
+// validateNotificationPolicyMatchers compiles the regular expressions of the regex matchers of the policy tree,
+// so that invalid ones are reported at plan time, with the offending policy and label, rather than rejected by Grafana at apply time.
+func validateNotificationPolicyMatchers(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validatePolicyMatchers("policy", d.Get("policy").([]interface{}))
+}
+
+func validatePolicyMatchers(path string, policies []interface{}) error {
+	for i, p := range policies {
+		policy, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		policyPath := fmt.Sprintf("%s.%d", path, i)
+		if matchers, ok := policy["matcher"].(*schema.Set); ok {
+			for _, m := range matchers.List() {
+				matcher := m.(map[string]interface{})
+				match, _ := matcher["match"].(string)
+				if match != "=~" && match != "!~" {
+					continue
+				}
+				// Alertmanager anchors the regular expressions of matchers
+				if _, err := regexp.Compile("^(?:" + matcher["value"].(string) + ")$"); err != nil {
+					return fmt.Errorf("%s: the matcher on label %q has an invalid regular expression %q: %w", policyPath, matcher["label"], matcher["value"], err)
+				}
+			}
+		}
+		if nested, ok := policy["policy"].([]interface{}); ok {
+			if err := validatePolicyMatchers(policyPath+".policy", nested); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func packNotifPolicy(npt *models.Route, data *schema.ResourceData) {
 	data.Set("disable_provenance", npt.Provenance == "")
 	data.Set("contact_point", npt.Receiver)
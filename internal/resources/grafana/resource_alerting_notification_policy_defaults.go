@@ -0,0 +1,125 @@
+package grafana
+
+import (
+	"context"
+
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+const PolicyDefaultsSingletonID = "policy_defaults"
+
+// The contact point and grouping of the default notification policy of a new Grafana organization.
+const defaultPolicyContactPoint = "grafana-default-email"
+
+var defaultPolicyGroupBy = []string{"grafana_folder", "alertname"}
+
+func ResourceNotificationPolicyDefaults() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Sets the default contact point and grouping of the notification policy tree, without managing the nested policies.
+
+Alert rules that use simplified routing send their notifications to a contact point selected in the rule, with the grouping of the default policy unless the rule overrides it.
+This resource allows managing these defaults with Terraform, while the nested policies are managed elsewhere (e.g. in the UI).
+The notification policy tree is updated without provenance, so that it stays editable in the UI.
+
+!> This resource must not be used together with the ` + "`grafana_notification_policy`" + ` resource, which manages the entire notification policy tree.
+
+On deletion, the grouping is reset to Grafana's default, and so is the default contact point if the ` + "`grafana-default-email`" + ` contact point still exists.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/manage-notifications/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/)
+
+This resource requires Grafana 9.1.0 or later.
+`,
+
+		CreateContext: common.WithAlertingMutex[schema.CreateContextFunc](putNotificationPolicyDefaults),
+		ReadContext:   readNotificationPolicyDefaults,
+		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](putNotificationPolicyDefaults),
+		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteNotificationPolicyDefaults),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"contact_point": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The default contact point to route all unmatched notifications to.",
+			},
+			"group_by": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "A list of alert labels to group alerts into notifications by. Use the special label `...` to group alerts by all labels, effectively disabling grouping.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func readNotificationPolicyDefaults(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := OAPIGlobalClient(ctx, meta) // TODO: Support org-scoped policies
+
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("contact_point", resp.Payload.Receiver)
+	data.Set("group_by", resp.Payload.GroupBy)
+	data.SetId(PolicyDefaultsSingletonID)
+	return nil
+}
+
+func putNotificationPolicyDefaults(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupBy := common.ListToStringSlice(data.Get("group_by").([]interface{}))
+	if err := updateNotificationPolicyDefaults(ctx, meta, data.Get("contact_point").(string), groupBy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(PolicyDefaultsSingletonID)
+	return readNotificationPolicyDefaults(ctx, data, meta)
+}
+
+func deleteNotificationPolicyDefaults(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := OAPIGlobalClient(ctx, meta) // TODO: Support org-scoped policies
+
+	// The tree must have a default contact point, so it's only reset if Grafana's default contact point still exists
+	contactPoint := ""
+	name := defaultPolicyContactPoint
+	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams().WithName(&name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(resp.Payload) > 0 {
+		contactPoint = defaultPolicyContactPoint
+	}
+
+	return diag.FromErr(updateNotificationPolicyDefaults(ctx, meta, contactPoint, defaultPolicyGroupBy))
+}
+
+// updateNotificationPolicyDefaults updates the root of the notification policy tree, keeping the nested policies.
+// The contact point is kept when empty. The provenance of the tree is disabled, so that the nested policies stay editable in the UI.
+func updateNotificationPolicyDefaults(ctx context.Context, meta interface{}, contactPoint string, groupBy []string) error {
+	client := OAPIGlobalClient(ctx, meta) // TODO: Support org-scoped policies
+
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return err
+	}
+	tree := resp.Payload
+	if contactPoint != "" {
+		tree.Receiver = contactPoint
+	}
+	tree.GroupBy = groupBy
+
+	disabled := "disabled"
+	return putPolicyTree(ctx, client, provisioning.NewPutPolicyTreeParams().WithBody(tree).WithXDisableProvenance(&disabled))
+}
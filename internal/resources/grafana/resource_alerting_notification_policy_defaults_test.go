@@ -0,0 +1,73 @@
+package grafana_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccNotificationPolicyDefaults_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	// TODO: Make parallizable
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_notification_policy_defaults/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_notification_policy_defaults.defaults", "id", "policy_defaults"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_defaults.defaults", "contact_point", "A Contact Point"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_defaults.defaults", "group_by.#", "3"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_defaults.defaults", "group_by.2", "team"),
+					// The tree stays editable in the UI
+					func(s *terraform.State) error {
+						resp, err := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta()).Provisioning.GetPolicyTree()
+						if err != nil {
+							return err
+						}
+						if resp.Payload.Provenance != "" {
+							return fmt.Errorf("expected the notification policy tree to have no provenance, got %q", resp.Payload.Provenance)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "grafana_notification_policy_defaults.defaults",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNotificationPolicyDefaults([]string{"..."}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_notification_policy_defaults.defaults", "group_by.#", "1"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_defaults.defaults", "group_by.0", "..."),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationPolicyDefaults(groupBy []string) string {
+	return fmt.Sprintf(`
+	resource "grafana_contact_point" "a_contact_point" {
+		name = "A Contact Point"
+
+		email {
+		  addresses = ["one@company.org"]
+		}
+	  }
+
+	resource "grafana_notification_policy_defaults" "defaults" {
+		contact_point = grafana_contact_point.a_contact_point.name
+		group_by      = %q
+	  }
+	`, groupBy)
+}
@@ -1,12 +1,16 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
 	"github.com/grafana/terraform-provider-grafana/internal/testutils"
 )
 
@@ -84,6 +88,20 @@ func TestAccNotificationPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_notification_policy.my_notification_policy", "group_by.0", "alertname"),
 				),
 			},
+			// Invalid durations are rejected at plan time.
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_notification_policy/resource.tf", map[string]string{
+					`repeat_interval = "3h"`: `repeat_interval = "3 hours"`,
+				}),
+				ExpectError: regexp.MustCompile(`"3 hours" is not a valid duration`),
+			},
+			// Invalid regex matchers are rejected at plan time, with the offending label.
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_notification_policy/resource.tf", map[string]string{
+					"another value.*": "another value.*(",
+				}),
+				ExpectError: regexp.MustCompile(`policy.1: the matcher on label "anotherlabel" has an invalid regular expression`),
+			},
 		},
 	})
 }
@@ -139,6 +157,70 @@ func TestAccNotificationPolicy_disableProvenance(t *testing.T) {
 	})
 }
 
+func TestAccNotificationPolicy_ignoreUIChanges(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var policy models.Route
+	client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
+
+	// TODO: Make parallizable
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingNotificationPolicyCheckExists.destroyed(&policy, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationPolicyIgnoreUIChanges(true),
+				Check: resource.ComposeTestCheckFunc(
+					alertingNotificationPolicyCheckExists.exists("grafana_notification_policy.test", &policy),
+					resource.TestCheckResourceAttr("grafana_notification_policy.test", "ignore_ui_changes", "true"),
+				),
+			},
+			{
+				// Simulate an edit in the UI, it must not be reported as drift
+				PreConfig: func() {
+					edited := policy
+					edited.GroupBy = []string{"edited"}
+					disabled := "disabled"
+					params := provisioning.NewPutPolicyTreeParams().WithBody(&edited).WithXDisableProvenance(&disabled)
+					if _, err := client.Provisioning.PutPolicyTree(params); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccNotificationPolicyIgnoreUIChanges(true),
+				PlanOnly: true,
+			},
+			{
+				// The edit is detected once the changes aren't ignored anymore
+				Config: testAccNotificationPolicyIgnoreUIChanges(false),
+				Check: resource.ComposeTestCheckFunc(
+					alertingNotificationPolicyCheckExists.exists("grafana_notification_policy.test", &policy),
+					resource.TestCheckResourceAttr("grafana_notification_policy.test", "ignore_ui_changes", "false"),
+					resource.TestCheckResourceAttr("grafana_notification_policy.test", "group_by.0", "hello"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationPolicyIgnoreUIChanges(ignore bool) string {
+	return fmt.Sprintf(`
+	resource "grafana_contact_point" "a_contact_point" {
+		name = "A Contact Point"
+
+		email {
+		  addresses = ["one@company.org"]
+		}
+	  }
+
+	resource "grafana_notification_policy" "test" {
+		group_by           = ["hello"]
+		contact_point      = grafana_contact_point.a_contact_point.name
+		disable_provenance = true
+		ignore_ui_changes  = %t
+	  }
+	`, ignore)
+}
+
 func testAccNotificationPolicyDisableProvenance(disableProvenance bool) string {
 	return fmt.Sprintf(`
 	resource "grafana_contact_point" "a_contact_point" {
@@ -3,21 +3,32 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"text/template/parse"
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// alertRuleMaxTitleLength is the maximum length of the titles of alert rules and rule groups accepted by Grafana.
+const alertRuleMaxTitleLength = 190
+
+var prometheusLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 func ResourceRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: `
@@ -32,18 +43,21 @@ This resource requires Grafana 9.1.0 or later.
 		ReadContext:   readAlertRuleGroup,
 		UpdateContext: putAlertRuleGroup,
 		DeleteContext: deleteAlertRuleGroup,
+		CustomizeDiff: customdiff.All(validateRuleGroupInterval, validateRuleGroupDuplicateTitles, common.CustomizeDiffDefaultLabels),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
-			"org_id": orgIDAttribute(),
+			"org_id":                             orgIDAttribute(),
+			common.AppliedDefaultLabelsAttribute: common.AppliedDefaultLabelsSchema(),
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The name of the rule group.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, alertRuleMaxTitleLength),
+				Description:  "The name of the rule group.",
 			},
 			"folder_uid": {
 				Type:        schema.TypeString,
@@ -51,10 +65,12 @@ This resource requires Grafana 9.1.0 or later.
 				ForceNew:    true,
 				Description: "The UID of the folder that the group belongs to.",
 			},
+			"create_folder_if_missing": createFolderIfMissingAttribute("folder_uid"),
+			"create_folder_title":      createFolderTitleAttribute(),
 			"interval_seconds": {
 				Type:        schema.TypeInt,
 				Required:    true,
-				Description: "The interval, in seconds, at which all rules in the group are evaluated. If a group contains many rules, the rules are evaluated sequentially.",
+				Description: "The interval, in seconds, at which all rules in the group are evaluated. If a group contains many rules, the rules are evaluated sequentially. It must be a multiple of the base evaluation interval of the Grafana instance (`base_interval` in the `unified_alerting` settings, 10 seconds by default).",
 			},
 			"disable_provenance": {
 				Type:        schema.TypeBool,
@@ -62,6 +78,17 @@ This resource requires Grafana 9.1.0 or later.
 				Default:     false,
 				Description: "Allow modifying the rule group from other sources than Terraform or the Grafana API.",
 			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Default:  map[string]interface{}{},
+				Description: "Key-value pairs attached to all the rules of the group. They are merged with the labels of each rule, which take precedence over the group labels. " +
+					"The alerting provisioning API has no group labels, so the labels are merged by the provider and each rule is saved with the merged labels.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validateRuleLabelsOrAnnotations(false),
+			},
 			"rule": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -75,9 +102,10 @@ This resource requires Grafana 9.1.0 or later.
 							Description: "The unique identifier of the alert rule.",
 						},
 						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The name of the alert rule.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, alertRuleMaxTitleLength),
+							Description:  "The name of the alert rule.",
 						},
 						"for": {
 							Type:             schema.TypeString,
@@ -104,10 +132,14 @@ This resource requires Grafana 9.1.0 or later.
 							Description: "Describes what state to enter when the rule's query is invalid and the rule cannot be executed. Options are OK, Error, and Alerting.",
 						},
 						"condition": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The `ref_id` of the query node in the `data` field to use as the alert condition.",
+							Type:     schema.TypeString,
+							Optional: true,
+							// Not computed: the plan must drop the condition when the rule moves to a `threshold` block, and the other way around.
+							// CustomizeDiff can't set the attributes of the nested blocks, so the condition generated for a threshold is kept empty instead.
+							Description: "The `ref_id` of the query node in the `data` field to use as the alert condition. Required unless `threshold` is set, it's empty then.",
 						},
+						"threshold": ruleThresholdSchema("A threshold on the result of one of the stages of `data`. " +
+							"The threshold expression stage is generated, with the `" + ruleThresholdRefID + "` ref ID, and used as the alert condition, so `condition` must not be set."),
 						"data": {
 							Type:             schema.TypeList,
 							Required:         true,
@@ -115,16 +147,24 @@ This resource requires Grafana 9.1.0 or later.
 							Description:      "A sequence of stages that describe the contents of the rule.",
 							DiffSuppressFunc: diffSuppressJSON,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
+								Schema: withRuleExpressionSchemas(map[string]*schema.Schema{
 									"ref_id": {
 										Type:        schema.TypeString,
-										Required:    true,
-										Description: "A unique string to identify this query stage within a rule.",
+										Optional:    true,
+										Computed:    true,
+										Description: "A unique string to identify this query stage within a rule. Defaults to the letter of the stage's position in the rule: `A` for the first stage, `B` for the second, and so on.",
+									},
+									"datasource": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: "The ID of the `grafana_data_source` resource being queried, e.g. `grafana_data_source.prometheus.id`. " +
+											"Its UID is set as `datasource_uid`, which is ignored when this is set, and the `datasource` of the `model`, if any, must match its type and UID.",
 									},
 									"datasource_uid": {
 										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The UID of the datasource being queried, or \"-100\" if this stage is an expression stage.",
+										Optional:    true,
+										Computed:    true,
+										Description: "The UID of the datasource being queried, or \"-100\" if this stage is an expression stage. Required unless an expression block or `datasource` is set.",
 									},
 									"query_type": {
 										Type:        schema.TypeString,
@@ -133,16 +173,18 @@ This resource requires Grafana 9.1.0 or later.
 										Description: "An optional identifier for the type of query being executed.",
 									},
 									"model": {
-										Required:     true,
+										Optional:     true,
+										Computed:     true,
 										Type:         schema.TypeString,
-										Description:  "Custom JSON data to send to the specified datasource when querying.",
+										Description:  "Custom JSON data to send to the specified datasource when querying. Required unless an expression block is set, in which case it is generated.",
 										ValidateFunc: validation.StringIsJSON,
 										StateFunc:    normalizeModelJSON,
 									},
 									"relative_time_range": {
 										Type:        schema.TypeList,
-										Required:    true,
-										Description: "The time range, relative to when the query is executed, across which to query.",
+										Optional:    true,
+										Computed:    true,
+										Description: "The time range, relative to when the query is executed, across which to query. Required unless an expression block is set.",
 										MaxItems:    1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
@@ -159,26 +201,28 @@ This resource requires Grafana 9.1.0 or later.
 											},
 										},
 									},
-								},
+								}),
 							},
 						},
 						"labels": {
 							Type:        schema.TypeMap,
 							Optional:    true,
 							Default:     map[string]interface{}{},
-							Description: "Key-value pairs to attach to the alert rule that can be used in matching, grouping, and routing.",
+							Description: "Key-value pairs to attach to the alert rule that can be used in matching, grouping, and routing. The keys must be valid Prometheus label names, and the values may be templates.",
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
+							ValidateDiagFunc: validateRuleLabelsOrAnnotations(false),
 						},
 						"annotations": {
 							Type:        schema.TypeMap,
 							Optional:    true,
 							Default:     map[string]interface{}{},
-							Description: "Key-value pairs of metadata to attach to the alert rule that may add user-defined context, but cannot be used for matching, grouping, or routing.",
+							Description: "Key-value pairs of metadata to attach to the alert rule that may add user-defined context, but cannot be used for matching, grouping, or routing. The values may be templates.",
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
+							ValidateDiagFunc: validateRuleLabelsOrAnnotations(true),
 						},
 						"is_paused": {
 							Type:        schema.TypeBool,
@@ -194,7 +238,7 @@ This resource requires Grafana 9.1.0 or later.
 }
 
 func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	key := UnpackGroupID(idStr)
 
@@ -208,6 +252,20 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta int
 	data.Set("folder_uid", g.FolderUID)
 	data.Set("interval_seconds", g.Interval)
 	disableProvenance := true
+	groupLabels := unpackMap(data.Get("labels"))
+	// The group labels are only kept in the state if all the rules still have them, so that a label removed from a rule outside of Terraform shows a diff
+	presentGroupLabels := groupLabels
+	priorLabels := map[string]map[string]string{}
+	thresholdRules := map[string]bool{}
+	expressionStages := map[string]map[string]string{}
+	stageDataSources := map[string]map[string]string{}
+	for _, r := range data.Get("rule").([]interface{}) {
+		r := r.(map[string]interface{})
+		priorLabels[r["name"].(string)] = unpackMap(r["labels"])
+		thresholdRules[r["name"].(string)] = len(r["threshold"].([]interface{})) > 0
+		expressionStages[r["name"].(string)] = ruleExpressionStages(r["data"])
+		stageDataSources[r["name"].(string)] = ruleStageDataSources(r["data"])
+	}
 	rules := make([]interface{}, 0, len(g.Rules))
 	for _, r := range g.Rules {
 		ruleResp, err := client.Provisioning.GetAlertRule(r.UID) // We need to get the rule through a separate API call to get the provenance.
@@ -215,8 +273,11 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta int
 			return diag.FromErr(err)
 		}
 		r := ruleResp.Payload
+		presentGroupLabels = groupLabelsInRule(presentGroupLabels, r.Labels, priorLabels[*r.Title])
+		r.Labels = withoutGroupLabels(r.Labels, groupLabels, priorLabels[*r.Title])
+		r.Labels = meta.(*common.Client).WithoutDefaultLabels(r.Labels, priorLabels[*r.Title])
 		data.Set("org_id", strconv.FormatInt(*r.OrgID, 10))
-		packed, err := packAlertRule(r)
+		packed, err := packAlertRule(r, thresholdRules[*r.Title], expressionStages[*r.Title], stageDataSources[*r.Title])
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -226,6 +287,7 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta int
 		rules = append(rules, packed)
 	}
 	data.Set("disable_provenance", disableProvenance)
+	data.Set("labels", presentGroupLabels)
 	data.Set("rule", rules)
 	data.SetId(MakeOrgResourceID(orgID, packGroupID(key)))
 
@@ -233,19 +295,25 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta int
 }
 
 func putAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
 
 	group := data.Get("name").(string)
 	folder := data.Get("folder_uid").(string)
 	interval := data.Get("interval_seconds").(int)
 
+	if err := ensureFolderExists(client, data, folder); err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupLabels := unpackMap(data.Get("labels"))
 	packedRules := data.Get("rule").([]interface{})
 	rules := make([]*models.ProvisionedAlertRule, 0, len(packedRules))
 	for i := range packedRules {
-		rule, err := unpackAlertRule(packedRules[i], group, folder, orgID)
+		rule, err := unpackAlertRule(packedRules[i], group, folder, orgID, ruleDataSourceResolver(client, orgID))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		rule.Labels = meta.(*common.Client).WithDefaultLabels(withGroupLabels(rule.Labels, groupLabels))
 		rules = append(rules, rule)
 	}
 
@@ -270,11 +338,12 @@ func putAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta inte
 
 	key := packGroupID(AlertRuleGroupKey{resp.Payload.FolderUID, resp.Payload.Title})
 	data.SetId(MakeOrgResourceID(orgID, key))
-	return readAlertRuleGroup(ctx, data, meta)
+	meta.(*common.Client).SetAppliedDefaultLabels(data)
+	return append(ruleGroupDuplicateTitlesWarnings(data, meta), readAlertRuleGroup(ctx, data, meta)...)
 }
 
 func deleteAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	key := UnpackGroupID(idStr)
 
@@ -294,6 +363,156 @@ func deleteAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta i
 	return nil
 }
 
+// validateRuleGroupInterval checks the group interval against the evaluation intervals configured in Grafana.
+// Grafana would otherwise round the interval, which results in a permanent diff.
+// The check is skipped if the settings can't be read (they require the server admin role).
+func validateRuleGroupInterval(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Unknown intervals, e.g. from other resources, are validated by the API when applied
+	if (d.Id() != "" && !d.HasChange("interval_seconds")) || !d.NewValueKnown("interval_seconds") {
+		return nil
+	}
+	client, ok := meta.(*common.Client)
+	if !ok || client.GrafanaOAPI == nil {
+		return nil
+	}
+
+	intervals, err := client.GrafanaAlertingIntervals()
+	if err != nil {
+		log.Printf("[WARN] skipping the validation of the rule group interval: %v", err)
+		return nil
+	}
+
+	interval := time.Duration(d.Get("interval_seconds").(int)) * time.Second
+	if interval < intervals.Min {
+		return fmt.Errorf("interval_seconds (%s) must be at least the minimum evaluation interval of the Grafana instance (%s)", interval, intervals.Min)
+	}
+	if intervals.Base > 0 && interval%intervals.Base != 0 {
+		return fmt.Errorf("interval_seconds (%s) must be a multiple of the base evaluation interval of the Grafana instance (%s)", interval, intervals.Base)
+	}
+	return nil
+}
+
+// validateRuleGroupDuplicateTitles checks that the titles of the rules aren't used by other rules of the group,
+// or by the rules of other rule groups of the folder planned in the same run.
+func validateRuleGroupDuplicateTitles(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*common.Client)
+	if !ok {
+		return nil
+	}
+
+	duplicates := ruleGroupDuplicateTitles(client, d.Get("org_id").(string), d.Get("folder_uid").(string), d.Get("name").(string), d.Get("rule").([]interface{}))
+	if len(duplicates) == 0 {
+		return nil
+	}
+	if client.DuplicateRuleTitles == common.DuplicateRuleTitlesWarn {
+		logPlanWarning("the rule group has duplicate rule titles: %s", strings.Join(duplicates, "; "))
+		return nil
+	}
+	return fmt.Errorf("the rule group has duplicate rule titles: %s", strings.Join(duplicates, "; "))
+}
+
+// ruleGroupDuplicateTitlesWarnings returns a warning listing the duplicate rule titles of the group, if the provider is in `warn` mode.
+func ruleGroupDuplicateTitlesWarnings(data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := meta.(*common.Client)
+	if !ok || client.DuplicateRuleTitles != common.DuplicateRuleTitlesWarn {
+		return nil
+	}
+
+	duplicates := ruleGroupDuplicateTitles(client, data.Get("org_id").(string), data.Get("folder_uid").(string), data.Get("name").(string), data.Get("rule").([]interface{}))
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "The rule group has duplicate rule titles",
+		Detail:   strings.Join(duplicates, "\n"),
+	}}
+}
+
+// ruleGroupDuplicateTitles lists the rule titles of the group that are used twice in the group, or that were claimed by another group of the folder in this run.
+// Titles that aren't known yet are skipped, and so are the other groups if the folder isn't known yet.
+func ruleGroupDuplicateTitles(client *common.Client, orgID, folderUID, group string, rules []interface{}) []string {
+
+	var duplicates []string
+	var titles []string
+	seen := map[string]bool{}
+	for _, r := range rules {
+		title := r.(map[string]interface{})["name"].(string)
+		if title == "" {
+			continue
+		}
+		if seen[title] {
+			duplicates = append(duplicates, fmt.Sprintf("rule %q is defined twice in the group", title))
+			continue
+		}
+		seen[title] = true
+		titles = append(titles, title)
+	}
+
+	if folderUID == "" {
+		return duplicates
+	}
+	claimed := client.ClaimRuleTitles(orgID, folderUID, group, titles)
+	for _, title := range titles {
+		if other, ok := claimed[title]; ok {
+			duplicates = append(duplicates, fmt.Sprintf("rule %q is also defined in rule group %q of the folder", title, other))
+		}
+	}
+	return duplicates
+}
+
+// validateRuleLabelsOrAnnotations checks that the keys of rule labels are valid Prometheus label names,
+// and that the values of labels or annotations are valid templates, so that the errors are reported by the plan rather than when the rule is evaluated.
+// Annotation keys are free-form, and some start with `__`, e.g. `__dashboardUid__`, which is reserved for labels.
+func validateRuleLabelsOrAnnotations(annotations bool) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+		for key, v := range i.(map[string]interface{}) {
+			switch {
+			case annotations:
+				// Annotation keys aren't label names
+			case !prometheusLabelNameRegexp.MatchString(key):
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       fmt.Sprintf("invalid key %q", key),
+					Detail:        "Label names must match " + prometheusLabelNameRegexp.String(),
+					AttributePath: path,
+				})
+			case strings.HasPrefix(key, "__"):
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       fmt.Sprintf("invalid key %q", key),
+					Detail:        "Label names starting with __ are reserved for internal use",
+					AttributePath: path,
+				})
+			}
+			if value, _ := v.(string); value != "" {
+				if err := validateRuleTemplate(key, value); err != nil {
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Error,
+						Summary:       fmt.Sprintf("invalid template in the value of %q", key),
+						Detail:        err.Error(),
+						AttributePath: path,
+					})
+				}
+			}
+		}
+		return diags
+	}
+}
+
+// validateRuleTemplate parses a label or annotation template the way Grafana does, with the `$labels`, `$values` and `$value` variables defined.
+// Functions aren't checked, as they depend on the Grafana version.
+func validateRuleTemplate(name, text string) error {
+	if !strings.Contains(text, "{{") {
+		return nil
+	}
+	tree := parse.New(name)
+	tree.Mode = parse.SkipFuncCheck
+	_, err := tree.Parse("{{$labels := .Labels}}{{$values := .Values}}{{$value := .Value}}"+text, "{{", "}}", map[string]*parse.Tree{})
+	return err
+}
+
 func diffSuppressJSON(k, oldValue, newValue string, data *schema.ResourceData) bool {
 	var o, n interface{}
 	d := json.NewDecoder(strings.NewReader(oldValue))
@@ -307,29 +526,49 @@ func diffSuppressJSON(k, oldValue, newValue string, data *schema.ResourceData) b
 	return reflect.DeepEqual(o, n)
 }
 
-func packAlertRule(r *models.ProvisionedAlertRule) (interface{}, error) {
-	data, err := packRuleData(r.Data)
+// packAlertRule packs the rule into the schema format.
+// If the rule is managed with a threshold block, its generated threshold stage is packed as that block rather than as a data stage.
+// The stages managed with expression blocks (mapping their ref ID to the block type) are packed as these blocks, along with their model.
+func packAlertRule(r *models.ProvisionedAlertRule, withThreshold bool, expressionStages, stageDataSources map[string]string) (interface{}, error) {
+	queries := r.Data
+	threshold := []interface{}{}
+	if withThreshold {
+		queries = make([]*models.AlertQuery, 0, len(r.Data))
+		for _, q := range r.Data {
+			if packed := packRuleThreshold(q); packed != nil {
+				threshold = append(threshold, packed)
+				continue
+			}
+			queries = append(queries, q)
+		}
+	}
+	data, err := packRuleData(queries, expressionStages, stageDataSources)
 	if err != nil {
 		return nil, err
 	}
+	condition := r.Condition
+	if len(threshold) > 0 && condition != nil && *condition == ruleThresholdRefID {
+		condition = common.Ref("")
+	}
 	json := map[string]interface{}{
 		"uid":            r.UID,
 		"name":           r.Title,
 		"for":            r.For.String(),
 		"no_data_state":  *r.NoDataState,
 		"exec_err_state": *r.ExecErrState,
-		"condition":      r.Condition,
+		"condition":      condition,
 		"labels":         r.Labels,
 		"annotations":    r.Annotations,
 		"data":           data,
+		"threshold":      threshold,
 		"is_paused":      r.IsPaused,
 	}
 	return json, nil
 }
 
-func unpackAlertRule(raw interface{}, groupName string, folderUID string, orgID int64) (*models.ProvisionedAlertRule, error) {
+func unpackAlertRule(raw interface{}, groupName string, folderUID string, orgID int64, resolveDataSource ruleDataSourceResolverFunc) (*models.ProvisionedAlertRule, error) {
 	json := raw.(map[string]interface{})
-	data, err := unpackRuleData(json["data"])
+	data, err := unpackRuleData(json["data"], resolveDataSource)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +578,26 @@ func unpackAlertRule(raw interface{}, groupName string, folderUID string, orgID
 		return nil, err
 	}
 
+	condition := json["condition"].(string)
+	if threshold := json["threshold"].([]interface{}); len(threshold) > 0 && threshold[0] != nil {
+		if condition != "" {
+			return nil, fmt.Errorf("rule %q: `condition` can't be set along with `threshold`", json["name"])
+		}
+		for _, stage := range data {
+			if stage.RefID == ruleThresholdRefID {
+				return nil, fmt.Errorf("rule %q: the `%s` ref ID is reserved for the generated threshold stage", json["name"], ruleThresholdRefID)
+			}
+		}
+		stage, err := unpackRuleThreshold(threshold[0].(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", json["name"], err)
+		}
+		data = append(data, stage)
+		condition = ruleThresholdRefID
+	} else if condition == "" {
+		return nil, fmt.Errorf("rule %q: `condition` is required unless `threshold` is set", json["name"])
+	}
+
 	rule := models.ProvisionedAlertRule{
 		UID:          json["uid"].(string),
 		Title:        common.Ref(json["name"].(string)),
@@ -349,7 +608,7 @@ func unpackAlertRule(raw interface{}, groupName string, folderUID string, orgID
 		NoDataState:  common.Ref(json["no_data_state"].(string)),
 		For:          common.Ref(strfmt.Duration(forDuration)),
 		Data:         data,
-		Condition:    common.Ref(json["condition"].(string)),
+		Condition:    common.Ref(condition),
 		Labels:       unpackMap(json["labels"]),
 		Annotations:  unpackMap(json["annotations"]),
 		IsPaused:     json["is_paused"].(bool),
@@ -358,7 +617,7 @@ func unpackAlertRule(raw interface{}, groupName string, folderUID string, orgID
 	return &rule, nil
 }
 
-func packRuleData(queries []*models.AlertQuery) (interface{}, error) {
+func packRuleData(queries []*models.AlertQuery, expressionStages, stageDataSources map[string]string) (interface{}, error) {
 	result := []interface{}{}
 	for i := range queries {
 		if queries[i] == nil {
@@ -372,6 +631,7 @@ func packRuleData(queries []*models.AlertQuery) (interface{}, error) {
 
 		data := map[string]interface{}{}
 		data["ref_id"] = queries[i].RefID
+		data["datasource"] = stageDataSources[queries[i].RefID]
 		data["datasource_uid"] = queries[i].DatasourceUID
 		data["query_type"] = queries[i].QueryType
 		timeRange := map[string]int{}
@@ -379,23 +639,34 @@ func packRuleData(queries []*models.AlertQuery) (interface{}, error) {
 		timeRange["to"] = int(queries[i].RelativeTimeRange.To)
 		data["relative_time_range"] = []interface{}{timeRange}
 		data["model"] = normalizeModelJSON(string(model))
+		if exprType, ok := expressionStages[queries[i].RefID]; ok {
+			if block, ok := packRuleExpression(exprType, queries[i]); ok {
+				data[exprType] = []interface{}{block}
+			}
+		}
 		result = append(result, data)
 	}
 	return result, nil
 }
 
-func unpackRuleData(raw interface{}) ([]*models.AlertQuery, error) {
+func unpackRuleData(raw interface{}, resolveDataSource ruleDataSourceResolverFunc) ([]*models.AlertQuery, error) {
 	rows := raw.([]interface{})
 	result := make([]*models.AlertQuery, 0, len(rows))
+	refIDs := map[string]bool{}
 	for i := range rows {
 		row := rows[i].(map[string]interface{})
 
 		stage := &models.AlertQuery{
-			RefID:         row["ref_id"].(string),
-			QueryType:     row["query_type"].(string),
-			DatasourceUID: row["datasource_uid"].(string),
+			RefID:             ruleStageRefID(row, i),
+			QueryType:         row["query_type"].(string),
+			DatasourceUID:     row["datasource_uid"].(string),
+			RelativeTimeRange: &models.RelativeTimeRange{},
+		}
+		if refIDs[stage.RefID] {
+			return nil, fmt.Errorf("stage %q: the ref ID is used by several stages", stage.RefID)
 		}
-		if rtr, ok := row["relative_time_range"]; ok {
+		refIDs[stage.RefID] = true
+		if rtr, ok := row["relative_time_range"]; ok && len(rtr.([]interface{})) > 0 && rtr.([]interface{})[0] != nil {
 			listShim := rtr.([]interface{})
 			rtr := listShim[0].(map[string]interface{})
 			stage.RelativeTimeRange = &models.RelativeTimeRange{
@@ -403,17 +674,142 @@ func unpackRuleData(raw interface{}) ([]*models.AlertQuery, error) {
 				To:   models.Duration(time.Duration(rtr["to"].(int))),
 			}
 		}
+		exprModel, err := unpackRuleExpression(stage.RefID, row)
+		if err != nil {
+			return nil, err
+		}
+		if exprModel != nil {
+			// The model is generated, the one in the state is ignored since it's only updated on read
+			stage.DatasourceUID = expressionDatasourceUID
+			stage.Model = exprModel
+			result = append(result, stage)
+			continue
+		}
+		dataSourceID, _ := row["datasource"].(string)
+		if (stage.DatasourceUID == "" && dataSourceID == "") || row["model"].(string) == "" || len(row["relative_time_range"].([]interface{})) == 0 {
+			return nil, fmt.Errorf("stage %q: `datasource_uid` or `datasource`, `model` and `relative_time_range` are required unless an expression block is set", stage.RefID)
+		}
+
 		var decodedModelJSON interface{}
-		err := json.Unmarshal([]byte(row["model"].(string)), &decodedModelJSON)
+		err = json.Unmarshal([]byte(row["model"].(string)), &decodedModelJSON)
 		if err != nil {
 			return nil, err
 		}
 		stage.Model = decodedModelJSON
+		if dataSourceID != "" {
+			if stage.DatasourceUID, err = resolveRuleStageDataSource(stage.RefID, dataSourceID, decodedModelJSON, resolveDataSource); err != nil {
+				return nil, err
+			}
+		}
 		result = append(result, stage)
 	}
 	return result, nil
 }
 
+// ruleDataSourceResolverFunc returns the data source with the given ID, the ID of a `grafana_data_source` resource.
+type ruleDataSourceResolverFunc func(id string) (*models.DataSource, error)
+
+// ruleDataSourceResolver returns a resolver of the data sources of the rules of a group, which fetches each data source once.
+func ruleDataSourceResolver(client *goapi.GrafanaHTTPAPI, orgID int64) ruleDataSourceResolverFunc {
+	dataSources := map[string]*models.DataSource{}
+	return func(id string) (*models.DataSource, error) {
+		if ds, ok := dataSources[id]; ok {
+			return ds, nil
+		}
+		dsOrgID, dsID := SplitOrgResourceID(id)
+		if dsOrgID > 0 && dsOrgID != orgID {
+			return nil, fmt.Errorf("data source %s belongs to organization %d, not to the organization of the rule group (%d)", id, dsOrgID, orgID)
+		}
+		resp, err := client.Datasources.GetDataSourceByID(dsID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get data source %s: %w", id, err)
+		}
+		dataSources[id] = resp.Payload
+		return resp.Payload, nil
+	}
+}
+
+// resolveRuleStageDataSource returns the UID of the data source of a rule data stage set by its `datasource` attribute,
+// after checking that the `datasource` of the stage's model, if any, refers to the same data source.
+func resolveRuleStageDataSource(refID, id string, model interface{}, resolve ruleDataSourceResolverFunc) (string, error) {
+	ds, err := resolve(id)
+	if err != nil {
+		return "", fmt.Errorf("stage %q: %w", refID, err)
+	}
+	modelMap, _ := model.(map[string]interface{})
+	modelDataSource, _ := modelMap["datasource"].(map[string]interface{})
+	if modelType, _ := modelDataSource["type"].(string); modelType != "" && modelType != ds.Type {
+		return "", fmt.Errorf("stage %q: the model queries a %q data source, but data source %q is of type %q", refID, modelType, ds.Name, ds.Type)
+	}
+	if modelUID, _ := modelDataSource["uid"].(string); modelUID != "" && modelUID != ds.UID {
+		return "", fmt.Errorf("stage %q: the model queries the data source with UID %q, but data source %q has UID %q", refID, modelUID, ds.Name, ds.UID)
+	}
+	return ds.UID, nil
+}
+
+// ruleStageRefID returns the ref ID of the i-th data stage of a rule, which defaults to the letter of its position.
+func ruleStageRefID(row map[string]interface{}, i int) string {
+	if refID, _ := row["ref_id"].(string); refID != "" {
+		return refID
+	}
+	return defaultRuleStageRefID(i)
+}
+
+// defaultRuleStageRefID returns the default ref ID of the i-th data stage of a rule, like the ref IDs of the Grafana UI: `A` to `Z`, then `AA`, `AB`, and so on.
+func defaultRuleStageRefID(i int) string {
+	refID := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		refID = string(rune('A'+(i-1)%26)) + refID
+	}
+	return refID
+}
+
+// ruleStageDataSources maps the ref IDs of the data stages of a rule that have a `datasource` attribute to its value.
+func ruleStageDataSources(raw interface{}) map[string]string {
+	dataSources := map[string]string{}
+	rows, _ := raw.([]interface{})
+	for i, r := range rows {
+		row, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, _ := row["datasource"].(string); id != "" {
+			dataSources[ruleStageRefID(row, i)] = id
+		}
+	}
+	return dataSources
+}
+
+// ruleThresholdRefID is the ref ID of the threshold expression stage generated from the `threshold` block of a rule.
+const ruleThresholdRefID = "THRESHOLD"
+
+// unpackRuleThreshold generates the threshold expression stage of the `threshold` block of a rule, like the `threshold` block of a stage.
+func unpackRuleThreshold(threshold map[string]interface{}) (*models.AlertQuery, error) {
+	model, err := unpackRuleExpression(ruleThresholdRefID, map[string]interface{}{"threshold": []interface{}{threshold}})
+	if err != nil {
+		return nil, err
+	}
+	return &models.AlertQuery{
+		RefID:             ruleThresholdRefID,
+		DatasourceUID:     expressionDatasourceUID,
+		RelativeTimeRange: &models.RelativeTimeRange{},
+		Model:             model,
+	}, nil
+}
+
+// packRuleThreshold packs a threshold expression stage generated by unpackRuleThreshold as a `threshold` block.
+// It returns nil if the stage isn't such a stage.
+func packRuleThreshold(q *models.AlertQuery) map[string]interface{} {
+	if q == nil || q.RefID != ruleThresholdRefID {
+		return nil
+	}
+	block, ok := packRuleExpression("threshold", q)
+	if !ok {
+		return nil
+	}
+	return block
+}
+
 // normalizeModelJSON is the StateFunc for the `model`. It removes well-known default
 // values from the model json, so that users do not see perma-diffs when not specifying
 // the values explicitly in their Terraform.
@@ -457,6 +853,46 @@ func normalizeModelJSON(model interface{}) string {
 	return resultJSON
 }
 
+// withGroupLabels returns the labels of a rule merged with the labels of its group. The labels of the rule take precedence.
+func withGroupLabels(labels map[string]string, groupLabels map[string]string) map[string]string {
+	merged := make(map[string]string, len(groupLabels)+len(labels))
+	for k, v := range groupLabels {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// withoutGroupLabels removes the labels of the group from the labels of a rule read from the API.
+// Labels that are also present in the prior state of the rule are kept.
+func withoutGroupLabels(labels map[string]string, groupLabels map[string]string, prior map[string]string) map[string]string {
+	result := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if groupValue, ok := groupLabels[k]; ok && groupValue == v {
+			if _, inPrior := prior[k]; !inPrior {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// groupLabelsInRule returns the group labels that are set on a rule read from the API.
+// Labels that the rule overrides, i.e. that are present in the prior state of the rule, are kept whatever their value.
+func groupLabelsInRule(groupLabels map[string]string, labels map[string]string, prior map[string]string) map[string]string {
+	result := make(map[string]string, len(groupLabels))
+	for k, v := range groupLabels {
+		_, overridden := prior[k]
+		if value, ok := labels[k]; overridden || (ok && value == v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 func unpackMap(raw interface{}) map[string]string {
 	json := raw.(map[string]interface{})
 	result := map[string]string{}
@@ -0,0 +1,377 @@
+package grafana
+
+import (
+	"fmt"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// expressionDatasourceUID is the UID of the server-side expressions datasource.
+const expressionDatasourceUID = "__expr__"
+
+// ruleExpressionTypes are the typed blocks of rule data stages, named after the type of the server-side expression they generate.
+var ruleExpressionTypes = []string{"math", "reduce", "resample", "threshold", "classic_conditions"}
+
+var thresholdEvaluatorTypes = []string{"gt", "lt", "within_range", "outside_range"}
+
+// withRuleExpressionSchemas adds the typed expression blocks to the schema of a rule data stage.
+func withRuleExpressionSchemas(stageSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range ruleExpressionSchemas() {
+		stageSchema[k] = v
+	}
+	return stageSchema
+}
+
+func ruleExpressionSchemas() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"math": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "A math expression, computed from the results of other stages.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"expression": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The math expression. Stages are referenced by `$` followed by their ref ID, e.g. `$A * 100`.",
+					},
+				},
+			},
+		},
+		"reduce": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "A reduce expression, reducing each series of another stage to a single number.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ref ID of the stage to reduce.",
+					},
+					"function": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"mean", "min", "max", "sum", "count", "last", "median"}, false),
+						Description:  "The reduce function. Can be `mean`, `min`, `max`, `sum`, `count`, `last` or `median`.",
+					},
+					"mode": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "",
+						ValidateFunc: validation.StringInSlice([]string{"", "dropNN", "replaceNN"}, false),
+						Description:  "How non-numeric values are handled. Empty (strict, the result is NaN if any value is not a number), `dropNN` (they are dropped) or `replaceNN` (they are replaced by `replace_with`).",
+					},
+					"replace_with": {
+						Type:        schema.TypeFloat,
+						Optional:    true,
+						Default:     0,
+						Description: "The value replacing non-numeric values, in the `replaceNN` mode.",
+					},
+				},
+			},
+		},
+		"resample": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "A resample expression, aligning the timestamps of the series of another stage.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ref ID of the stage to resample.",
+					},
+					"window": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: common.ValidateDuration,
+						Description:      "The duration between the resampled points, e.g. `10s`.",
+					},
+					"downsampler": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"mean", "min", "max", "sum", "last"}, false),
+						Description:  "The function aggregating the points of a window that has more than one. Can be `mean`, `min`, `max`, `sum` or `last`.",
+					},
+					"upsampler": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"pad", "backfilling", "fillna"}, false),
+						Description:  "How windows without points are filled. Can be `pad` (the last known value), `backfilling` (the next known value) or `fillna` (NaN).",
+					},
+				},
+			},
+		},
+		"threshold": ruleThresholdSchema("A threshold expression, comparing the result of another stage to one or two values."),
+		"classic_conditions": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "A classic condition expression, evaluating conditions on reduced series of other stages.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"condition": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Description: "The conditions, combined with their operators in order.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"query": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The ref ID of the stage the condition applies to.",
+								},
+								"reducer": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice([]string{"avg", "min", "max", "sum", "count", "last", "median", "diff", "diff_abs", "percent_diff", "percent_diff_abs", "count_non_null"}, false),
+									Description:  "The function reducing each series to a single value, e.g. `avg` or `last`.",
+								},
+								"type": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(append([]string{"no_value"}, thresholdEvaluatorTypes...), false),
+									Description:  "The comparison. Can be `gt`, `lt`, `within_range`, `outside_range` or `no_value`.",
+								},
+								"value": {
+									Type:        schema.TypeFloat,
+									Optional:    true,
+									Description: "The value to compare to, or the lower bound of the range. Not used by the `no_value` comparison.",
+								},
+								"upper_value": {
+									Type:        schema.TypeFloat,
+									Optional:    true,
+									Description: "The upper bound of the range. Required for the `within_range` and `outside_range` comparisons.",
+								},
+								"operator": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									Default:      "and",
+									ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+									Description:  "How the condition is combined with the previous ones. Can be `and` or `or`.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ruleThresholdSchema is the schema of the `threshold` blocks, of rules and of their data stages.
+func ruleThresholdSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ref_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The `ref_id` of the stage of `data` to compare. Its result must be a single number per series, e.g. an instant query or a reduce expression.",
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(thresholdEvaluatorTypes, false),
+					Description:  "The comparison. `gt` fires when the result is above the value, `lt` when it is below, `within_range` and `outside_range` when it is within or outside the range from `value` to `upper_value`.",
+				},
+				"value": {
+					Type:        schema.TypeFloat,
+					Required:    true,
+					Description: "The value to compare the result to, or the lower bound of the range.",
+				},
+				"upper_value": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Description: "The upper bound of the range. Required for the `within_range` and `outside_range` comparisons.",
+				},
+			},
+		},
+	}
+}
+
+// ruleExpressionStages maps the ref IDs of the data stages of a rule that have a typed expression block to the block type.
+func ruleExpressionStages(raw interface{}) map[string]string {
+	stages := map[string]string{}
+	rows, _ := raw.([]interface{})
+	for i, r := range rows {
+		row, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, t := range ruleExpressionTypes {
+			if list, ok := row[t].([]interface{}); ok && len(list) > 0 {
+				stages[ruleStageRefID(row, i)] = t
+			}
+		}
+	}
+	return stages
+}
+
+// unpackRuleExpression generates the expression datasource model of a rule data stage that has a typed expression block.
+// It returns a nil model if the stage has none.
+func unpackRuleExpression(refID string, row map[string]interface{}) (map[string]interface{}, error) {
+	var exprType string
+	var block map[string]interface{}
+	for _, t := range ruleExpressionTypes {
+		if list, ok := row[t].([]interface{}); ok && len(list) > 0 && list[0] != nil {
+			if exprType != "" {
+				return nil, fmt.Errorf("stage %q: only one of %v can be set", refID, ruleExpressionTypes)
+			}
+			exprType, block = t, list[0].(map[string]interface{})
+		}
+	}
+	if exprType == "" {
+		return nil, nil
+	}
+
+	model := map[string]interface{}{
+		"refId": refID,
+		"type":  exprType,
+		"datasource": map[string]interface{}{
+			"type": expressionDatasourceUID,
+			"uid":  expressionDatasourceUID,
+		},
+	}
+	switch exprType {
+	case "math":
+		model["expression"] = block["expression"].(string)
+	case "reduce":
+		model["expression"] = block["input"].(string)
+		model["reducer"] = block["function"].(string)
+		if mode := block["mode"].(string); mode != "" {
+			settings := map[string]interface{}{"mode": mode}
+			if mode == "replaceNN" {
+				settings["replaceWithValue"] = block["replace_with"].(float64)
+			}
+			model["settings"] = settings
+		}
+	case "resample":
+		model["expression"] = block["input"].(string)
+		model["window"] = block["window"].(string)
+		model["downsampler"] = block["downsampler"].(string)
+		model["upsampler"] = block["upsampler"].(string)
+	case "threshold":
+		evaluator, err := unpackExpressionEvaluator(block)
+		if err != nil {
+			return nil, fmt.Errorf("stage %q: %w", refID, err)
+		}
+		model["expression"] = block["ref_id"].(string)
+		model["conditions"] = []interface{}{map[string]interface{}{"evaluator": evaluator}}
+	case "classic_conditions":
+		var conditions []interface{}
+		for _, c := range block["condition"].([]interface{}) {
+			condition := c.(map[string]interface{})
+			evaluator, err := unpackExpressionEvaluator(condition)
+			if err != nil {
+				return nil, fmt.Errorf("stage %q: %w", refID, err)
+			}
+			conditions = append(conditions, map[string]interface{}{
+				"type":      "query",
+				"evaluator": evaluator,
+				"operator":  map[string]interface{}{"type": condition["operator"].(string)},
+				"query":     map[string]interface{}{"params": []interface{}{condition["query"].(string)}},
+				"reducer":   map[string]interface{}{"type": condition["reducer"].(string), "params": []interface{}{}},
+			})
+		}
+		model["conditions"] = conditions
+	}
+	return model, nil
+}
+
+func unpackExpressionEvaluator(block map[string]interface{}) (map[string]interface{}, error) {
+	evaluatorType := block["type"].(string)
+	params := []interface{}{}
+	switch evaluatorType {
+	case "no_value":
+	case "within_range", "outside_range":
+		upper, _ := block["upper_value"].(float64)
+		if upper < block["value"].(float64) {
+			return nil, fmt.Errorf("the %s comparison requires an upper_value greater than or equal to value", evaluatorType)
+		}
+		params = append(params, block["value"].(float64), upper)
+	default:
+		params = append(params, block["value"].(float64))
+	}
+	return map[string]interface{}{"type": evaluatorType, "params": params}, nil
+}
+
+// packRuleExpression reads the typed expression block of the given type from the model of a rule data stage.
+// It returns false if the model isn't an expression of that type.
+func packRuleExpression(exprType string, q *models.AlertQuery) (map[string]interface{}, bool) {
+	model, ok := q.Model.(map[string]interface{})
+	if !ok || model["type"] != exprType {
+		return nil, false
+	}
+	expression, _ := model["expression"].(string)
+
+	switch exprType {
+	case "math":
+		return map[string]interface{}{"expression": expression}, true
+	case "reduce":
+		reducer, _ := model["reducer"].(string)
+		settings, _ := model["settings"].(map[string]interface{})
+		mode, _ := settings["mode"].(string)
+		replaceWith, _ := settings["replaceWithValue"].(float64)
+		return map[string]interface{}{"input": expression, "function": reducer, "mode": mode, "replace_with": replaceWith}, true
+	case "resample":
+		window, _ := model["window"].(string)
+		downsampler, _ := model["downsampler"].(string)
+		upsampler, _ := model["upsampler"].(string)
+		return map[string]interface{}{"input": expression, "window": window, "downsampler": downsampler, "upsampler": upsampler}, true
+	case "threshold":
+		conditions, _ := model["conditions"].([]interface{})
+		if len(conditions) != 1 {
+			return nil, false
+		}
+		condition, _ := conditions[0].(map[string]interface{})
+		block := packExpressionEvaluator(condition)
+		block["ref_id"] = expression
+		return block, true
+	case "classic_conditions":
+		var packed []interface{}
+		conditions, _ := model["conditions"].([]interface{})
+		for _, c := range conditions {
+			condition, _ := c.(map[string]interface{})
+			block := packExpressionEvaluator(condition)
+			query, _ := condition["query"].(map[string]interface{})
+			if params, _ := query["params"].([]interface{}); len(params) > 0 {
+				block["query"], _ = params[0].(string)
+			}
+			reducer, _ := condition["reducer"].(map[string]interface{})
+			block["reducer"], _ = reducer["type"].(string)
+			operator, _ := condition["operator"].(map[string]interface{})
+			block["operator"], _ = operator["type"].(string)
+			packed = append(packed, block)
+		}
+		return map[string]interface{}{"condition": packed}, true
+	}
+	return nil, false
+}
+
+func packExpressionEvaluator(condition map[string]interface{}) map[string]interface{} {
+	evaluator, _ := condition["evaluator"].(map[string]interface{})
+	block := map[string]interface{}{}
+	block["type"], _ = evaluator["type"].(string)
+	params, _ := evaluator["params"].([]interface{})
+	if len(params) > 0 {
+		block["value"], _ = params[0].(float64)
+	}
+	if len(params) > 1 {
+		block["upper_value"], _ = params[1].(float64)
+	}
+	return block
+}
@@ -0,0 +1,45 @@
+package grafana
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupLabelsInRule(t *testing.T) {
+	groupLabels := map[string]string{"team": "platform", "env": "dev", "tier": ""}
+
+	for _, tc := range []struct {
+		name     string
+		labels   map[string]string
+		prior    map[string]string
+		expected map[string]string
+	}{
+		{
+			name:     "all the labels are set",
+			labels:   map[string]string{"team": "platform", "env": "dev", "tier": ""},
+			expected: groupLabels,
+		},
+		{
+			name:     "labels removed from the rule",
+			labels:   map[string]string{"team": "platform"},
+			expected: map[string]string{"team": "platform"},
+		},
+		{
+			name:     "label changed on the rule",
+			labels:   map[string]string{"team": "platform", "env": "prod", "tier": ""},
+			expected: map[string]string{"team": "platform", "tier": ""},
+		},
+		{
+			name:     "label overridden by the rule",
+			labels:   map[string]string{"team": "platform", "env": "prod", "tier": ""},
+			prior:    map[string]string{"env": "prod"},
+			expected: groupLabels,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := groupLabelsInRule(groupLabels, tc.labels, tc.prior); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
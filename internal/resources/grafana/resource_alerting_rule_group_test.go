@@ -1,13 +1,20 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
 	"github.com/grafana/terraform-provider-grafana/internal/testutils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccAlertRule_basic(t *testing.T) {
@@ -46,9 +53,10 @@ func TestAccAlertRule_basic(t *testing.T) {
 			},
 			// Test import.
 			{
-				ResourceName:      "grafana_rule_group.my_alert_rule",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.my_alert_rule",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 			// Test update content.
 			{
@@ -136,19 +144,22 @@ func TestAccAlertRule_model(t *testing.T) {
 			},
 			// Test import.
 			{
-				ResourceName:      "grafana_rule_group.rg_model_params_defaults",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.rg_model_params_defaults",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 			{
-				ResourceName:      "grafana_rule_group.rg_model_params_omitted",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.rg_model_params_omitted",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 			{
-				ResourceName:      "grafana_rule_group.rg_model_params_non_default",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.rg_model_params_non_default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 		},
 	})
@@ -177,9 +188,10 @@ func TestAccAlertRule_compound(t *testing.T) {
 			},
 			// Test import.
 			{
-				ResourceName:      "grafana_rule_group.my_multi_alert_group",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.my_multi_alert_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 			// Test update.
 			{
@@ -221,6 +233,431 @@ func TestAccAlertRule_compound(t *testing.T) {
 	})
 }
 
+func TestAccAlertRule_threshold(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_rule_group/_acc_threshold.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_threshold_rule", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.#", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.condition", ""),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.data.#", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.#", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.0.ref_id", "A"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.0.type", "gt"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.0.value", "80"),
+					func(s *terraform.State) error {
+						rule := group.Rules[0]
+						if *rule.Condition != "THRESHOLD" || len(rule.Data) != 2 || rule.Data[1].RefID != "THRESHOLD" {
+							return fmt.Errorf("expected the threshold stage to be generated and used as the condition, got condition %q and %d stages", *rule.Condition, len(rule.Data))
+						}
+						return nil
+					},
+				),
+			},
+			// Update the threshold
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_threshold.tf", map[string]string{
+					`"gt"`: `"lt"`,
+					"80":   "20.5",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_threshold_rule", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.0.type", "lt"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.0.value", "20.5"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_threshold.tf", map[string]string{
+					`for  = "2m"`: `for  = "2m"
+    condition = "A"`,
+				}),
+				ExpectError: regexp.MustCompile("`condition` can't be set along with `threshold`"),
+			},
+		},
+	})
+}
+
+// Moving a rule between `condition` and `threshold` doesn't keep the previous condition.
+func TestAccAlertRule_thresholdMigration(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+	// The condition is a math expression on the query, it's replaced by the threshold block
+	withCondition := map[string]string{
+		`    threshold {
+      ref_id = "A"
+      type   = "gt"
+      value  = 80
+    }`: `    data {
+      ref_id         = "B"
+      datasource_uid = "__expr__"
+      relative_time_range {
+        from = 0
+        to   = 0
+      }
+      model = jsonencode({ type = "math", expression = "$A > 80" })
+    }`,
+		`for  = "2m"`: `for  = "2m"
+    condition = "B"`,
+	}
+	checkCondition := func(condition string, stages int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			rule := group.Rules[0]
+			if *rule.Condition != condition || len(rule.Data) != stages {
+				return fmt.Errorf("expected condition %q with %d stages, got condition %q with %d stages", condition, stages, *rule.Condition, len(rule.Data))
+			}
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_threshold.tf", withCondition),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_threshold_rule", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.condition", "B"),
+					checkCondition("B", 2),
+				),
+			},
+			// From condition to threshold
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_rule_group/_acc_threshold.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_threshold_rule", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.condition", ""),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.#", "1"),
+					checkCondition("THRESHOLD", 2),
+				),
+			},
+			// Removing the threshold without setting a condition doesn't keep the generated one
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_threshold.tf", map[string]string{
+					`    threshold {
+      ref_id = "A"
+      type   = "gt"
+      value  = 80
+    }`: "",
+				}),
+				ExpectError: regexp.MustCompile("`condition` is required unless `threshold` is set"),
+			},
+			// From threshold to condition
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_threshold.tf", withCondition),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_threshold_rule", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.condition", "B"),
+					resource.TestCheckResourceAttr("grafana_rule_group.my_threshold_rule", "rule.0.threshold.#", "0"),
+					checkCondition("B", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlertRule_expressions(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+	name := "grafana_rule_group.my_expressions_rule"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_rule_group/_acc_expressions.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists(name, &group),
+					resource.TestCheckResourceAttr(name, "rule.0.data.#", "6"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.1.datasource_uid", "__expr__"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.1.resample.0.window", "1m"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.2.reduce.0.function", "mean"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.2.reduce.0.mode", "dropNN"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.3.math.0.expression", "$C * 100"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.3.model", `{"datasource":{"type":"__expr__","uid":"__expr__"},"expression":"$C * 100","refId":"D","type":"math"}`),
+					resource.TestCheckResourceAttr(name, "rule.0.data.4.threshold.0.type", "within_range"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.4.threshold.0.upper_value", "90"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.5.classic_conditions.0.condition.#", "2"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.5.classic_conditions.0.condition.1.operator", "or"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.5.classic_conditions.0.condition.1.type", "no_value"),
+					func(s *terraform.State) error {
+						model := group.Rules[0].Data[2].Model.(map[string]interface{})
+						if model["type"] != "reduce" || model["expression"] != "B" || model["reducer"] != "mean" {
+							return fmt.Errorf("unexpected reduce model: %v", model)
+						}
+						return nil
+					},
+				),
+			},
+			// Update an expression
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_expressions.tf", map[string]string{
+					"$C * 100": "$C * 10",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists(name, &group),
+					resource.TestCheckResourceAttr(name, "rule.0.data.3.math.0.expression", "$C * 10"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_expressions.tf", map[string]string{
+					`expression = "$C * 100"`: `expression = "$C * 100"
+      }
+      reduce {
+        input    = "C"
+        function = "max"`,
+				}),
+				ExpectError: regexp.MustCompile(`stage "D": only one of`),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_expressions.tf", map[string]string{
+					"upper_value = 90": "upper_value = 5",
+				}),
+				ExpectError: regexp.MustCompile("the within_range comparison requires an upper_value"),
+			},
+		},
+	})
+}
+
+func TestAccAlertRule_datasource(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+	var ds models.DataSource
+	name := "grafana_rule_group.my_datasource_rule"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_rule_group/_acc_datasource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists(name, &group),
+					datasourceCheckExists.exists("grafana_data_source.prometheus", &ds),
+					resource.TestCheckResourceAttr(name, "rule.0.data.#", "3"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.0.ref_id", "A"),
+					resource.TestCheckResourceAttrPair(name, "rule.0.data.0.datasource", "grafana_data_source.prometheus", "id"),
+					resource.TestCheckResourceAttrPair(name, "rule.0.data.0.datasource_uid", "grafana_data_source.prometheus", "uid"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.1.ref_id", "B"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.2.ref_id", "C"),
+					resource.TestCheckResourceAttr(name, "rule.0.data.2.datasource_uid", "__expr__"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_datasource.tf", map[string]string{
+					`datasource = { type = "prometheus" }`: `datasource = { type = "loki" }`,
+				}),
+				ExpectError: regexp.MustCompile(`stage "A": the model queries a "loki" data source, but data source "Rule Group Prometheus" is of type "prometheus"`),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/_acc_datasource.tf", map[string]string{
+					`      reduce {`: `      ref_id = "A"
+      reduce {`,
+				}),
+				ExpectError: regexp.MustCompile(`stage "A": the ref ID is used by several stages`),
+			},
+		},
+	})
+}
+
+// Invalid labels, annotations and titles are rejected at plan time.
+func TestAccAlertRule_invalidLabelsAndAnnotations(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var steps []resource.TestStep
+	for _, tc := range []struct {
+		replace map[string]string
+		err     string
+	}{
+		{
+			replace: map[string]string{`"e" = "f"`: `"team-name" = "f"`},
+			err:     `invalid key "team-name"`,
+		},
+		{
+			replace: map[string]string{`"e" = "f"`: `"__name__" = "f"`},
+			err:     `Label names starting with __ are reserved`,
+		},
+		{
+			replace: map[string]string{`"a" = "b"`: `"summary" = "{{ $labels.instance is down"`},
+			err:     `invalid template in the value of "summary"`,
+		},
+		{
+			replace: map[string]string{`"a" = "b"`: `"summary" = "{{ $undefined }}"`},
+			err:     `undefined variable "\$undefined"`,
+		},
+		{
+			replace: map[string]string{`"My Alert Rule 1"`: `"` + strings.Repeat("a", 191) + `"`},
+			err:     `expected length of rule.0.name to be in the range \(1 - 190\)`,
+		},
+	} {
+		steps = append(steps, resource.TestStep{
+			Config:      testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/resource.tf", tc.replace),
+			ExpectError: regexp.MustCompile(tc.err),
+		})
+	}
+	// Templates using the variables defined by Grafana are valid, and annotation keys aren't label names
+	steps = append(steps, resource.TestStep{
+		Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_rule_group/resource.tf", map[string]string{
+			`"a" = "b"`: `"summary" = "{{ $labels.instance }} is at {{ humanize $value }} ({{ index $values \"B\" }})"`,
+			`"e" = "f"`: `"severity" = "{{ if gt $value 90.0 }}critical{{ else }}warning{{ end }}"`,
+			`"c" = "d"`: `"__dashboardUid__" = "abc", "runbook-url" = "https://example.com/runbook"`,
+			`"g" = "h"`: `"team" = "h"`,
+		}),
+		PlanOnly:           true,
+		ExpectNonEmptyPlan: true,
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps:             steps,
+	})
+}
+
+func TestAccAlertRule_duplicateTitles(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	name := acctest.RandString(10)
+	// The UIDs of the folders are set, so that they are known at plan time
+	config := func(mode, secondFolder, secondTitle string) string {
+		return fmt.Sprintf(`
+provider "grafana" {
+	duplicate_rule_titles = "%[2]s"
+}
+
+resource "grafana_folder" "first" {
+	uid   = "%[1]s-first"
+	title = "%[1]s-first"
+}
+
+resource "grafana_folder" "second" {
+	uid   = "%[1]s-second"
+	title = "%[1]s-second"
+}
+
+resource "grafana_rule_group" "first" {
+	name             = "%[1]s"
+	folder_uid       = grafana_folder.first.uid
+	interval_seconds = 60
+	rule {
+		name      = "%[1]s-rule"
+		condition = "A"
+		data {
+			ref_id         = "A"
+			datasource_uid = "__expr__"
+			relative_time_range {
+				from = 0
+				to   = 0
+			}
+			model = jsonencode({ type = "math", expression = "2 + 2 > 1" })
+		}
+	}
+}
+
+resource "grafana_rule_group" "second" {
+	name             = "%[1]s-second"
+	folder_uid       = grafana_folder.%[3]s.uid
+	interval_seconds = 60
+	rule {
+		name      = "%[4]s"
+		condition = "A"
+		data {
+			ref_id         = "A"
+			datasource_uid = "__expr__"
+			relative_time_range {
+				from = 0
+				to   = 0
+			}
+			model = jsonencode({ type = "math", expression = "2 + 2 > 1" })
+		}
+	}
+}`, name, mode, secondFolder, secondTitle)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("fail", "first", name+"-rule"),
+				ExpectError: regexp.MustCompile(`rule "` + name + `-rule" is also defined in rule group`),
+			},
+			{
+				// Only the plan is checked, Grafana may reject the duplicate titles when they are applied
+				Config:             config("warn", "first", name+"-rule"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The titles only have to be unique within a folder
+				Config: config("fail", "second", name+"-rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_rule_group.first", "rule.0.name", name+"-rule"),
+					resource.TestCheckResourceAttr("grafana_rule_group.second", "rule.0.name", name+"-rule"),
+				),
+			},
+			{
+				Config: config("fail", "first", name+"-other-rule"),
+				Check:  resource.TestCheckResourceAttr("grafana_rule_group.second", "rule.0.name", name+"-other-rule"),
+			},
+		},
+	})
+}
+
+func TestAccAlertRule_unknownInterval(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var group models.AlertRuleGroup
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				// The interval is only known once the folder is created, it isn't validated at plan time
+				Config: fmt.Sprintf(`
+resource "grafana_folder" "test" {
+	title = "%[1]s"
+}
+
+resource "grafana_rule_group" "test" {
+	name             = "%[1]s"
+	folder_uid       = grafana_folder.test.uid
+	interval_seconds = grafana_folder.test.uid != "" ? 60 : 0
+	rule {
+		name      = "%[1]s"
+		condition = "A"
+		data {
+			ref_id         = "A"
+			datasource_uid = "__expr__"
+			relative_time_range {
+				from = 0
+				to   = 0
+			}
+			model = jsonencode({ type = "math", expression = "2 + 2 > 1" })
+		}
+	}
+}`, name),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.test", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "interval_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlertRule_inOrg(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
 
@@ -262,11 +699,21 @@ func TestAccAlertRule_inOrg(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.0.data.0.model", "{\"hide\":false,\"refId\":\"A\"}"),
 				),
 			},
+			// Intervals that Grafana would round are rejected at plan time.
+			{
+				Config:      testAccAlertRuleGroupInOrgConfig(name, 365, false),
+				ExpectError: regexp.MustCompile(`interval_seconds \(6m5s\) must be a multiple of the base evaluation interval of the Grafana instance \(10s\)`),
+			},
+			{
+				Config:      testAccAlertRuleGroupInOrgConfig(name, 0, false),
+				ExpectError: regexp.MustCompile(`must be at least the minimum evaluation interval of the Grafana instance`),
+			},
 			// Test import.
 			{
-				ResourceName:      "grafana_rule_group.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 			// Test delete resource, but not org.
 			{
@@ -306,9 +753,10 @@ func TestAccAlertRule_disableProvenance(t *testing.T) {
 			},
 			// Test import.
 			{
-				ResourceName:      "grafana_rule_group.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 			// Enable editing from UI.
 			{
@@ -323,9 +771,10 @@ func TestAccAlertRule_disableProvenance(t *testing.T) {
 			},
 			// Test import.
 			{
-				ResourceName:      "grafana_rule_group.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_rule_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 			// Disable editing from UI.
 			{
@@ -342,6 +791,302 @@ func TestAccAlertRule_disableProvenance(t *testing.T) {
 	})
 }
 
+func TestAccAlertRule_createFolderIfMissing(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var group models.AlertRuleGroup
+	var org models.OrgDetailsDTO
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		// The folder is deleted along with the organization
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			orgCheckExists.destroyed(&org, nil),
+			alertingRuleGroupCheckExists.destroyed(&group, &org),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_organization" "test" {
+	name = "%[1]s"
+}
+
+resource "grafana_rule_group" "test" {
+	org_id                   = grafana_organization.test.id
+	name                     = "%[1]s"
+	folder_uid               = "%[1]s"
+	create_folder_if_missing = true
+	create_folder_title      = "Alerts {{uid}}"
+	interval_seconds         = 60
+	rule {
+		name      = "My Alert Rule 1"
+		condition = "A"
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 600
+				to   = 0
+			}
+			datasource_uid = "PD8C576611E62080A"
+			model          = jsonencode({ refId = "A" })
+		}
+	}
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.test", &group),
+					orgCheckExists.exists("grafana_organization.test", &org),
+					checkResourceIsInOrg("grafana_rule_group.test", "grafana_organization.test"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "folder_uid", name),
+					func(s *terraform.State) error {
+						client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta()).WithOrgID(org.ID)
+						resp, err := client.Folders.GetFolderByUID(name)
+						if err != nil {
+							return fmt.Errorf("folder %s was not created: %w", name, err)
+						}
+						if expected := "Alerts " + name; resp.Payload.Title != expected {
+							return fmt.Errorf("expected folder title %q, got %q", expected, resp.Payload.Title)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlertRule_defaultLabels(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var group models.AlertRuleGroup
+	name := acctest.RandString(10)
+	config := func(defaultLabels string) string {
+		return fmt.Sprintf(`
+provider "grafana" {
+	default_labels = %[2]s
+}
+
+resource "grafana_folder" "test" {
+	title = "%[1]s"
+}
+
+resource "grafana_rule_group" "test" {
+	name             = "%[1]s"
+	folder_uid       = grafana_folder.test.uid
+	interval_seconds = 60
+	rule {
+		name      = "My Alert Rule 1"
+		condition = "A"
+		labels = {
+			env = "prod"
+		}
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 600
+				to   = 0
+			}
+			datasource_uid = "PD8C576611E62080A"
+			model          = jsonencode({ refId = "A" })
+		}
+	}
+	rule {
+		name      = "My Alert Rule 2"
+		condition = "A"
+		labels = {
+			team = "platform"
+		}
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 600
+				to   = 0
+			}
+			datasource_uid = "PD8C576611E62080A"
+			model          = jsonencode({ refId = "A" })
+		}
+	}
+}
+`, name, defaultLabels)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`{
+		team = "platform"
+		env  = "dev"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.test", &group),
+					// Only the labels set on the resource are in the state
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.0.labels.env", "prod"),
+					// A default label also set on a new rule is kept, since the rule sets it
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.1.labels.%", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.1.labels.team", "platform"),
+					func(s *terraform.State) error {
+						labels := group.Rules[0].Labels
+						if labels["team"] != "platform" || labels["env"] != "prod" {
+							return fmt.Errorf("expected the default labels to be merged into the rule labels, got %v", labels)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "applied_default_labels.%", "2"),
+				),
+			},
+			// Removing a default label updates the rules, even though their labels in the state don't change
+			{
+				Config: config(`{
+		team = "platform"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.test", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "applied_default_labels.%", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.1.labels.%", "1"),
+					func(s *terraform.State) error {
+						if labels := group.Rules[1].Labels; len(labels) != 1 || labels["team"] != "platform" {
+							return fmt.Errorf("expected the removed default label to be removed from the rule labels, got %v", labels)
+						}
+						return nil
+					},
+				),
+			},
+			// So does removing all the default labels
+			{
+				Config: config("{}"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.test", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "applied_default_labels.%", "0"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.0.labels.%", "1"),
+					func(s *terraform.State) error {
+						if labels := group.Rules[0].Labels; len(labels) != 1 || labels["env"] != "prod" {
+							return fmt.Errorf("expected the default labels to be removed from the rule labels, got %v", labels)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlertRule_groupLabels(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var group models.AlertRuleGroup
+	name := acctest.RandString(10)
+	config := fmt.Sprintf(`
+resource "grafana_folder" "test" {
+	title = "%[1]s"
+}
+
+resource "grafana_rule_group" "test" {
+	name             = "%[1]s"
+	folder_uid       = grafana_folder.test.uid
+	interval_seconds = 60
+	labels = {
+		team = "platform"
+		env  = "dev"
+	}
+	rule {
+		name      = "My Alert Rule 1"
+		condition = "A"
+		labels = {
+			env = "prod"
+		}
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 600
+				to   = 0
+			}
+			datasource_uid = "PD8C576611E62080A"
+			model          = jsonencode({ refId = "A" })
+		}
+	}
+	rule {
+		name      = "My Alert Rule 2"
+		condition = "A"
+		labels = {
+			team = "platform"
+		}
+		data {
+			ref_id = "A"
+			relative_time_range {
+				from = 600
+				to   = 0
+			}
+			datasource_uid = "PD8C576611E62080A"
+			model          = jsonencode({ refId = "A" })
+		}
+	}
+}
+`, name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.test", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "labels.%", "2"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "labels.team", "platform"),
+					// Only the labels set on the rule are in its state
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.0.labels.env", "prod"),
+					// A group label also set on a new rule is kept, since the rule sets it
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.1.labels.%", "1"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "rule.1.labels.team", "platform"),
+					func(s *terraform.State) error {
+						labels := group.Rules[0].Labels
+						if len(labels) != 2 || labels["team"] != "platform" || labels["env"] != "prod" {
+							return fmt.Errorf("expected the group labels to be merged into the rule labels, got %v", labels)
+						}
+						return nil
+					},
+				),
+			},
+			// Removing a group label from a rule outside of Terraform shows a diff
+			{
+				PreConfig: func() {
+					client := testutils.Provider.Meta().(*common.Client).GrafanaOAPI
+					resp, err := client.Provisioning.GetAlertRule(group.Rules[1].UID)
+					if err != nil {
+						t.Fatal(err)
+					}
+					rule := resp.Payload
+					delete(rule.Labels, "env")
+					if _, err := client.Provisioning.PutAlertRule(provisioning.NewPutAlertRuleParams().WithUID(rule.UID).WithBody(rule)); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.test", &group),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "labels.%", "2"),
+					func(s *terraform.State) error {
+						if labels := group.Rules[1].Labels; labels["env"] != "dev" {
+							return fmt.Errorf("expected the group label to be set again on the rule, got %v", labels)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccAlertRuleGroupInOrgConfig(name string, interval int, disableProvenance bool) string {
 	return fmt.Sprintf(`
 resource "grafana_organization" "test" {
@@ -97,7 +97,7 @@ func ResourceAnnotation() *schema.Resource {
 }
 
 func CreateAnnotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	annotation, err := makeAnnotation(d)
 	if err != nil {
@@ -115,7 +115,7 @@ func CreateAnnotation(ctx context.Context, d *schema.ResourceData, meta interfac
 }
 
 func UpdateAnnotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	postAnnotation, err := makeAnnotation(d)
 	if err != nil {
@@ -134,7 +134,7 @@ func UpdateAnnotation(ctx context.Context, d *schema.ResourceData, meta interfac
 }
 
 func ReadAnnotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.Annotations.GetAnnotationByID(idStr)
 	if err, shouldReturn := common.CheckReadError("Annotation", d, err); shouldReturn {
@@ -158,7 +158,7 @@ func ReadAnnotation(ctx context.Context, d *schema.ResourceData, meta interface{
 }
 
 func DeleteAnnotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	_, err := client.Annotations.DeleteAnnotationByID(idStr)
 	diag, _ := common.CheckReadError("annotation", d, err)
@@ -0,0 +1,245 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/client/access_control"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+const annotationActionPrefix = "annotations:"
+
+var (
+	annotationPermissionActions = []string{"read", "create", "write", "delete"}
+	annotationPermissionTypes   = []string{"*", "dashboard", "organization"}
+)
+
+func ResourceAnnotationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Grants annotation permissions to users, teams and service accounts: reading, adding, editing and deleting the annotations of an organization, or of a single dashboard.
+
+The permissions are held by a custom role managed by this resource, and assigned to the given users, teams and service accounts. Assignments that aren't specified are removed.
+The permissions granted by the basic roles (e.g. ` + "`Editor`" + `) aren't changed.
+
+**Note:** This resource is available only with Grafana Enterprise 9.2+.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/roles-and-permissions/access-control/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/access_control/)
+`,
+		CreateContext: CreateAnnotationPermissions,
+		ReadContext:   ReadAnnotationPermissions,
+		UpdateContext: UpdateAnnotationPermissions,
+		DeleteContext: DeleteAnnotationPermissions,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role holding the permissions.",
+			},
+			"role_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UID of the role holding the permissions.",
+			},
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(annotationPermissionActions, false),
+				},
+				Description: fmt.Sprintf("The actions allowed on the annotations. Can be %s.", strings.Join(annotationPermissionActions, ", ")),
+			},
+			"annotation_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "*",
+				ValidateFunc:  validation.StringInSlice(annotationPermissionTypes, false),
+				ConflictsWith: []string{"dashboard_uid"},
+				Description:   "The annotations the permissions apply to: `dashboard` for the annotations of all the dashboards, `organization` for the annotations that aren't attached to a dashboard, `*` for both.",
+			},
+			"dashboard_uid": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"annotation_type"},
+				Description:   "UID of a dashboard, to only apply the permissions to its annotations.",
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the users to grant the permissions to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"teams": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the teams to grant the permissions to.",
+				// Ignore the org ID of the team when hashing. It works with or without it.
+				Set: func(i interface{}) int {
+					_, teamID := SplitOrgResourceID(i.(string))
+					return schema.HashString(teamID)
+				},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_accounts": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the service accounts to grant the permissions to.",
+				// Ignore the org ID of the service account when hashing. It works with or without it.
+				Set: func(i interface{}) int {
+					_, saID := SplitOrgResourceID(i.(string))
+					return schema.HashString(saID)
+				},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func CreateAnnotationPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+
+	resp, err := client.AccessControl.CreateRole(&models.CreateRoleForm{
+		Name:        d.Get("name").(string),
+		Description: "Annotation permissions managed by Terraform",
+		Group:       "Annotations",
+		Version:     1,
+		Permissions: annotationPermissions(d),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	uid := resp.Payload.UID
+	d.SetId(MakeOrgResourceID(orgID, uid))
+
+	if err := setAnnotationPermissionsAssignments(ctx, d, meta, uid); err != nil {
+		return err
+	}
+	return ReadAnnotationPermissions(ctx, d, meta)
+}
+
+func ReadAnnotationPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	resp, err := client.AccessControl.GetRole(uid)
+	if err, shouldReturn := common.CheckReadError("annotation permissions", d, err); shouldReturn {
+		return err
+	}
+	role := resp.Payload
+
+	var actions []string
+	annotationType, dashboardUID := "*", ""
+	for _, p := range role.Permissions {
+		actions = append(actions, strings.TrimPrefix(p.Action, annotationActionPrefix))
+		if uid, ok := strings.CutPrefix(p.Scope, "dashboards:uid:"); ok {
+			dashboardUID, annotationType = uid, ""
+		} else if t, ok := strings.CutPrefix(p.Scope, "annotations:type:"); ok {
+			annotationType = t
+		}
+	}
+	d.Set("org_id", fmt.Sprint(orgID))
+	d.Set("name", role.Name)
+	d.Set("actions", actions)
+	if annotationType == "" {
+		// Set the default value, as `annotation_type` conflicts with `dashboard_uid`
+		annotationType = "*"
+	}
+	d.Set("annotation_type", annotationType)
+	d.Set("dashboard_uid", dashboardUID)
+
+	assignments, err := client.AccessControl.GetRoleAssignments(uid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(setRoleAssignments(assignments.Payload, d))
+}
+
+func UpdateAnnotationPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	if d.HasChanges("actions", "annotation_type", "dashboard_uid") {
+		// Roles are only updated when their version increases
+		resp, err := client.AccessControl.GetRole(uid)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		role := resp.Payload
+		if _, err := client.AccessControl.UpdateRole(uid, &models.UpdateRoleCommand{
+			Name:        role.Name,
+			Description: role.Description,
+			Group:       role.Group,
+			Version:     role.Version + 1,
+			Permissions: annotationPermissions(d),
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("users", "teams", "service_accounts") {
+		if err := setAnnotationPermissionsAssignments(ctx, d, meta, uid); err != nil {
+			return err
+		}
+	}
+	return ReadAnnotationPermissions(ctx, d, meta)
+}
+
+func DeleteAnnotationPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	global := false
+	_, err := client.AccessControl.DeleteRole(access_control.NewDeleteRoleParams().WithRoleUID(uid).WithGlobal(&global), nil)
+	diag, _ := common.CheckReadError("annotation permissions", d, err)
+	return diag
+}
+
+// annotationPermissions returns the permissions of the role: the configured actions, on the configured annotations.
+func annotationPermissions(d *schema.ResourceData) []*models.Permission {
+	scope := "annotations:type:" + d.Get("annotation_type").(string)
+	if dashboardUID := d.Get("dashboard_uid").(string); dashboardUID != "" {
+		scope = "dashboards:uid:" + dashboardUID
+	}
+
+	var permissions []*models.Permission
+	for _, action := range d.Get("actions").(*schema.Set).List() {
+		permissions = append(permissions, &models.Permission{
+			Action: annotationActionPrefix + action.(string),
+			Scope:  scope,
+		})
+	}
+	return permissions
+}
+
+func setAnnotationPermissionsAssignments(ctx context.Context, d *schema.ResourceData, meta interface{}, uid string) diag.Diagnostics {
+	client, _, _ := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	_, err := client.AccessControl.SetRoleAssignments(uid, &models.SetRoleAssignmentsCommand{
+		Users:           append([]int64{}, collectRoleAssignents(d.Get("users"), false)...),
+		Teams:           append([]int64{}, collectRoleAssignents(d.Get("teams"), true)...),
+		ServiceAccounts: append([]int64{}, collectRoleAssignents(d.Get("service_accounts"), true)...),
+	})
+	if err != nil {
+		return diag.Errorf("failed to assign the annotation permissions: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccAnnotationPermissions(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.2.0")
+
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnnotationPermissionsConfig(name, `annotation_type = "organization"`, `"create", "write"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "name", name),
+					resource.TestCheckResourceAttrSet("grafana_annotation_permissions.test", "role_uid"),
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "annotation_type", "organization"),
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "dashboard_uid", ""),
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "actions.#", "2"),
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "teams.#", "1"),
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "service_accounts.#", "1"),
+				),
+			},
+			{
+				Config: testAccAnnotationPermissionsConfig(name, `dashboard_uid = grafana_dashboard.test.uid`, `"create", "write", "delete"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "annotation_type", "*"),
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "dashboard_uid", name),
+					resource.TestCheckResourceAttr("grafana_annotation_permissions.test", "actions.#", "3"),
+				),
+			},
+			{
+				ResourceName:      "grafana_annotation_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAnnotationPermissionsConfig(name, target, actions string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	config_json = jsonencode({
+		title = "%[1]s"
+		uid   = "%[1]s"
+	})
+}
+
+resource "grafana_team" "test" {
+	name = "%[1]s"
+}
+
+resource "grafana_service_account" "test" {
+	name = "%[1]s"
+	role = "Viewer"
+}
+
+resource "grafana_annotation_permissions" "test" {
+	name             = "%[1]s"
+	%[2]s
+	actions          = [%[3]s]
+	teams            = [grafana_team.test.id]
+	service_accounts = [grafana_service_account.test.id]
+}
+`, name, target, actions)
+}
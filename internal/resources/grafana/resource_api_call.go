@@ -0,0 +1,288 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+var apiCallMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+func ResourceAPICall() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Calls an endpoint of the Grafana HTTP API with the provider's credentials, for the features that aren't supported by the other resources yet.
+
+The call is made when the resource is created, and again when its body or exports change. The optional destroy call is made when the resource is destroyed.
+Changing the method or the path replaces the resource: the destroy call is made before the new call.
+The state isn't refreshed from Grafana: changes made outside of Terraform aren't detected.
+The response is sensitive, since it may contain secrets, e.g. the key of a created token.
+
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/)
+`,
+
+		CreateContext: CreateAPICall,
+		ReadContext:   schema.NoopContext,
+		UpdateContext: UpdateAPICall,
+		DeleteContext: DeleteAPICall,
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("status_code", apiCallChanged),
+			customdiff.ComputedIf("response_body", apiCallChanged),
+			customdiff.ComputedIf("response_exports", apiCallChanged),
+		),
+
+		Schema: apiCallSchema(apiCallMethods, http.MethodPost, true, map[string]*schema.Schema{
+			"destroy_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      http.MethodDelete,
+				ValidateFunc: validation.StringInSlice(apiCallMethods, false),
+				Description:  "The HTTP method of the destroy call.",
+			},
+			"destroy_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of the destroy call, relative to the Grafana URL, e.g. `/api/things/my-thing`. If not set, no call is made when the resource is destroyed.",
+			},
+			"destroy_body": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The JSON body of the destroy call.",
+			},
+		}),
+	}
+}
+
+func DatasourceAPICall() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Calls an endpoint of the Grafana HTTP API with the provider's credentials, for the features that aren't supported by the other data sources yet.
+The call is made every time the data source is read, so only ` + "`GET`" + ` calls are allowed: use the ` + "`grafana_api_call`" + ` resource to modify things.
+
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/)
+`,
+
+		ReadContext: readAPICallDatasource,
+		Schema:      apiCallSchema([]string{http.MethodGet}, http.MethodGet, false, nil),
+	}
+}
+
+// apiCallSchema returns the schema shared by the `grafana_api_call` resource and data source, with the given methods, default method and additional attributes.
+// If forceNew is set, a change of the method or the path replaces the resource.
+func apiCallSchema(methods []string, defaultMethod string, forceNew bool, extra map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"org_id": orgIDAttribute(),
+		"method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     forceNew,
+			Default:      defaultMethod,
+			ValidateFunc: validation.StringInSlice(methods, false),
+			Description:  "The HTTP method of the call.",
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with a `/`"),
+			Description:  "The path of the call, relative to the Grafana URL, with its query string, e.g. `/api/search?type=dash-db`.",
+		},
+		"body": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: common.SuppressEquivalentJSONDiffs,
+			Description:      "The JSON body of the call.",
+		},
+		"exports": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "Values to extract from the JSON body of the response into `response_exports`, as a map of names to paths. " +
+				"A path is a subset of JMESPath: field names separated by dots, and array indexes, e.g. `items[0].uid`. Negative indexes count from the end of the array.",
+		},
+		"status_code": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The status code of the response.",
+		},
+		"response_body": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The body of the response.",
+		},
+		"response_exports": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The values extracted from the response with `exports`. Strings are exported as is, other values are encoded in JSON.",
+		},
+	}
+	for k, v := range extra {
+		s[k] = v
+	}
+	return s
+}
+
+// apiCallChanged returns whether the call is made again, changing the response.
+func apiCallChanged(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.HasChanges("body", "exports")
+}
+
+func CreateAPICall(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	if err := callAPI(ctx, d, meta, orgID); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(MakeOrgResourceID(orgID, d.Get("method").(string)+" "+d.Get("path").(string)))
+	return nil
+}
+
+func UpdateAPICall(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Changes of the destroy call are only recorded in the state
+	if !d.HasChanges("body", "exports") {
+		return nil
+	}
+	_, orgID, _ := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+	if err := callAPI(ctx, d, meta, orgID); err != nil {
+		// Keep the previous body and exports in the state, so that the next apply makes the call again
+		oldBody, _ := d.GetChange("body")
+		oldExports, _ := d.GetChange("exports")
+		d.Set("body", oldBody)
+		d.Set("exports", oldExports)
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func DeleteAPICall(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	path := d.Get("destroy_path").(string)
+	if path == "" {
+		return nil
+	}
+	_, orgID, _ := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	status, respBody, err := doAPICall(ctx, meta, orgID, d.Get("destroy_method").(string), path, d.Get("destroy_body").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status >= 400 && status != http.StatusNotFound {
+		return diag.Errorf("the destroy call failed with status: %d, body: %s", status, respBody)
+	}
+	return nil
+}
+
+func readAPICallDatasource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	if err := callAPI(ctx, d, meta, orgID); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(MakeOrgResourceID(orgID, d.Get("method").(string)+" "+d.Get("path").(string)))
+	return nil
+}
+
+// callAPI makes the call described by the `method`, `path` and `body` attributes, and sets the computed attributes from the response.
+// Responses with an error status code fail the call.
+func callAPI(ctx context.Context, d *schema.ResourceData, meta interface{}, orgID int64) error {
+	status, respBody, err := doAPICall(ctx, meta, orgID, d.Get("method").(string), d.Get("path").(string), d.Get("body").(string))
+	if err != nil {
+		return err
+	}
+	if status >= 400 {
+		return fmt.Errorf("status: %d, body: %s", status, respBody)
+	}
+
+	exports := map[string]string{}
+	if exportPaths := d.Get("exports").(map[string]interface{}); len(exportPaths) > 0 {
+		var doc interface{}
+		if err := json.Unmarshal(respBody, &doc); err != nil {
+			return fmt.Errorf("the response isn't valid JSON, values can't be exported from it: %w", err)
+		}
+		for name, path := range exportPaths {
+			if exports[name], err = apiCallExport(doc, path.(string)); err != nil {
+				return fmt.Errorf("failed to export %q: %w", name, err)
+			}
+		}
+	}
+
+	d.Set("status_code", status)
+	d.Set("response_body", string(respBody))
+	d.Set("response_exports", exports)
+	return nil
+}
+
+func doAPICall(ctx context.Context, meta interface{}, orgID int64, method, path, body string) (int, []byte, error) {
+	var query url.Values
+	if p, rawQuery, ok := strings.Cut(path, "?"); ok {
+		var err error
+		if query, err = url.ParseQuery(rawQuery); err != nil {
+			return 0, nil, fmt.Errorf("invalid query string %q: %w", rawQuery, err)
+		}
+		path = p
+	}
+	var reqBody []byte
+	contentType := ""
+	if body != "" {
+		reqBody, contentType = []byte(body), "application/json"
+	}
+	return grafanaAPIRequest(ctx, meta.(*common.Client), orgID, method, path, query, contentType, reqBody)
+}
+
+var apiCallExportSegmentRegexp = regexp.MustCompile(`^([^.\[\]]*)((?:\[-?\d+\])*)$`)
+
+// apiCallExport extracts the value at the given path of a JSON document, e.g. `items[0].uid`.
+func apiCallExport(doc interface{}, path string) (string, error) {
+	value := doc
+	for _, segment := range strings.Split(path, ".") {
+		match := apiCallExportSegmentRegexp.FindStringSubmatch(segment)
+		if match == nil || (match[1] == "" && match[2] == "") {
+			return "", fmt.Errorf("invalid path %q", path)
+		}
+		if field := match[1]; field != "" {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return "", fmt.Errorf("%q: %q isn't a field of an object", path, field)
+			}
+			if value, ok = object[field]; !ok {
+				return "", fmt.Errorf("%q: no field %q", path, field)
+			}
+		}
+		for _, index := range strings.Split(strings.Trim(match[2], "[]"), "][") {
+			if index == "" {
+				continue
+			}
+			array, ok := value.([]interface{})
+			if !ok {
+				return "", fmt.Errorf("%q: [%s] isn't an index of an array", path, index)
+			}
+			i, _ := strconv.Atoi(index)
+			if i < 0 {
+				i += len(array)
+			}
+			if i < 0 || i >= len(array) {
+				return "", fmt.Errorf("%q: index %s is out of range", path, index)
+			}
+			value = array[i]
+		}
+	}
+
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	encoded, err := json.Marshal(value)
+	return string(encoded), err
+}
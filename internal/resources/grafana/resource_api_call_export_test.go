@@ -0,0 +1,59 @@
+package grafana
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAPICallExport(t *testing.T) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(`{
+		"uid": "abc",
+		"id": 12,
+		"meta": {"enabled": true, "tags": ["a", "b"]},
+		"items": [{"uid": "first"}, {"uid": "second", "values": [[1, 2], [3, 4]]}],
+		"dotted.key": "unreachable"
+	}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		path          string
+		expected      string
+		expectedError string
+	}{
+		{path: "uid", expected: "abc"},
+		{path: "id", expected: "12"},
+		{path: "meta.enabled", expected: "true"},
+		{path: "meta", expected: `{"enabled":true,"tags":["a","b"]}`},
+		{path: "meta.tags[1]", expected: "b"},
+		{path: "items[0].uid", expected: "first"},
+		{path: "items[-1].uid", expected: "second"},
+		{path: "items[1].values[1][0]", expected: "3"},
+		{path: "items[2].uid", expectedError: "index 2 is out of range"},
+		{path: "items[-3]", expectedError: "index -3 is out of range"},
+		{path: "missing", expectedError: `no field "missing"`},
+		{path: "uid.value", expectedError: `"value" isn't a field of an object`},
+		{path: "meta[0]", expectedError: "[0] isn't an index of an array"},
+		{path: "items..uid", expectedError: "invalid path"},
+		{path: "items[a]", expectedError: "invalid path"},
+		{path: "dotted.key", expectedError: `no field "dotted"`},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			value, err := apiCallExport(doc, tc.path)
+			if tc.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+					t.Fatalf("expected an error containing %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if value != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package grafana_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAPICall_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		// The destroy call deletes the folder
+		CheckDestroy: func(s *terraform.State) error {
+			client := testutils.Provider.Meta().(*common.Client).GrafanaOAPI.WithOrgID(1)
+			_, err := client.Folders.GetFolderByUID(uid)
+			if err == nil {
+				return fmt.Errorf("folder %s still exists", uid)
+			} else if !common.IsNotFoundError(err) {
+				return err
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPICallFolder(uid, "first title"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_api_call.folder", "status_code", "200"),
+					resource.TestCheckResourceAttr("grafana_api_call.folder", "response_exports.uid", uid),
+					resource.TestCheckResourceAttr("grafana_api_call.test", "status_code", "200"),
+					resource.TestCheckResourceAttr("grafana_api_call.test", "response_exports.title", "first title"),
+					resource.TestCheckResourceAttr("data.grafana_api_call.folder", "response_exports.title", "first title"),
+				),
+			},
+			// A change of the body makes the call again
+			{
+				Config: testAccAPICallFolder(uid, "second title"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_api_call.folder", "response_exports.uid", uid),
+					resource.TestCheckResourceAttr("grafana_api_call.test", "status_code", "200"),
+					resource.TestCheckResourceAttr("grafana_api_call.test", "response_exports.title", "second title"),
+					resource.TestCheckResourceAttr("data.grafana_api_call.folder", "response_exports.title", "second title"),
+				),
+			},
+			// A failed call keeps the previous body in the state, so the next plan makes it again
+			{
+				Config:      testAccAPICallFolder(uid, ""),
+				ExpectError: regexp.MustCompile(`status: 400`),
+			},
+			{
+				Config:             testAccAPICallFolder(uid, ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccAPICallFolder creates a folder with a call, with a destroy call deleting it, and updates its title with another call.
+func testAccAPICallFolder(uid, title string) string {
+	return fmt.Sprintf(`
+resource "grafana_api_call" "folder" {
+  path = "/api/folders"
+  body = jsonencode({
+    uid   = "%[1]s"
+    title = "%[1]s"
+  })
+  exports = {
+    uid = "uid"
+  }
+
+  destroy_path = "/api/folders/%[1]s"
+}
+
+resource "grafana_api_call" "test" {
+  method = "PUT"
+  path   = "/api/folders/%[1]s"
+  body = jsonencode({
+    title     = "%[2]s"
+    overwrite = true
+  })
+  exports = {
+    title = "title"
+  }
+  depends_on = [grafana_api_call.folder]
+}
+
+data "grafana_api_call" "folder" {
+  path = "/api/folders/%[1]s"
+  exports = {
+    title = "title"
+  }
+  depends_on = [grafana_api_call.test]
+}
+`, uid, title)
+}
@@ -63,7 +63,7 @@ Manages Grafana API Keys.
 }
 
 func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c, orgID := OAPIClientFromNewOrgResource(m, d)
+	c, orgID := OAPIClientFromNewOrgResource(ctx, m, d)
 
 	request := models.AddAPIKeyCommand{
 		Name:          d.Get("name").(string),
@@ -83,7 +83,7 @@ func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c, orgID, idStr := OAPIClientFromExistingOrgResource(m, d.Id())
+	c, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, m, d.Id())
 
 	includeExpired := true
 	response, err := c.APIKeys.GetAPIkeys(api_keys.NewGetAPIkeysParams().WithIncludeExpired(&includeExpired))
@@ -117,7 +117,7 @@ func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c, _, idStr := OAPIClientFromExistingOrgResource(m, d.Id())
+	c, _, idStr := OAPIClientFromExistingOrgResource(ctx, m, d.Id())
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
 		return diag.FromErr(err)
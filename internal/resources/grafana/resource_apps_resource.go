@@ -0,0 +1,328 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+const (
+	// appsResourceFieldManager is the manager of the fields set by the provider, in server-side apply terms.
+	appsResourceFieldManager = "terraform-provider-grafana"
+	appsResourceApplyPatch   = "application/apply-patch+yaml"
+	appsResourceDefaultNS    = "default"
+)
+
+func ResourceAppsResource() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Manages any resource of the Grafana app platform, the Kubernetes-style APIs served under ` + "`/apis`" + `, from a manifest.
+
+The manifest is applied with server-side apply semantics: only the fields it sets are managed by Terraform, and fields set by Grafana or by other clients are left as they are.
+Drift is detected on the fields set in the manifest.
+
+**Note:** The app platform APIs are experimental. The APIs of the resource kinds must be enabled on the Grafana instance.
+`,
+		CreateContext: CreateAppsResource,
+		ReadContext:   ReadAppsResource,
+		UpdateContext: UpdateAppsResource,
+		DeleteContext: DeleteAppsResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.ForceNewIfChange("manifest", func(ctx context.Context, oldValue, newValue, meta interface{}) bool {
+			// Changing the identity of the object creates another object
+			oldManifest, oldErr := parseAppsManifest(oldValue.(string))
+			newManifest, newErr := parseAppsManifest(newValue.(string))
+			if oldErr != nil || newErr != nil {
+				return false
+			}
+			oldRef, _ := appsResourceRefFromManifest(oldManifest, "")
+			newRef, _ := appsResourceRefFromManifest(newManifest, "")
+			return oldRef != newRef
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"manifest": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The manifest of the resource, in JSON or YAML. It must set `apiVersion` (`<group>/<version>`), `kind` and `metadata.name`. " +
+					"`metadata.namespace` defaults to `default`, the namespace of the default organization.",
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					manifest, err := parseAppsManifest(i.(string))
+					if err == nil {
+						_, err = appsResourceRefFromManifest(manifest, "")
+					}
+					if err != nil {
+						return nil, []error{fmt.Errorf("%s: %w", k, err)}
+					}
+					return nil, nil
+				},
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					oldManifest, oldErr := parseAppsManifest(oldValue)
+					newManifest, newErr := parseAppsManifest(newValue)
+					return oldErr == nil && newErr == nil && reflect.DeepEqual(oldManifest, newManifest)
+				},
+			},
+			"plural": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The plural name of the resource kind, used in the API paths (e.g. `playlists`). Defaults to the lowercased kind followed by `s`.",
+			},
+			"force_conflicts": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Take over the fields of the manifest that are managed by other clients. Otherwise, applying a manifest that sets such fields to other values fails.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the object, set by Grafana.",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the object, as of the last read.",
+			},
+		},
+	}
+}
+
+func CreateAppsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return applyAppsResource(ctx, d, meta)
+}
+
+func UpdateAppsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return applyAppsResource(ctx, d, meta)
+}
+
+func applyAppsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	manifest, err := parseAppsManifest(d.Get("manifest").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ref, err := appsResourceRefFromManifest(manifest, d.Get("plural").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The manifest is sent as JSON, which is valid YAML
+	body, err := json.Marshal(manifest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	query := url.Values{
+		"fieldManager": []string{appsResourceFieldManager},
+		"force":        []string{strconv.FormatBool(d.Get("force_conflicts").(bool))},
+	}
+	if err := appsAPIRequest(ctx, meta.(*common.Client), http.MethodPatch, ref.path(), query, appsResourceApplyPatch, body, nil); err != nil {
+		return diag.Errorf("failed to apply %s %q: %v", manifest["kind"], ref.Name, err)
+	}
+
+	d.SetId(ref.id())
+	return ReadAppsResource(ctx, d, meta)
+}
+
+func ReadAppsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ref, err := parseAppsResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var live map[string]interface{}
+	err = appsAPIRequest(ctx, meta.(*common.Client), http.MethodGet, ref.path(), nil, "", nil, &live)
+	if err, shouldReturn := common.CheckReadError("apps resource", d, err); shouldReturn {
+		return err
+	}
+
+	metadata, _ := live["metadata"].(map[string]interface{})
+	d.Set("uid", metadata["uid"])
+	d.Set("resource_version", metadata["resourceVersion"])
+	d.Set("plural", ref.Plural)
+
+	// Only the fields set in the manifest are compared to the live object. On import, there's no manifest yet: the whole object, minus the fields set by Grafana, is used instead.
+	manifest := cleanAppsObject(live)
+	if configuredManifest := d.Get("manifest").(string); configuredManifest != "" {
+		if configured, err := parseAppsManifest(configuredManifest); err == nil {
+			projected := projectAppsObject(configured, live)
+			if reflect.DeepEqual(projected, configured) {
+				return nil
+			}
+			manifest = projected.(map[string]interface{})
+		}
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("manifest", string(data))
+
+	return nil
+}
+
+func DeleteAppsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ref, err := parseAppsResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = appsAPIRequest(ctx, meta.(*common.Client), http.MethodDelete, ref.path(), nil, "", nil, nil)
+	diag, _ := common.CheckReadError("apps resource", d, err)
+	return diag
+}
+
+// appsResourceRef identifies an object of the app platform.
+type appsResourceRef struct {
+	APIVersion string
+	Namespace  string
+	Plural     string
+	Name       string
+}
+
+func (r appsResourceRef) path() string {
+	return fmt.Sprintf("apis/%s/namespaces/%s/%s/%s", r.APIVersion, r.Namespace, r.Plural, r.Name)
+}
+
+// id returns the resource ID: <group>/<version>/<namespace>/<plural>/<name>
+func (r appsResourceRef) id() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.APIVersion, r.Namespace, r.Plural, r.Name)
+}
+
+func parseAppsResourceID(id string) (appsResourceRef, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 5 {
+		return appsResourceRef{}, fmt.Errorf("invalid ID %q, expected <group>/<version>/<namespace>/<plural>/<name>", id)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return appsResourceRef{}, fmt.Errorf("invalid ID %q, expected <group>/<version>/<namespace>/<plural>/<name>", id)
+		}
+	}
+	return appsResourceRef{
+		APIVersion: parts[0] + "/" + parts[1],
+		Namespace:  parts[2],
+		Plural:     parts[3],
+		Name:       parts[4],
+	}, nil
+}
+
+// parseAppsManifest parses a JSON or YAML manifest. The result is normalized to the types of a JSON document, so that manifests can be compared.
+func parseAppsManifest(s string) (map[string]interface{}, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	var manifest map[string]interface{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: must be an object")
+	}
+	return manifest, nil
+}
+
+// appsResourceRefFromManifest returns the reference of the object of a manifest. The plural defaults to the lowercased kind followed by `s`.
+func appsResourceRefFromManifest(manifest map[string]interface{}, plural string) (appsResourceRef, error) {
+	apiVersion, _ := manifest["apiVersion"].(string)
+	if parts := strings.Split(apiVersion, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return appsResourceRef{}, fmt.Errorf("`apiVersion` must be set, in the <group>/<version> format")
+	}
+	kind, _ := manifest["kind"].(string)
+	if kind == "" {
+		return appsResourceRef{}, fmt.Errorf("`kind` must be set")
+	}
+	metadata, _ := manifest["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		return appsResourceRef{}, fmt.Errorf("`metadata.name` must be set")
+	}
+	namespace, _ := metadata["namespace"].(string)
+	if namespace == "" {
+		namespace = appsResourceDefaultNS
+	}
+	if plural == "" {
+		plural = strings.ToLower(kind) + "s"
+	}
+	return appsResourceRef{APIVersion: apiVersion, Namespace: namespace, Plural: plural, Name: name}, nil
+}
+
+// projectAppsObject returns the fields of the live object that are set in the configured manifest.
+// Lists are compared as a whole, their items are projected only when both lists have the same length.
+func projectAppsObject(configured, live interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		projected := map[string]interface{}{}
+		for k, v := range c {
+			if lv, ok := l[k]; ok {
+				projected[k] = projectAppsObject(v, lv)
+			}
+		}
+		return projected
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(c) {
+			return live
+		}
+		projected := make([]interface{}, len(l))
+		for i := range l {
+			projected[i] = projectAppsObject(c[i], l[i])
+		}
+		return projected
+	default:
+		return live
+	}
+}
+
+// cleanAppsObject removes the status and the metadata set by Grafana from an object, so that it can be used as a manifest.
+func cleanAppsObject(live map[string]interface{}) map[string]interface{} {
+	manifest := map[string]interface{}{}
+	for k, v := range live {
+		if k != "status" {
+			manifest[k] = v
+		}
+	}
+	metadata, ok := live["metadata"].(map[string]interface{})
+	if !ok {
+		return manifest
+	}
+	cleanMetadata := map[string]interface{}{}
+	for _, k := range []string{"name", "namespace", "labels"} {
+		if v, ok := metadata[k]; ok {
+			cleanMetadata[k] = v
+		}
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		cleanAnnotations := map[string]interface{}{}
+		for k, v := range annotations {
+			if !strings.HasPrefix(k, "grafana.app/") {
+				cleanAnnotations[k] = v
+			}
+		}
+		if len(cleanAnnotations) > 0 {
+			cleanMetadata["annotations"] = cleanAnnotations
+		}
+	}
+	manifest["metadata"] = cleanMetadata
+	return manifest
+}
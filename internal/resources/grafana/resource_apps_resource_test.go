@@ -0,0 +1,106 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAppsResource_playlist(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.0.0") // The playlist app API is enabled by default since Grafana 11
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	playlist := models.Playlist{UID: name}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      playlistCheckExists.destroyed(&playlist, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsResourcePlaylistJSON(name, "My Playlist", "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAppsResourceCheckPlaylist(name, "My Playlist", "5m"),
+					resource.TestCheckResourceAttr("grafana_apps_resource.test", "id", "playlist.grafana.app/v0alpha1/default/playlists/"+name),
+					resource.TestCheckResourceAttr("grafana_apps_resource.test", "plural", "playlists"),
+					resource.TestCheckResourceAttrSet("grafana_apps_resource.test", "uid"),
+					resource.TestCheckResourceAttrSet("grafana_apps_resource.test", "resource_version"),
+				),
+			},
+			// YAML manifest, with an update
+			{
+				Config: testAccAppsResourcePlaylistYAML(name, "My Updated Playlist", "10m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAppsResourceCheckPlaylist(name, "My Updated Playlist", "10m"),
+					resource.TestCheckResourceAttr("grafana_apps_resource.test", "id", "playlist.grafana.app/v0alpha1/default/playlists/"+name),
+				),
+			},
+			{
+				ResourceName:            "grafana_apps_resource.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest", "force_conflicts"},
+			},
+		},
+	})
+}
+
+func testAccAppsResourceCheckPlaylist(uid, title, interval string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutils.Provider.Meta().(*common.Client).GrafanaOAPI.WithOrgID(1)
+		resp, err := client.Playlists.GetPlaylist(uid)
+		if err != nil {
+			return fmt.Errorf("error getting playlist %s: %w", uid, err)
+		}
+		if resp.Payload.Name != title || resp.Payload.Interval != interval {
+			return fmt.Errorf("playlist %s has name %q and interval %q, expected %q and %q", uid, resp.Payload.Name, resp.Payload.Interval, title, interval)
+		}
+		return nil
+	}
+}
+
+func testAccAppsResourcePlaylistJSON(name, title, interval string) string {
+	return fmt.Sprintf(`
+resource "grafana_apps_resource" "test" {
+	manifest = jsonencode({
+		apiVersion = "playlist.grafana.app/v0alpha1"
+		kind       = "Playlist"
+		metadata = {
+			name = "%s"
+		}
+		spec = {
+			title    = "%s"
+			interval = "%s"
+			items = [
+				{
+					type  = "dashboard_by_tag"
+					value = "terraform"
+				},
+			]
+		}
+	})
+}`, name, title, interval)
+}
+
+func testAccAppsResourcePlaylistYAML(name, title, interval string) string {
+	return fmt.Sprintf(`
+resource "grafana_apps_resource" "test" {
+	manifest = <<EOT
+apiVersion: playlist.grafana.app/v0alpha1
+kind: Playlist
+metadata:
+  name: %s
+spec:
+  title: %s
+  interval: %s
+  items:
+    - type: dashboard_by_tag
+      value: terraform
+EOT
+}`, name, title, interval)
+}
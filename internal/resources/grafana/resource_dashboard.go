@@ -4,12 +4,18 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"net/url"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/grafana/grafana-openapi-client-go/client/dashboards"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 )
@@ -18,8 +24,14 @@ var (
 	StoreDashboardSHA256 bool
 )
 
+const (
+	dashboardOverwriteAlways      = "always"
+	dashboardOverwriteIfUnchanged = "if_unchanged"
+	dashboardOverwriteNever       = "never"
+)
+
 func ResourceDashboard() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 
 		Description: `
 Manages Grafana dashboards.
@@ -32,11 +44,18 @@ Manages Grafana dashboards.
 		ReadContext:   ReadDashboard,
 		UpdateContext: UpdateDashboard,
 		DeleteContext: DeleteDashboard,
+		CustomizeDiff: customdiff.All(
+			setJSONFromConfigURL("config_json", NormalizeDashboardConfigJSON),
+			suppressDashboardIgnoredJSONPaths,
+			validateDashboardDeprecatedPanels,
+			validateDashboardFolder,
+			setDashboardMetadata,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: withConfigURLAttributes("config_json", map[string]*schema.Schema{
 			"org_id": orgIDAttribute(),
 			"uid": {
 				Type:     schema.TypeString,
@@ -55,62 +74,144 @@ Manages Grafana dashboards.
 				Computed:    true,
 				Description: "The full URL of the dashboard.",
 			},
+			"render_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of a PNG image of the dashboard, rendered by the Grafana image renderer. Query parameters such as `from`, `to`, `width` and `height` can be appended to it.",
+			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
 				Description: "Whenever you save a version of your dashboard, a copy of that version is saved " +
-					"so that previous versions of your dashboard are not lost.",
+					"so that previous versions of your dashboard are not lost. " +
+					"When `overwrite` is set to `if_unchanged`, this is the version last applied by Terraform.",
 			},
-			"folder": {
+			"title": {
 				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The id or UID of the folder to save the dashboard in.",
+				Computed:    true,
+				Description: "The title of the dashboard, from its JSON model. It's known at plan time when the JSON is.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tags of the dashboard, from its JSON model. They're known at plan time when the JSON is.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"panel_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The number of panels of the dashboard, including the panels of collapsed rows and the panels of `panels_json`. Rows aren't counted. " +
+					"It's known at plan time when the JSON is.",
+			},
+			"folder": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The id or UID of the folder to save the dashboard in. " +
+					"The folder must be in the organization of the dashboard: this is checked at plan time when the folder is known.",
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					_, old = SplitOrgResourceID(old)
 					_, new = SplitOrgResourceID(new)
 					return old == "0" && new == "" || old == "" && new == "0" || old == new
 				},
 			},
+			"create_folder_if_missing": createFolderIfMissingAttribute("folder"),
+			"create_folder_title":      createFolderTitleAttribute(),
 			"config_json": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				StateFunc:    NormalizeDashboardConfigJSON,
 				ValidateFunc: validateDashboardConfigJSON,
-				Description:  "The complete dashboard model JSON.",
+				Description:  "The complete dashboard model JSON. Either `config_json` or `config_url` must be set.",
+			},
+			"panels_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "**Experimental.** A list of panel model JSONs appended to the panels of `config_json`. " +
+					"This allows assembling a dashboard from panel fragments owned by different modules. " +
+					"The provider lays out these panels below the panels of `config_json`, left to right: " +
+					"only the `w` and `h` properties of their `gridPos` are used (defaulting to 12 and 8). The width can't be more than 24, the width of the dashboard grid.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDashboardPanelJSON,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return normalizeDashboardPanelJSON(old) == normalizeDashboardPanelJSON(new)
+					},
+				},
+			},
+			"ignored_json_paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Paths of the dashboard model whose values are maintained in Grafana, e.g. in the UI, rather than by Terraform. " +
+					"Their values in `config_json` are ignored by the diff, and replaced by the current values in Grafana when the dashboard is updated. " +
+					"The paths are JSON pointers, e.g. `/time`, or JSONPaths made of names, indexes and wildcards, e.g. `$.panels[*].fieldConfig.defaults.thresholds`. " +
+					"The values in `config_json` are used when the dashboard is created. Can't be used when the provider's `store_dashboard_sha256` is set, since the ignored values can't be compared with a hash.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDashboardJSONPath,
+				},
 			},
 			"overwrite": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Description: "Set to true if you want to overwrite existing dashboard with newer version, same dashboard title in folder or same dashboard uid.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Controls what happens when the dashboard conflicts with one that already exists in Grafana. " +
+					"`always` overwrites any existing dashboard with the same title in the folder or the same uid, as well as changes made outside of Terraform. " +
+					"`if_unchanged` makes updates fail if the dashboard was modified in Grafana (for example, in the UI) since Terraform last applied it. " +
+					"`never` never sets the overwrite flag: creation fails if a conflicting dashboard exists and updates fail if the dashboard was modified since it was last read by Terraform. " +
+					"When unset, creation fails on conflicts and updates always overwrite. " +
+					"The legacy values `true` and `false` are equivalent to `always` and unset.",
+				ValidateFunc: validation.StringInSlice([]string{
+					dashboardOverwriteAlways,
+					dashboardOverwriteIfUnchanged,
+					dashboardOverwriteNever,
+					"true",
+					"false",
+				}, false),
 			},
 			"message": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Set a commit message for the version history.",
 			},
+		}),
+		// The state upgrader from version 0 was removed in v2. To upgrade, users can first upgrade to the last v1 release, apply, then upgrade to v2.
+		SchemaVersion: 2,
+	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 1,
+			Type:    resourceDashboardV1(r.Schema).CoreConfigSchema().ImpliedType(),
+			Upgrade: upgradeDashboardOverwriteV1,
 		},
-		SchemaVersion: 1, // The state upgrader was removed in v2. To upgrade, users can first upgrade to the last v1 release, apply, then upgrade to v2.
 	}
+	return r
 }
 
 func CreateDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	dashboard, err := makeDashboard(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	diags := append(dashboardDeprecatedPanelsWarnings(d, meta), dashboardStrictSchemaWarnings(ctx, meta, orgID, dashboard.Dashboard.(map[string]interface{}))...)
+	if err := ensureFolderExists(client, d, dashboard.FolderUID); err != nil {
+		return diag.FromErr(err)
+	}
 	resp, err := client.Dashboards.PostDashboard(&dashboard)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(MakeOrgResourceID(orgID, *resp.Payload.UID))
-	return ReadDashboard(ctx, d, meta)
+	d.Set("version", *resp.Payload.Version)
+	return append(diags, ReadDashboard(ctx, d, meta)...)
 }
 
 func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.Dashboards.GetDashboardByUID(uid)
 	if err, shouldReturn := common.CheckReadError("dashboard", d, err); shouldReturn {
@@ -123,8 +224,16 @@ func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}
 	d.Set("org_id", strconv.FormatInt(orgID, 10))
 	d.Set("uid", model["uid"].(string))
 	d.Set("dashboard_id", int64(model["id"].(float64)))
-	d.Set("version", int64(model["version"].(float64)))
+	// In `if_unchanged` mode, the version is the one last applied by Terraform.
+	// It's compared against Grafana's version on the next update.
+	if dashboardOverwriteMode(d) != dashboardOverwriteIfUnchanged || d.Get("version").(int) == 0 {
+		d.Set("version", int64(model["version"].(float64)))
+	}
+	for k, v := range dashboardMetadata(model) {
+		d.Set(k, v)
+	}
 	d.Set("url", metaClient.GrafanaSubpath(dashboard.Meta.URL))
+	d.Set("render_url", dashboardViewURL(metaClient, dashboard.Meta.URL, "render/d", url.Values{"orgId": {strconv.FormatInt(orgID, 10)}}))
 
 	// If the folder was originally set to a numeric ID, we read the folder ID
 	// Othwerwise, we read the folder UID
@@ -146,6 +255,28 @@ func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	configJSON := d.Get("config_json").(string)
 
+	// Panels added from `panels_json` are at the end of the dashboard's panels.
+	// Split them out, so that they don't show up as a diff on `config_json`.
+	if panelFragments := d.Get("panels_json").([]interface{}); len(panelFragments) > 0 {
+		panels, _ := remoteDashJSON["panels"].([]interface{})
+		split := len(panels) - len(panelFragments)
+		if split < 0 {
+			split = 0
+		}
+		var fragments []string
+		for _, panel := range panels[split:] {
+			fragments = append(fragments, normalizeDashboardPanelJSON(panel))
+		}
+		d.Set("panels_json", fragments)
+
+		remoteDashJSON["panels"] = panels[:split]
+		if configuredDashJSON, err := UnmarshalDashboardConfigJSON(configJSON); err == nil && split == 0 {
+			if _, ok := configuredDashJSON["panels"]; !ok {
+				delete(remoteDashJSON, "panels")
+			}
+		}
+	}
+
 	// Skip if configJSON string is a sha256 hash
 	// If `uid` is not set in configuration, we need to delete it from the
 	// dashboard JSON we just read from the Grafana API. This is so it does not
@@ -168,32 +299,185 @@ func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}
 }
 
 func UpdateDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	if !d.HasChangesExcept("ignored_json_paths") {
+		// The paths only apply to the next changes
+		return ReadDashboard(ctx, d, meta)
+	}
 
 	dashboard, err := makeDashboard(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := preserveDashboardIgnoredJSONPaths(client, d, dashboard.Dashboard.(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := append(dashboardDeprecatedPanelsWarnings(d, meta), dashboardStrictSchemaWarnings(ctx, meta, orgID, dashboard.Dashboard.(map[string]interface{}))...)
+	if err := ensureFolderExists(client, d, dashboard.FolderUID); err != nil {
+		return diag.FromErr(err)
+	}
 	dashboard.Dashboard.(map[string]interface{})["id"] = d.Get("dashboard_id").(int)
-	dashboard.Overwrite = true
+	switch dashboardOverwriteMode(d) {
+	case dashboardOverwriteIfUnchanged, dashboardOverwriteNever:
+		// Grafana rejects the save if its version differs from the one we send
+		dashboard.Dashboard.(map[string]interface{})["version"] = d.Get("version").(int)
+	default:
+		dashboard.Overwrite = true
+	}
 	resp, err := client.Dashboards.PostDashboard(&dashboard)
 	if err != nil {
+		var preconditionErr *dashboards.PostDashboardPreconditionFailed
+		if errors.As(err, &preconditionErr) {
+			return diag.Errorf("dashboard %q was modified in Grafana since Terraform last applied it (version %d). "+
+				"Review the changes, then either copy them into `config_json` or set `overwrite = \"always\"` to discard them: %v",
+				d.Get("uid").(string), d.Get("version").(int), err)
+		}
 		return diag.FromErr(err)
 	}
 	d.SetId(MakeOrgResourceID(orgID, *resp.Payload.UID))
-	return ReadDashboard(ctx, d, meta)
+	d.Set("version", *resp.Payload.Version)
+	return append(diags, ReadDashboard(ctx, d, meta)...)
 }
 
 func DeleteDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	_, deleteErr := client.Dashboards.DeleteDashboardByUID(uid)
 	err, _ := common.CheckReadError("dashboard", d, deleteErr)
 	return err
 }
 
+// validateDashboardFolder checks at plan time that the folder of the dashboard is in the organization of the dashboard.
+// A folder referenced by its resource ID (`<org ID>:<folder ID>`) must have the same org ID, and the folder must exist in that org, unless it's created by `create_folder_if_missing`.
+// The check is skipped when the folder isn't known yet, or when the plan-time requests are disabled by `skip_version_check`.
+func validateDashboardFolder(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("folder") || !d.NewValueKnown("org_id") || (d.Id() != "" && !d.HasChange("folder") && !d.HasChange("org_id")) {
+		return nil
+	}
+	folderOrgID, folder := SplitOrgResourceID(d.Get("folder").(string))
+	if folder == "" || folder == "0" {
+		return nil
+	}
+	client, ok := meta.(*common.Client)
+	if !ok || client.GrafanaOAPI == nil || client.SkipVersionCheck {
+		return nil
+	}
+
+	orgID, _ := strconv.ParseInt(d.Get("org_id").(string), 10, 64)
+	if orgID == 0 {
+		orgID = client.GrafanaOAPI.OrgID()
+	}
+	dashboardDesc := "the dashboard"
+	if configJSON, err := UnmarshalDashboardConfigJSON(d.Get("config_json").(string)); err == nil {
+		if uid, ok := configJSON["uid"].(string); ok && uid != "" {
+			dashboardDesc = fmt.Sprintf("dashboard %q", uid)
+		}
+	}
+	if folderOrgID > 0 && orgID > 0 && folderOrgID != orgID {
+		return fmt.Errorf("%s is in org %d, but its folder %q is in org %d: "+
+			"set the `org_id` of the dashboard to the `org_id` of the folder, or use a folder of org %d", dashboardDesc, orgID, folder, folderOrgID, orgID)
+	}
+	if d.Get("create_folder_if_missing").(bool) {
+		return nil
+	}
+
+	oapi := client.GrafanaOAPI.Clone()
+	if orgID > 0 {
+		oapi = client.GrafanaOAPIWithOrgID(orgID)
+	}
+	oapi = common.OAPIWithContext(ctx, oapi)
+	var err error
+	if folderID, parseErr := strconv.ParseInt(folder, 10, 64); parseErr == nil {
+		_, err = oapi.Folders.GetFolderByID(folderID)
+	} else {
+		_, err = oapi.Folders.GetFolderByUID(folder)
+	}
+	if err != nil && common.IsNotFoundError(err) {
+		return fmt.Errorf("the folder %q of %s doesn't exist in org %d: "+
+			"check that the folder and the dashboard have the same `org_id`, or set `create_folder_if_missing`", folder, dashboardDesc, orgID)
+	} else if err != nil {
+		// The check is best effort, e.g. the credentials may not be allowed to read the folder
+		log.Printf("[WARN] failed to check the folder %q of %s: %v", folder, dashboardDesc, err)
+	}
+	return nil
+}
+
+// setDashboardMetadata sets the attributes read from the JSON model of the dashboard at plan time, so that other resources can reference them.
+func setDashboardMetadata(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	setComputed := func() error {
+		for _, k := range []string{"title", "tags", "panel_count"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if !d.NewValueKnown("config_json") || !d.NewValueKnown("panels_json") {
+		return setComputed()
+	}
+	if d.Id() != "" && !d.HasChange("config_json") && !d.HasChange("panels_json") {
+		return nil
+	}
+
+	dashboardJSON, err := UnmarshalDashboardConfigJSON(d.Get("config_json").(string))
+	if err != nil {
+		// The attributes are read from Grafana after the dashboard is saved
+		return setComputed()
+	}
+	if err := appendDashboardPanels(dashboardJSON, d.Get("panels_json").([]interface{})); err != nil {
+		return err
+	}
+	for k, v := range dashboardMetadata(dashboardJSON) {
+		if err := d.SetNew(k, v); err != nil {
+			return err
+		}
+	}
+	if uid, ok := dashboardJSON["uid"].(string); ok && uid != "" {
+		return d.SetNew("uid", uid)
+	}
+	return nil
+}
+
+// dashboardMetadata returns the `title`, `tags` and `panel_count` attributes of a dashboard from its JSON model.
+func dashboardMetadata(dashboardJSON map[string]interface{}) map[string]interface{} {
+	title, _ := dashboardJSON["title"].(string)
+	tags := []string{}
+	if tagList, ok := dashboardJSON["tags"].([]interface{}); ok {
+		for _, tag := range tagList {
+			if tag, ok := tag.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	var countPanels func(panels interface{}) int
+	countPanels = func(panels interface{}) int {
+		panelList, _ := panels.([]interface{})
+		count := 0
+		for _, panel := range panelList {
+			panelMap, ok := panel.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if panelMap["type"] == "row" {
+				// Collapsed rows contain their panels
+				count += countPanels(panelMap["panels"])
+				continue
+			}
+			count++
+		}
+		return count
+	}
+
+	return map[string]interface{}{
+		"title":       title,
+		"tags":        tags,
+		"panel_count": countPanels(dashboardJSON["panels"]),
+	}
+}
+
 func makeDashboard(d *schema.ResourceData) (models.SaveDashboardCommand, error) {
 	dashboard := models.SaveDashboardCommand{
-		Overwrite: d.Get("overwrite").(bool),
+		Overwrite: dashboardOverwriteMode(d) == dashboardOverwriteAlways,
 		Message:   d.Get("message").(string),
 	}
 
@@ -210,10 +494,131 @@ func makeDashboard(d *schema.ResourceData) (models.SaveDashboardCommand, error)
 		return dashboard, err
 	}
 	delete(dashboardJSON, "id")
+	if err := appendDashboardPanels(dashboardJSON, d.Get("panels_json").([]interface{})); err != nil {
+		return dashboard, err
+	}
 	dashboard.Dashboard = dashboardJSON
 	return dashboard, nil
 }
 
+const (
+	dashboardGridWidth          = 24
+	dashboardPanelDefaultWidth  = 12
+	dashboardPanelDefaultHeight = 8
+)
+
+// appendDashboardPanels appends the panels from `panels_json` to the dashboard model.
+// They are packed left to right, in rows, below the panels already in the dashboard.
+func appendDashboardPanels(dashboardJSON map[string]interface{}, fragments []interface{}) error {
+	if len(fragments) == 0 {
+		return nil
+	}
+
+	panels, _ := dashboardJSON["panels"].([]interface{})
+	x, y, rowHeight := 0, 0, 0
+	for _, panel := range panels {
+		if panelMap, ok := panel.(map[string]interface{}); ok {
+			gridPos, _ := panelMap["gridPos"].(map[string]interface{})
+			panelY, _ := gridPos["y"].(float64)
+			panelH, _ := gridPos["h"].(float64)
+			if bottom := int(panelY + panelH); bottom > y {
+				y = bottom
+			}
+		}
+	}
+
+	for i, fragment := range fragments {
+		panel := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(normalizeDashboardPanelJSON(fragment)), &panel); err != nil {
+			return fmt.Errorf("invalid panels_json[%d]: %w", i, err)
+		}
+		gridPos := panel["gridPos"].(map[string]interface{})
+		w, h := int(gridPos["w"].(float64)), int(gridPos["h"].(float64))
+		// The fragment may not have been validated if it was unknown at plan time
+		if w > dashboardGridWidth {
+			return fmt.Errorf("invalid panels_json[%d]: the width of the panel is %d, but the dashboard grid is %d wide", i, w, dashboardGridWidth)
+		}
+		if x+w > dashboardGridWidth {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+		gridPos["x"], gridPos["y"] = x, y
+		x += w
+		if h > rowHeight {
+			rowHeight = h
+		}
+		panels = append(panels, panel)
+	}
+
+	dashboardJSON["panels"] = panels
+	return nil
+}
+
+// normalizeDashboardPanelJSON normalizes a panel from `panels_json`.
+// The panel's ID and position are managed by Grafana and the provider, only its size is kept.
+func normalizeDashboardPanelJSON(panel interface{}) string {
+	var panelJSON map[string]interface{}
+	switch p := panel.(type) {
+	case map[string]interface{}:
+		panelJSON = p
+	case string:
+		if err := json.Unmarshal([]byte(p), &panelJSON); err != nil {
+			return p
+		}
+	}
+	if panelJSON == nil {
+		return ""
+	}
+
+	delete(panelJSON, "id")
+	gridPos, _ := panelJSON["gridPos"].(map[string]interface{})
+	w, ok := gridPos["w"].(float64)
+	if !ok || w <= 0 {
+		w = dashboardPanelDefaultWidth
+	}
+	h, ok := gridPos["h"].(float64)
+	if !ok || h <= 0 {
+		h = dashboardPanelDefaultHeight
+	}
+	panelJSON["gridPos"] = map[string]interface{}{"w": w, "h": h}
+
+	j, _ := json.Marshal(panelJSON)
+	return string(j)
+}
+
+// resourceDashboardV1 is the schema of the dashboards whose `overwrite` attribute was a boolean, from the current schema.
+func resourceDashboardV1(current map[string]*schema.Schema) *schema.Resource {
+	v1 := map[string]*schema.Schema{}
+	for k, v := range current {
+		v1[k] = v
+	}
+	v1["overwrite"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	return &schema.Resource{Schema: v1}
+}
+
+// upgradeDashboardOverwriteV1 converts the boolean `overwrite` attribute to its legacy string values, which are still accepted.
+func upgradeDashboardOverwriteV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if overwrite, ok := rawState["overwrite"].(bool); ok {
+		rawState["overwrite"] = strconv.FormatBool(overwrite)
+	}
+	return rawState, nil
+}
+
+// dashboardOverwriteMode returns the configured `overwrite` mode, mapping the legacy boolean values.
+// An empty string means the default behavior: no overwrite on creation, always overwrite on update.
+func dashboardOverwriteMode(d *schema.ResourceData) string {
+	switch mode := d.Get("overwrite").(string); mode {
+	case "true":
+		return dashboardOverwriteAlways
+	case "false":
+		return ""
+	default:
+		return mode
+	}
+}
+
 // UnmarshalDashboardConfigJSON is a convenience func for unmarshalling
 // `config_json` field.
 func UnmarshalDashboardConfigJSON(configJSON string) (map[string]interface{}, error) {
@@ -237,6 +642,20 @@ func validateDashboardConfigJSON(config interface{}, k string) ([]string, []erro
 	return nil, nil
 }
 
+// validateDashboardPanelJSON validates a panel from `panels_json`.
+// A panel wider than the dashboard grid can't be laid out.
+func validateDashboardPanelJSON(config interface{}, k string) ([]string, []error) {
+	panelJSON := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(config.(string)), &panelJSON); err != nil {
+		return nil, []error{err}
+	}
+	gridPos, _ := panelJSON["gridPos"].(map[string]interface{})
+	if w, ok := gridPos["w"].(float64); ok && w > dashboardGridWidth {
+		return nil, []error{fmt.Errorf("%s: the width of the panel is %v, but the dashboard grid is %d wide", k, w, dashboardGridWidth)}
+	}
+	return nil, nil
+}
+
 // NormalizeDashboardConfigJSON is the StateFunc for the `config_json` field.
 //
 // It removes the following fields:
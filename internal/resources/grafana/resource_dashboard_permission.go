@@ -26,6 +26,7 @@ Manages the entire set of permissions for a dashboard. Permissions that aren't s
 		ReadContext:   ReadDashboardPermissions,
 		UpdateContext: UpdateDashboardPermissions,
 		DeleteContext: DeleteDashboardPermissions,
+		CustomizeDiff: validateRemovedDefaultRoles,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -92,12 +93,15 @@ Manages the entire set of permissions for a dashboard. Permissions that aren't s
 					},
 				},
 			},
+			"remove_default_viewer_role": removeDefaultRoleAttribute("Viewer", "dashboard"),
+			"remove_default_editor_role": removeDefaultRoleAttribute("Editor", "dashboard"),
+			"effective_permissions":      effectivePermissionsAttribute("dashboard"),
 		},
 	}
 }
 
 func UpdateDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var list []interface{}
 	if v, ok := d.GetOk("permissions"); ok {
@@ -143,7 +147,7 @@ func UpdateDashboardPermissions(ctx context.Context, d *schema.ResourceData, met
 }
 
 func ReadDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	var resp interface {
 		GetPayload() []*models.DashboardACLInfoDTO
 	}
@@ -161,8 +165,16 @@ func ReadDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta
 
 	dashboardPermissions := resp.GetPayload()
 	permissionItems := make([]interface{}, len(dashboardPermissions))
+	effectivePermissions := make([]interface{}, 0, len(dashboardPermissions))
 	count := 0
 	for _, permission := range dashboardPermissions {
+		effectivePermissions = append(effectivePermissions, map[string]interface{}{
+			"role":       permission.Role,
+			"team_id":    strconv.FormatInt(permission.TeamID, 10),
+			"user_id":    strconv.FormatInt(permission.UserID, 10),
+			"permission": permission.PermissionName,
+			"inherited":  permission.Inherited,
+		})
 		if permission.DashboardID != -1 {
 			permissionItem := make(map[string]interface{})
 			permissionItem["role"] = permission.Role
@@ -178,15 +190,16 @@ func ReadDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set("permissions", permissionItems)
+	d.Set("effective_permissions", effectivePermissions)
 
-	return nil
+	return removedDefaultRolesWarnings(d, "dashboard")
 }
 
 func DeleteDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// since permissions are tied to dashboards, we can't really delete the permissions.
 	// we will simply remove all permissions, leaving a dashboard that only an admin can access.
 	// if for some reason the parent dashboard doesn't exist, we'll just ignore the error
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	err := updateDashboardPermissions(client, idStr, &models.UpdateDashboardACLCommand{})
 	diags, _ := common.CheckReadError("dashboard permissions", d, err)
 	return diags
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -149,7 +150,7 @@ func checkDashboardPermissionsEmpty(dashboard *models.DashboardFullWithMeta) res
 }
 
 func checkDashboardPermissions(dashboard *models.DashboardFullWithMeta, expectedPerms []*models.DashboardACLInfoDTO) error {
-	client := grafana.OAPIGlobalClient(testutils.Provider.Meta())
+	client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
 	uid := dashboard.Dashboard.(map[string]interface{})["uid"].(string)
 	resp, err := client.DashboardPermissions.GetDashboardPermissionsListByUID(uid)
 	if err != nil {
@@ -79,7 +79,7 @@ Manages Grafana public dashboards.
 }
 
 func CreatePublicDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	dashboardUID := d.Get("dashboard_uid").(string)
 
 	publicDashboardPayload := makePublicDashboard(d)
@@ -93,7 +93,7 @@ func CreatePublicDashboard(ctx context.Context, d *schema.ResourceData, meta int
 	return ReadPublicDashboard(ctx, d, meta)
 }
 func UpdatePublicDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, compositeID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	dashboardUID, publicDashboardUID, _ := strings.Cut(compositeID, ":")
 
 	publicDashboard := makePublicDashboard(d)
@@ -112,7 +112,7 @@ func UpdatePublicDashboard(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func DeletePublicDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, compositeID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	dashboardUID, publicDashboardUID, _ := strings.Cut(compositeID, ":")
 	_, err := client.DashboardPublic.DeletePublicDashboard(publicDashboardUID, dashboardUID)
 
@@ -131,7 +131,7 @@ func makePublicDashboard(d *schema.ResourceData) *models.PublicDashboardDTO {
 }
 
 func ReadPublicDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, compositeID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	dashboardUID, _, _ := strings.Cut(compositeID, ":")
 
 	resp, err := client.DashboardPublic.GetPublicDashboard(dashboardUID)
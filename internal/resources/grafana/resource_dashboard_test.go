@@ -1,8 +1,10 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -49,6 +51,7 @@ func TestAccDashboard_basic(t *testing.T) {
 							resource.TestCheckResourceAttr("grafana_dashboard.test", "org_id", "1"),
 							resource.TestCheckResourceAttr("grafana_dashboard.test", "uid", "basic"),
 							resource.TestCheckResourceAttr("grafana_dashboard.test", "url", strings.TrimRight(os.Getenv("GRAFANA_URL"), "/")+"/d/basic/terraform-acceptance-test"),
+							resource.TestCheckResourceAttr("grafana_dashboard.test", "render_url", strings.TrimRight(os.Getenv("GRAFANA_URL"), "/")+"/render/d/basic/terraform-acceptance-test?orgId=1"),
 							resource.TestCheckResourceAttr(
 								"grafana_dashboard.test", "config_json", expectedInitialConfig,
 							),
@@ -86,7 +89,7 @@ func TestAccDashboard_basic(t *testing.T) {
 						ResourceName:            "grafana_dashboard.test",
 						ImportState:             true,
 						ImportStateVerify:       true,
-						ImportStateVerifyIgnore: []string{"message"},
+						ImportStateVerifyIgnore: []string{"message", "create_folder_if_missing", "create_folder_title"},
 					},
 				},
 			})
@@ -240,9 +243,10 @@ func TestAccDashboard_folder_uid(t *testing.T) {
 				),
 			},
 			{
-				ImportState:       true,
-				ResourceName:      "grafana_dashboard.test_folder",
-				ImportStateVerify: true,
+				ImportState:             true,
+				ResourceName:            "grafana_dashboard.test_folder",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_folder_if_missing", "create_folder_title"},
 			},
 		},
 	})
@@ -287,6 +291,370 @@ func TestAccDashboard_inOrg(t *testing.T) {
 	})
 }
 
+func TestAccDashboard_folderInOtherOrg(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	orgName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccDashboardInOrganization(orgName), `org_id  = grafana_organization.test.id`, "", 1),
+				ExpectError: regexp.MustCompile(`dashboard "dashboard-` + orgName + `" is in org \d+, but its folder "\d+" is in org 1`),
+			},
+			{
+				Config: testAccDashboardInOrganization(orgName) + fmt.Sprintf(`
+resource "grafana_dashboard" "missing_folder" {
+	org_id      = grafana_organization.test.id
+	folder      = "missing-%[1]s"
+	config_json = jsonencode({
+	  title = "missing-folder-%[1]s"
+	})
+}`, orgName),
+				ExpectError: regexp.MustCompile(`the folder "missing-` + orgName + `" of the dashboard doesn't exist in org \d+`),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_overwriteIfUnchanged(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+	client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardOverwrite(uid, "if_unchanged", "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "overwrite", "if_unchanged"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "version", "1"),
+				),
+			},
+			{
+				// Updates made by Terraform are allowed
+				Config: testAccDashboardOverwrite(uid, "if_unchanged", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "version", "2"),
+				),
+			},
+			{
+				// Simulate an edit in the UI, the next update must fail
+				PreConfig: func() {
+					model := dashboard.Dashboard.(map[string]interface{})
+					model["title"] = "edited in the UI"
+					if _, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{Dashboard: model, Overwrite: true}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      testAccDashboardOverwrite(uid, "if_unchanged", "updated again"),
+				ExpectError: regexp.MustCompile(`was modified in Grafana since Terraform last applied it \(version 2\)`),
+			},
+			{
+				// Discard the UI changes
+				Config: testAccDashboardOverwrite(uid, "always", "updated again"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "config_json", fmt.Sprintf(`{"title":"updated again","uid":"%s"}`, uid)),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "version", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_ignoredJSONPaths(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+	client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	checkModel := func(key, expected string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if v := dashboard.Dashboard.(map[string]interface{})[key]; v != expected {
+				return fmt.Errorf("expected %s to be %q, got %v", key, expected, v)
+			}
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardIgnoredJSONPaths(uid, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					checkModel("refresh", "5s"),
+				),
+			},
+			{
+				// Simulate an edit of the ignored paths in the UI, which doesn't show up in the plan
+				PreConfig: func() {
+					model := dashboard.Dashboard.(map[string]interface{})
+					model["refresh"] = "1m"
+					model["time"] = map[string]interface{}{"from": "now-6h", "to": "now"}
+					if _, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{Dashboard: model, Overwrite: true}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDashboardIgnoredJSONPaths(uid, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					checkModel("refresh", "1m"),
+				),
+			},
+			{
+				// The edit is preserved by the updates made by Terraform
+				Config: testAccDashboardIgnoredJSONPaths(uid, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					checkModel("title", "updated"),
+					checkModel("refresh", "1m"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "config_json",
+						fmt.Sprintf(`{"refresh":"1m","time":{"from":"now-6h","to":"now"},"title":"updated","uid":"%s"}`, uid)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_panelsJSON(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	config_json = jsonencode({
+		title  = "%[1]s"
+		uid    = "%[1]s"
+		panels = [{ title = "base", type = "text", gridPos = { x = 0, y = 0, w = 24, h = 4 } }]
+	})
+	panels_json = [
+		jsonencode({ title = "a", type = "text" }),
+		jsonencode({ title = "b", type = "text", gridPos = { w = 12, h = 6 } }),
+		jsonencode({ title = "c", type = "text", gridPos = { w = 24 } }),
+	]
+}`, uid),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "panels_json.#", "3"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "panel_count", "4"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "config_json", fmt.Sprintf(
+						`{"panels":[{"gridPos":{"h":4,"w":24,"x":0,"y":0},"title":"base","type":"text"}],"title":"%[1]s","uid":"%[1]s"}`, uid,
+					)),
+					func(s *terraform.State) error {
+						expected := map[string][2]float64{"base": {0, 0}, "a": {0, 4}, "b": {12, 4}, "c": {0, 12}}
+						panels := dashboard.Dashboard.(map[string]interface{})["panels"].([]interface{})
+						if len(panels) != len(expected) {
+							return fmt.Errorf("expected %d panels, got %d", len(expected), len(panels))
+						}
+						for _, panel := range panels {
+							panel := panel.(map[string]interface{})
+							gridPos := panel["gridPos"].(map[string]interface{})
+							if pos := expected[panel["title"].(string)]; gridPos["x"] != pos[0] || gridPos["y"] != pos[1] {
+								return fmt.Errorf("panel %s: expected position %v, got %v", panel["title"], pos, gridPos)
+							}
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	config_json = jsonencode({
+		title = "%[1]s"
+		uid   = "%[1]s"
+	})
+	panels_json = [
+		jsonencode({ title = "a", type = "text", gridPos = { w = 25 } }),
+	]
+}`, uid),
+				ExpectError: regexp.MustCompile(`the width of the panel is 25, but the dashboard grid is 24 wide`),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_metadata(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	config_json = jsonencode({
+		title  = "%[1]s"
+		uid    = "%[1]s"
+		tags   = ["team-a", "prod"]
+		panels = [
+			{ title = "a", type = "text", gridPos = { x = 0, y = 0, w = 24, h = 4 } },
+			{ title = "row", type = "row", collapsed = true, gridPos = { x = 0, y = 4, w = 24, h = 1 }, panels = [
+				{ title = "b", type = "text", gridPos = { x = 0, y = 5, w = 24, h = 4 } },
+			] },
+		]
+	})
+}
+
+// The metadata is known at plan time, so it can be used in the keys of for_each
+resource "grafana_playlist" "test" {
+	for_each = toset(grafana_dashboard.test.tags)
+	name     = "%[1]s-${each.key}"
+	interval = "5m"
+
+	item {
+		order = 1
+		title = grafana_dashboard.test.title
+		type  = "dashboard_by_uid"
+		value = grafana_dashboard.test.uid
+	}
+}`, uid),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "title", uid),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "tags.0", "team-a"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "tags.1", "prod"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "panel_count", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_playlist.test[\"prod\"]", "item.*", map[string]string{
+						"title": uid,
+						"value": uid,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_deprecatedPanels(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+	config := func(mode, panelType string) string {
+		return fmt.Sprintf(`
+provider "grafana" {
+	dashboard_deprecated_panels = "%[2]s"
+}
+
+resource "grafana_dashboard" "test" {
+	config_json = jsonencode({
+		title  = "%[1]s"
+		uid    = "%[1]s"
+		panels = [{
+			title  = "row"
+			type   = "row"
+			panels = [{ title = "requests", type = "%[3]s" }]
+		}]
+	})
+}`, uid, mode, panelType)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			// Deprecated panels nested in rows are detected
+			{
+				Config:      config("fail", "graph"),
+				ExpectError: regexp.MustCompile(`panel "requests" is of type "graph", use "timeseries" instead`),
+			},
+			{
+				Config: config("warn", "graph"),
+				Check:  dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+			},
+			{
+				Config: config("fail", "timeseries"),
+				Check:  dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_strictSchema(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			// The findings are warnings, the dashboard is still applied
+			{
+				Config: fmt.Sprintf(`
+provider "grafana" {
+	strict_schema = true
+}
+
+resource "grafana_dashboard" "test" {
+	config_json = jsonencode({
+		title         = "%[1]s"
+		uid           = "%[1]s"
+		schemaVersion = 99
+		unknownField  = true
+		panels        = [{ title = "requests", type = "not-installed-panel" }]
+	})
+}`, uid),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "uid", uid),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_configURL(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	// The content of config_url is fetched in the unit tests of the package, this checks the validation of the attributes
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "grafana_dashboard" "test" {
+	config_url    = "http://grafana.com/api/dashboards/1860/revisions/37/download"
+	config_sha256 = "` + strings.Repeat("0", 64) + `"
+}`,
+				ExpectError: regexp.MustCompile(`expected "config_url" to have a url with schema of: "https"`),
+			},
+			{
+				Config: `
+resource "grafana_dashboard" "test" {
+	config_url = "https://grafana.com/api/dashboards/1860/revisions/37/download"
+}`,
+				ExpectError: regexp.MustCompile(`"config_url": all of ` + "`config_sha256,config_url`" + ` must be specified`),
+			},
+		},
+	})
+}
+
 func testAccDashboardCheckExistsInFolder(dashboard *models.DashboardFullWithMeta, folder *models.Folder) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if dashboard.Meta.FolderID != folder.ID && folder.ID != 0 {
@@ -377,6 +745,30 @@ resource "grafana_dashboard" "test_folder" {
 }`, uid, folderRef)
 }
 
+func testAccDashboardOverwrite(uid, overwrite, title string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	overwrite   = "%[2]s"
+	config_json = jsonencode({
+		"title" : "%[3]s",
+		"uid" : "%[1]s"
+	})
+}`, uid, overwrite, title)
+}
+
+func testAccDashboardIgnoredJSONPaths(uid, title string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	ignored_json_paths = ["/time", "$.refresh"]
+	config_json = jsonencode({
+		"title" : "%[2]s",
+		"uid" : "%[1]s",
+		"refresh" : "5s",
+		"time" : { "from" : "now-1h", "to" : "now" }
+	})
+}`, uid, title)
+}
+
 func testAccDashboardInOrganization(orgName string) string {
 	return fmt.Sprintf(`
 resource "grafana_organization" "test" {
@@ -0,0 +1,32 @@
+package grafana
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestUpgradeDashboardOverwriteV1(t *testing.T) {
+	upgrader := ResourceDashboard().StateUpgraders[0]
+	if overwrite := upgrader.Type.AttributeType("overwrite"); overwrite != cty.Bool {
+		t.Fatalf("expected the version 1 overwrite attribute to be a boolean, got %s", overwrite.FriendlyName())
+	}
+
+	for _, tc := range []struct {
+		overwrite interface{}
+		expected  interface{}
+	}{
+		{overwrite: true, expected: "true"},
+		{overwrite: false, expected: "false"},
+		{overwrite: nil, expected: nil},
+	} {
+		state, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"overwrite": tc.overwrite}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if state["overwrite"] != tc.expected {
+			t.Errorf("expected overwrite %v to be upgraded to %v, got %v", tc.overwrite, tc.expected, state["overwrite"])
+		}
+	}
+}
@@ -2,8 +2,11 @@ package grafana
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -31,13 +34,14 @@ source selected (via the 'type' argument).
 		UpdateContext: UpdateDataSource,
 		DeleteContext: DeleteDataSource,
 		ReadContext:   ReadDataSource,
+		CustomizeDiff: customizeDiffDataSourceUID,
 		SchemaVersion: 1,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: withDataSourceAuthAttributes(map[string]*schema.Schema{
 			"org_id": orgIDAttribute(),
 			"uid": {
 				Type:        schema.TypeString,
@@ -46,6 +50,24 @@ source selected (via the 'type' argument).
 				ForceNew:    true,
 				Description: "Unique identifier. If unset, this will be automatically generated.",
 			},
+			"generate_uid_from_name": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"uid"},
+				Description:   "Derive the UID from the name of the data source, so that a data source with the same name has the same UID in every Grafana instance. The UID is the slugified name followed by a hash of the name. Renaming the data source replaces it.",
+			},
+			"uid_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"generate_uid_from_name"},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, maxDataSourceUIDPrefixLength),
+					validation.StringMatch(common.UIDRegexp, "must only contain letters, numbers, dashes and underscores"),
+				),
+				Description: "Prefix of the UID generated from the name. Only used when `generate_uid_from_name` is set.",
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -139,13 +161,13 @@ source selected (via the 'type' argument).
 					return common.SuppressEquivalentJSONDiffs(k, oldValue, newValue, d)
 				},
 			},
-		},
+		}),
 	}
 }
 
 // CreateDataSource creates a Grafana datasource
 func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	dataSource, err := makeDataSource(d)
 	if err != nil {
@@ -158,12 +180,12 @@ func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	d.SetId(MakeOrgResourceID(orgID, resp.Payload.Datasource.ID))
-	return ReadDataSource(ctx, d, meta)
+	return append(dataSourceStrictSchemaWarnings(ctx, meta, orgID, dataSource.Type), ReadDataSource(ctx, d, meta)...)
 }
 
 // UpdateDataSource updates a Grafana datasource
 func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	dataSource, err := makeDataSource(d)
 	if err != nil {
@@ -184,20 +206,26 @@ func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 		User:            dataSource.User,
 		WithCredentials: dataSource.WithCredentials,
 	}
-	_, err = client.Datasources.UpdateDataSourceByID(idStr, &body)
-
-	return diag.FromErr(err)
+	if _, err = client.Datasources.UpdateDataSourceByID(idStr, &body); err != nil {
+		return diag.FromErr(err)
+	}
+	return dataSourceStrictSchemaWarnings(ctx, meta, orgID, dataSource.Type)
 }
 
+// dataSourceImportNamePrefix marks the IDs given to `terraform import` that are data source names rather than IDs or UIDs.
+const dataSourceImportNamePrefix = "name/"
+
 // ReadDataSource reads a Grafana datasource
 func ReadDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	var resp interface{ GetPayload() *models.DataSource }
 	var err error
-	// Support both numerical and UID IDs, so that we can import an existing datasource with either.
+	// Support numerical IDs, UIDs and names (prefixed with `name/`), so that we can import an existing datasource with any of them.
 	// Following the read, it's normalized to a numerical ID.
-	if _, parseErr := strconv.ParseInt(idStr, 10, 64); parseErr == nil {
+	if name, ok := strings.CutPrefix(idStr, dataSourceImportNamePrefix); ok {
+		resp, err = client.Datasources.GetDataSourceByName(name)
+	} else if _, parseErr := strconv.ParseInt(idStr, 10, 64); parseErr == nil {
 		resp, err = client.Datasources.GetDataSourceByID(idStr)
 	} else {
 		resp, err = client.Datasources.GetDataSourceByUID(idStr)
@@ -212,7 +240,7 @@ func ReadDataSource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 // DeleteDataSource deletes a Grafana datasource
 func DeleteDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	_, err := client.Datasources.DeleteDataSourceByID(idStr)
 	diag, _ := common.CheckReadError("datasource", d, err)
@@ -232,6 +260,7 @@ func readDatasource(d *schema.ResourceData, dataSource *models.DataSource) diag.
 	d.Set("org_id", strconv.FormatInt(dataSource.OrgID, 10))
 
 	gottenJSONData, gottenHeaders := removeHeadersFromJSONData(dataSource.JSONData.(map[string]interface{}))
+	removeAuthFromJSONData(d, gottenJSONData)
 	encodedJSONData, err := json.Marshal(gottenJSONData)
 	if err != nil {
 		return diag.Errorf("Failed to marshal JSON data: %s", err)
@@ -272,6 +301,9 @@ func makeDataSource(d *schema.ResourceData) (*models.AddDataSourceCommand, error
 	}
 
 	jd, sd = jsonDataWithHeaders(jd, sd, httpHeaders)
+	if err := jsonDataWithAuth(d, jd, sd); err != nil {
+		return nil, err
+	}
 
 	return &models.AddDataSourceCommand{
 		Name:           d.Get("name").(string),
@@ -283,7 +315,7 @@ func makeDataSource(d *schema.ResourceData) (*models.AddDataSourceCommand, error
 		IsDefault:      d.Get("is_default").(bool),
 		BasicAuth:      d.Get("basic_auth_enabled").(bool),
 		BasicAuthUser:  d.Get("basic_auth_username").(string),
-		UID:            d.Get("uid").(string),
+		UID:            dataSourceUID(d),
 		JSONData:       jd,
 		SecureJSONData: sd,
 	}, err
@@ -347,3 +379,51 @@ func removeHeadersFromJSONData(input map[string]interface{}) (map[string]interfa
 
 	return jsonData, headers
 }
+
+const (
+	// Grafana rejects UIDs longer than 40 characters
+	maxDataSourceUIDLength       = 40
+	maxDataSourceUIDPrefixLength = 16
+	dataSourceUIDHashLength      = 8
+)
+
+var dataSourceUIDSlugRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// GenerateDataSourceUID derives a UID from the name of a data source: <prefix><slugified name>-<hash of the name>.
+// The slug is truncated so that the UID fits in the length allowed by Grafana, the hash keeps it unique.
+func GenerateDataSourceUID(prefix, name string) string {
+	hash := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(hash[:])[:dataSourceUIDHashLength]
+
+	slug := strings.Trim(dataSourceUIDSlugRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if maxSlugLength := maxDataSourceUIDLength - len(prefix) - len(suffix) - 1; len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	if slug == "" {
+		return prefix + suffix
+	}
+	return prefix + slug + "-" + suffix
+}
+
+// dataSourceUID returns the UID to send to the API: the generated one if `generate_uid_from_name` is set, the configured one otherwise.
+func dataSourceUID(d *schema.ResourceData) string {
+	if d.Get("generate_uid_from_name").(bool) {
+		return GenerateDataSourceUID(d.Get("uid_prefix").(string), d.Get("name").(string))
+	}
+	return d.Get("uid").(string)
+}
+
+// customizeDiffDataSourceUID plans the generated UID, so that it's known before apply and renaming the data source replaces it.
+func customizeDiffDataSourceUID(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("generate_uid_from_name").(bool) {
+		return nil
+	}
+	if !d.NewValueKnown("name") || !d.NewValueKnown("uid_prefix") {
+		return d.SetNewComputed("uid")
+	}
+	uid := GenerateDataSourceUID(d.Get("uid_prefix").(string), d.Get("name").(string))
+	if uid == d.Get("uid").(string) {
+		return nil
+	}
+	return d.SetNew("uid", uid)
+}
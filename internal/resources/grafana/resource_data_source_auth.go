@@ -0,0 +1,367 @@
+package grafana
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var awsRoleARNRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+
+// dataSourceAuthField maps an attribute of an auth block to a key of the JSON data (or of the secure JSON data) of a data source.
+type dataSourceAuthField struct {
+	attr   string
+	key    string
+	secure bool
+	// defaultValue is the value Grafana uses when the key isn't set, which is read into the state instead of an empty value.
+	defaultValue string
+}
+
+// dataSourceAuthKeys are the keys an auth block is written to, for some data source types.
+type dataSourceAuthKeys struct {
+	fields []dataSourceAuthField
+	// enabledKey is a boolean key of the JSON data which enables the authentication. No key is set if empty.
+	enabledKey string
+}
+
+// dataSourceAuthBlock is a typed block setting the authentication keys of cloud data sources, instead of setting them in `json_data_encoded`.
+type dataSourceAuthBlock struct {
+	name string
+	// jsonDataKey is the JSON data object the keys are nested in. The keys are at the top level of the JSON data if empty.
+	jsonDataKey string
+	fields      []dataSourceAuthField
+	// keysByType are the keys of the data source types which don't use the fields above.
+	keysByType map[string]dataSourceAuthKeys
+	validate   func(values map[string]interface{}) error
+}
+
+// keys returns the keys the block is written to for a type of data source.
+func (b dataSourceAuthBlock) keys(dataSourceType string) dataSourceAuthKeys {
+	if keys, ok := b.keysByType[dataSourceType]; ok {
+		return keys
+	}
+	return dataSourceAuthKeys{fields: b.fields}
+}
+
+// awsSigV4AuthKeys are the keys of the SigV4 authentication of HTTP data sources, such as Prometheus.
+var awsSigV4AuthKeys = dataSourceAuthKeys{
+	fields: []dataSourceAuthField{
+		{attr: "auth_type", key: "sigV4AuthType", defaultValue: "default"},
+		{attr: "default_region", key: "sigV4Region"},
+		{attr: "assume_role_arn", key: "sigV4AssumeRoleArn"},
+		{attr: "external_id", key: "sigV4ExternalId"},
+		{attr: "profile", key: "sigV4Profile"},
+		{attr: "access_key", key: "sigV4AccessKey", secure: true},
+		{attr: "secret_key", key: "sigV4SecretKey", secure: true},
+	},
+	enabledKey: "sigV4Auth",
+}
+
+var dataSourceAuthBlocks = []dataSourceAuthBlock{
+	{
+		name:        "azure_auth",
+		jsonDataKey: "azureCredentials",
+		fields: []dataSourceAuthField{
+			{attr: "auth_type", key: "authType"},
+			{attr: "cloud", key: "azureCloud", defaultValue: "AzureCloud"},
+			{attr: "tenant_id", key: "tenantId"},
+			{attr: "client_id", key: "clientId"},
+			{attr: "client_secret", key: "azureClientSecret", secure: true},
+		},
+		validate: func(values map[string]interface{}) error {
+			if values["auth_type"] == "clientsecret" {
+				return requireDataSourceAuthAttrs("azure_auth", "clientsecret", values, "tenant_id", "client_id", "client_secret")
+			}
+			return nil
+		},
+	},
+	{
+		name: "gcp_auth",
+		fields: []dataSourceAuthField{
+			{attr: "authentication_type", key: "authenticationType"},
+			{attr: "default_project", key: "defaultProject"},
+			{attr: "client_email", key: "clientEmail"},
+			{attr: "token_uri", key: "tokenUri", defaultValue: "https://oauth2.googleapis.com/token"},
+			{attr: "private_key", key: "privateKey", secure: true},
+		},
+		validate: func(values map[string]interface{}) error {
+			if values["authentication_type"] == "jwt" {
+				return requireDataSourceAuthAttrs("gcp_auth", "jwt", values, "client_email", "default_project", "private_key")
+			}
+			return nil
+		},
+	},
+	{
+		name: "aws_auth",
+		fields: []dataSourceAuthField{
+			{attr: "auth_type", key: "authType", defaultValue: "default"},
+			{attr: "default_region", key: "defaultRegion"},
+			{attr: "assume_role_arn", key: "assumeRoleArn"},
+			{attr: "external_id", key: "externalId"},
+			{attr: "profile", key: "profile"},
+			{attr: "access_key", key: "accessKey", secure: true},
+			{attr: "secret_key", key: "secretKey", secure: true},
+		},
+		keysByType: map[string]dataSourceAuthKeys{
+			"prometheus":                          awsSigV4AuthKeys,
+			"grafana-amazonprometheus-datasource": awsSigV4AuthKeys,
+		},
+		validate: func(values map[string]interface{}) error {
+			switch {
+			case values["auth_type"] == "keys":
+				return requireDataSourceAuthAttrs("aws_auth", "keys", values, "access_key", "secret_key")
+			case values["external_id"] != "" && values["assume_role_arn"] == "":
+				return fmt.Errorf("aws_auth: external_id is only used with assume_role_arn")
+			}
+			return nil
+		},
+	},
+}
+
+// withDataSourceAuthAttributes adds the `azure_auth`, `gcp_auth` and `aws_auth` blocks to the schema of data sources.
+func withDataSourceAuthAttributes(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range dataSourceAuthAttributes() {
+		resourceSchema[k] = v
+	}
+	return resourceSchema
+}
+
+func dataSourceAuthAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"azure_auth": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"gcp_auth", "aws_auth"},
+			Description:   "Authentication of Azure data sources (e.g. Azure Monitor, or Prometheus with Azure authentication), set in the `azureCredentials` JSON data.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"auth_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"msi", "workloadidentity", "clientsecret"}, false),
+						Description:  "The authentication method: `msi` (managed identity), `workloadidentity` or `clientsecret` (app registration).",
+					},
+					"cloud": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "AzureCloud",
+						ValidateFunc: validation.StringInSlice([]string{"AzureCloud", "AzureChinaCloud", "AzureUSGovernment"}, false),
+						Description:  "The Azure cloud: `AzureCloud`, `AzureChinaCloud` or `AzureUSGovernment`.",
+					},
+					"tenant_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The ID of the Azure AD tenant. Required with `clientsecret`.",
+					},
+					"client_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The client ID of the app registration, or of the user-assigned managed identity. Required with `clientsecret`.",
+					},
+					"client_secret": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The client secret of the app registration. Required with `clientsecret`.",
+					},
+				},
+			},
+		},
+		"gcp_auth": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"azure_auth", "aws_auth"},
+			Description:   "Authentication of Google Cloud data sources (e.g. Google Cloud Monitoring or BigQuery).",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"authentication_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"jwt", "gce"}, false),
+						Description:  "The authentication method: `jwt` (service account key) or `gce` (default service account of the GCE VM or GKE workload, with Application Default Credentials).",
+					},
+					"default_project": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The default project of the queries. Required with `jwt`.",
+					},
+					"client_email": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The email of the service account. Required with `jwt`.",
+					},
+					"token_uri": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "https://oauth2.googleapis.com/token",
+						Description: "The token URI of the service account key.",
+					},
+					"private_key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The private key of the service account key, in PEM format. Required with `jwt`.",
+					},
+				},
+			},
+		},
+		"aws_auth": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"azure_auth", "gcp_auth"},
+			Description:   "Authentication of AWS data sources (e.g. CloudWatch or Athena). For `prometheus` and `grafana-amazonprometheus-datasource` data sources, it sets their SigV4 authentication (the `sigV4*` JSON data keys) instead.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"auth_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "default",
+						ValidateFunc: validation.StringInSlice([]string{"default", "keys", "credentials", "ec2_iam_role", "grafana_assume_role"}, false),
+						Description:  "The authentication provider: `default` (AWS SDK default), `keys` (access and secret keys), `credentials` (credentials file), `ec2_iam_role` or `grafana_assume_role` (Grafana Cloud).",
+					},
+					"default_region": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The default region of the queries, e.g. `us-east-1`.",
+					},
+					"assume_role_arn": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringMatch(awsRoleARNRegexp, "must be the ARN of an IAM role"),
+						Description:  "The ARN of the IAM role to assume.",
+					},
+					"external_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The external ID required by the trust policy of the role to assume.",
+					},
+					"profile": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The profile of the credentials file, with `credentials`.",
+					},
+					"access_key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The access key ID. Required with `keys`.",
+					},
+					"secret_key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The secret access key. Required with `keys`.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func requireDataSourceAuthAttrs(block, authType string, values map[string]interface{}, attrs ...string) error {
+	var missing []string
+	for _, attr := range attrs {
+		if values[attr] == "" {
+			missing = append(missing, attr)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s: %s must be set with %s authentication", block, strings.Join(missing, ", "), authType)
+	}
+	return nil
+}
+
+// jsonDataWithAuth sets the keys of the configured auth blocks in the JSON data and secure JSON data of a data source.
+// The keys can't also be set in `json_data_encoded` or `secure_json_data_encoded`, which would be overwritten.
+func jsonDataWithAuth(d *schema.ResourceData, jsonData map[string]interface{}, secureJSONData map[string]string) error {
+	dataSourceType := d.Get("type").(string)
+	for _, block := range dataSourceAuthBlocks {
+		list := d.Get(block.name).([]interface{})
+		if len(list) == 0 || list[0] == nil {
+			continue
+		}
+		values := list[0].(map[string]interface{})
+		if err := block.validate(values); err != nil {
+			return err
+		}
+
+		target := jsonData
+		if block.jsonDataKey != "" {
+			if _, ok := jsonData[block.jsonDataKey]; ok {
+				return fmt.Errorf("%s is set by the %s block, it can't also be set in json_data_encoded", block.jsonDataKey, block.name)
+			}
+			target = map[string]interface{}{}
+			jsonData[block.jsonDataKey] = target
+		}
+		keys := block.keys(dataSourceType)
+		if keys.enabledKey != "" {
+			if _, ok := target[keys.enabledKey]; ok {
+				return fmt.Errorf("%s is set by the %s block, it can't also be set in json_data_encoded", keys.enabledKey, block.name)
+			}
+			target[keys.enabledKey] = true
+		}
+		for _, field := range keys.fields {
+			value := values[field.attr].(string)
+			if field.secure {
+				if _, ok := secureJSONData[field.key]; ok {
+					return fmt.Errorf("%s is set by the %s block, it can't also be set in secure_json_data_encoded", field.key, block.name)
+				}
+				if value != "" {
+					secureJSONData[field.key] = value
+				}
+				continue
+			}
+			if _, ok := target[field.key]; ok {
+				return fmt.Errorf("%s is set by the %s block, it can't also be set in json_data_encoded", field.key, block.name)
+			}
+			if value != "" {
+				target[field.key] = value
+			}
+		}
+	}
+	return nil
+}
+
+// removeAuthFromJSONData reads the keys of the configured auth blocks from the JSON data of a data source, and removes them from it.
+// The secure values aren't returned by the API, they are kept from the state.
+func removeAuthFromJSONData(d *schema.ResourceData, jsonData map[string]interface{}) {
+	dataSourceType := d.Get("type").(string)
+	for _, block := range dataSourceAuthBlocks {
+		list, ok := d.Get(block.name).([]interface{})
+		if !ok || len(list) == 0 || list[0] == nil {
+			continue
+		}
+		current := list[0].(map[string]interface{})
+
+		source := jsonData
+		if block.jsonDataKey != "" {
+			source, _ = jsonData[block.jsonDataKey].(map[string]interface{})
+			delete(jsonData, block.jsonDataKey)
+		}
+		keys := block.keys(dataSourceType)
+		if keys.enabledKey != "" && block.jsonDataKey == "" {
+			delete(jsonData, keys.enabledKey)
+		}
+		values := map[string]interface{}{}
+		for _, field := range keys.fields {
+			if field.secure {
+				values[field.attr] = current[field.attr]
+				continue
+			}
+			values[field.attr], _ = source[field.key].(string)
+			if values[field.attr] == "" {
+				values[field.attr] = field.defaultValue
+			}
+			if block.jsonDataKey == "" {
+				delete(jsonData, field.key)
+			}
+		}
+		d.Set(block.name, []interface{}{values})
+	}
+}
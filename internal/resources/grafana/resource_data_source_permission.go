@@ -86,7 +86,7 @@ Manages the entire set of permissions for a datasource. Permissions that aren't
 }
 
 func UpdateDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var list []interface{}
 	if v, ok := d.GetOk("permissions"); ok {
@@ -121,7 +121,7 @@ func UpdateDatasourcePermissions(ctx context.Context, d *schema.ResourceData, me
 		configuredPermissions = append(configuredPermissions, &permissionItem)
 	}
 
-	if err := updateResourcePermissions(client, datasource.UID, datasourcesPermissionsType, configuredPermissions); err != nil {
+	if _, err := updateResourcePermissions(client, datasource.UID, datasourcesPermissionsType, configuredPermissions); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -131,7 +131,7 @@ func UpdateDatasourcePermissions(ctx context.Context, d *schema.ResourceData, me
 }
 
 func ReadDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, id := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.Datasources.GetDataSourceByID(id)
 	if diag, shouldReturn := common.CheckReadError("data source permissions", d, err); shouldReturn {
@@ -165,7 +165,7 @@ func ReadDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func DeleteDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, id := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.Datasources.GetDataSourceByID(id)
 	if diags, shouldReturn := common.CheckReadError("data source permissions", d, err); shouldReturn {
@@ -173,24 +173,45 @@ func DeleteDatasourcePermissions(ctx context.Context, d *schema.ResourceData, me
 	}
 	datasource := resp.Payload
 
-	err = updateResourcePermissions(client, datasource.UID, datasourcesPermissionsType, []*models.SetResourcePermissionCommand{})
+	_, err = updateResourcePermissions(client, datasource.UID, datasourcesPermissionsType, []*models.SetResourcePermissionCommand{})
 	diags, _ := common.CheckReadError("datasource permissions", d, err)
 	return diags
 }
 
-func updateResourcePermissions(client *goapi.GrafanaHTTPAPI, uid, resourceType string, permissions []*models.SetResourcePermissionCommand) error {
-	areEqual := func(a *models.ResourcePermissionDTO, b *models.SetResourcePermissionCommand) bool {
-		return a.Permission == b.Permission && a.TeamID == b.TeamID && a.UserID == b.UserID && a.BuiltInRole == b.BuiltInRole
-	}
-
+// updateResourcePermissions replaces the managed, non-inherited permissions of a resource with the given ones, in a single call to the bulk permissions endpoint.
+// The endpoint isn't called if the permissions are already the given ones, in which case false is returned.
+func updateResourcePermissions(client *goapi.GrafanaHTTPAPI, uid, resourceType string, permissions []*models.SetResourcePermissionCommand) (bool, error) {
 	listResp, err := client.AccessControl.GetResourcePermissions(uid, resourceType)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	permissionList := resourcePermissionChanges(listResp.Payload, permissions)
+	if len(permissionList) == 0 {
+		return false, nil
+	}
+
+	body := models.SetPermissionsCommand{Permissions: permissionList}
+	params := access_control.NewSetResourcePermissionsParams().
+		WithResource(resourceType).
+		WithResourceID(uid).
+		WithBody(&body)
+	if _, err := client.AccessControl.SetResourcePermissions(params); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// resourcePermissionChanges returns the items to send to the bulk permissions endpoint to go from the current permissions of a resource to the given ones:
+// the permissions to add or update, and the permissions to remove with an empty permission.
+func resourcePermissionChanges(current []*models.ResourcePermissionDTO, permissions []*models.SetResourcePermissionCommand) []*models.SetResourcePermissionCommand {
+	areEqual := func(a *models.ResourcePermissionDTO, b *models.SetResourcePermissionCommand) bool {
+		return a.Permission == b.Permission && a.TeamID == b.TeamID && a.UserID == b.UserID && a.BuiltInRole == b.BuiltInRole
 	}
 
 	var permissionList []*models.SetResourcePermissionCommand
 deleteLoop:
-	for _, current := range listResp.Payload {
+	for _, current := range current {
 		// Only managed and non-inherited permissions can be provisioned through this resource, so we disregard the permissions obtained through custom and fixed roles here
 		if !current.IsManaged || current.IsInherited {
 			continue
@@ -213,7 +234,7 @@ deleteLoop:
 
 addLoop:
 	for _, new := range permissions {
-		for _, current := range listResp.Payload {
+		for _, current := range current {
 			if areEqual(current, new) {
 				continue addLoop
 			}
@@ -222,12 +243,5 @@ addLoop:
 		permissionList = append(permissionList, new)
 	}
 
-	body := models.SetPermissionsCommand{Permissions: permissionList}
-	params := access_control.NewSetResourcePermissionsParams().
-		WithResource(resourceType).
-		WithResourceID(uid).
-		WithBody(&body)
-	_, err = client.AccessControl.SetResourcePermissions(params)
-
-	return err
+	return permissionList
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
 	"github.com/grafana/terraform-provider-grafana/internal/testutils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -115,6 +116,21 @@ func TestAccDataSource_Loki(t *testing.T) {
 					return rs.Primary.Attributes["uid"], nil
 				},
 			},
+			// Test import using name
+			{
+				ResourceName:      "grafana_data_source.loki",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Ignore sensitive attributes, we mostly only care about "json_data_encoded"
+				ImportStateVerifyIgnore: []string{"secure_json_data_encoded", "http_headers."},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafana_data_source.loki"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", "grafana_data_source.loki")
+					}
+					return "name/" + rs.Primary.Attributes["name"], nil
+				},
+			},
 		},
 	})
 }
@@ -232,6 +248,155 @@ func TestAccDataSource_Influx(t *testing.T) {
 	})
 }
 
+func TestAccDataSource_authBlocks(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource models.DataSource
+	dsName := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      datasourceCheckExists.destroyed(&dataSource, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	resource "grafana_data_source" "test" {
+		type = "cloudwatch"
+		name = "%s"
+		aws_auth {
+			default_region  = "eu-west-1"
+			assume_role_arn = "arn:aws:iam::123456789012:role/grafana"
+			external_id     = "external-id"
+		}
+		json_data_encoded = jsonencode({
+			logsTimeout = "30m"
+		})
+	}`, dsName),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "aws_auth.0.auth_type", "default"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "aws_auth.0.default_region", "eu-west-1"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "aws_auth.0.assume_role_arn", "arn:aws:iam::123456789012:role/grafana"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "aws_auth.0.external_id", "external-id"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "json_data_encoded", `{"logsTimeout":"30m"}`),
+					func(s *terraform.State) error {
+						expected := map[string]interface{}{
+							"authType":      "default",
+							"defaultRegion": "eu-west-1",
+							"assumeRoleArn": "arn:aws:iam::123456789012:role/grafana",
+							"externalId":    "external-id",
+							"logsTimeout":   "30m",
+						}
+						if !reflect.DeepEqual(dataSource.JSONData, expected) {
+							return fmt.Errorf("bad JSON data: %#v. Expected: %+v", dataSource.JSONData, expected)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	resource "grafana_data_source" "test" {
+		type = "prometheus"
+		name = "%s"
+		url  = "https://aps-workspaces.eu-west-1.amazonaws.com/workspaces/ws-test"
+		aws_auth {
+			auth_type      = "keys"
+			default_region = "eu-west-1"
+			access_key     = "access"
+			secret_key     = "secret"
+		}
+	}`, dsName),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "aws_auth.0.auth_type", "keys"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "aws_auth.0.default_region", "eu-west-1"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "json_data_encoded", "{}"),
+					func(s *terraform.State) error {
+						expected := map[string]interface{}{
+							"sigV4Auth":     true,
+							"sigV4AuthType": "keys",
+							"sigV4Region":   "eu-west-1",
+						}
+						if !reflect.DeepEqual(dataSource.JSONData, expected) {
+							return fmt.Errorf("bad JSON data: %#v. Expected: %+v", dataSource.JSONData, expected)
+						}
+						if !dataSource.SecureJSONFields["sigV4AccessKey"] || !dataSource.SecureJSONFields["sigV4SecretKey"] {
+							return fmt.Errorf("the SigV4 keys aren't set: %#v", dataSource.SecureJSONFields)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	resource "grafana_data_source" "test" {
+		type = "grafana-azure-monitor-datasource"
+		name = "%s"
+		azure_auth {
+			auth_type     = "clientsecret"
+			tenant_id     = "tenant"
+			client_id     = "client"
+			client_secret = "secret"
+		}
+	}`, dsName),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "azure_auth.0.auth_type", "clientsecret"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "azure_auth.0.cloud", "AzureCloud"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "azure_auth.0.tenant_id", "tenant"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "azure_auth.0.client_id", "client"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "azure_auth.0.client_secret", "secret"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "json_data_encoded", "{}"),
+					func(s *terraform.State) error {
+						expected := map[string]interface{}{
+							"authType":   "clientsecret",
+							"azureCloud": "AzureCloud",
+							"tenantId":   "tenant",
+							"clientId":   "client",
+						}
+						if !reflect.DeepEqual(dataSource.JSONData.(map[string]interface{})["azureCredentials"], expected) {
+							return fmt.Errorf("bad azureCredentials: %#v. Expected: %+v", dataSource.JSONData, expected)
+						}
+						if !dataSource.SecureJSONFields["azureClientSecret"] {
+							return fmt.Errorf("azureClientSecret isn't set: %#v", dataSource.SecureJSONFields)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	resource "grafana_data_source" "test" {
+		type = "stackdriver"
+		name = "%s"
+		gcp_auth {
+			authentication_type = "jwt"
+			default_project     = "project"
+			client_email        = "grafana@project.iam.gserviceaccount.com"
+		}
+	}`, dsName),
+				ExpectError: regexp.MustCompile(`gcp_auth: private_key must be set with jwt authentication`),
+			},
+			{
+				Config: fmt.Sprintf(`
+	resource "grafana_data_source" "test" {
+		type = "stackdriver"
+		name = "%s"
+		gcp_auth {
+			authentication_type = "gce"
+			default_project     = "project"
+		}
+		json_data_encoded = jsonencode({
+			defaultProject = "other-project"
+		})
+	}`, dsName),
+				ExpectError: regexp.MustCompile(`defaultProject is set by the gcp_auth block`),
+			},
+		},
+	})
+}
+
 func TestAccDataSource_changeUID(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t)
 
@@ -271,6 +436,72 @@ func TestAccDataSource_changeUID(t *testing.T) {
 	})
 }
 
+func TestAccDataSource_generateUIDFromName(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource models.DataSource
+	name := acctest.RandomWithPrefix("Generated UID")
+	config := func(name string) string {
+		return fmt.Sprintf(`
+	resource "grafana_data_source" "test" {
+		name                   = "%s"
+		type                   = "prometheus"
+		url                    = "http://localhost:9090"
+		generate_uid_from_name = true
+		uid_prefix             = "tf-"
+	}`, name)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      datasourceCheckExists.destroyed(&dataSource, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "uid", grafana.GenerateDataSourceUID("tf-", name)),
+				),
+			},
+			// Renaming the data source changes its UID
+			{
+				Config: config(name + " renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "uid", grafana.GenerateDataSourceUID("tf-", name+" renamed")),
+				),
+			},
+		},
+	})
+}
+
+func TestGenerateDataSourceUID(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	for _, tc := range []struct {
+		prefix, name, expected string
+	}{
+		{name: "Prometheus", expected: "prometheus-"},
+		{prefix: "prod-", name: "My Loki (EU)", expected: "prod-my-loki-eu-"},
+		{name: "!!!", expected: ""},
+		{prefix: "tf_", name: "A very long data source name that does not fit in a UID", expected: "tf_a-very-long-data-source-name-"},
+	} {
+		uid := grafana.GenerateDataSourceUID(tc.prefix, tc.name)
+		if len(uid) > 40 {
+			t.Errorf("%q: UID %q is longer than 40 characters", tc.name, uid)
+		}
+		if !common.UIDRegexp.MatchString(uid) {
+			t.Errorf("%q: UID %q contains invalid characters", tc.name, uid)
+		}
+		if uid[:len(uid)-8] != tc.expected {
+			t.Errorf("%q: expected UID %q to start with %q", tc.name, uid, tc.expected)
+		}
+		if again := grafana.GenerateDataSourceUID(tc.prefix, tc.name); again != uid {
+			t.Errorf("%q: UID isn't deterministic: %q != %q", tc.name, uid, again)
+		}
+	}
+}
+
 func TestAccDatasource_inOrg(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t)
 
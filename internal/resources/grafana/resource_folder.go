@@ -3,8 +3,11 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/folders"
 	"github.com/grafana/grafana-openapi-client-go/client/search"
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -69,7 +72,7 @@ func ResourceFolder() *schema.Resource {
 }
 
 func CreateFolder(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var body models.CreateFolderCommand
 	if title := d.Get("title").(string); title != "" {
@@ -96,7 +99,7 @@ func CreateFolder(ctx context.Context, d *schema.ResourceData, meta interface{})
 }
 
 func UpdateFolder(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	folder, err := GetFolderByIDorUID(client.Folders, idStr)
 	if err != nil {
@@ -117,7 +120,7 @@ func UpdateFolder(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 func ReadFolder(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	folder, err := GetFolderByIDorUID(client.Folders, idStr)
 	if err, shouldReturn := common.CheckReadError("folder", d, err); shouldReturn {
@@ -135,7 +138,7 @@ func ReadFolder(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 }
 
 func DeleteFolder(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	deleteParams := folders.NewDeleteFolderParams().WithFolderUID(d.Get("uid").(string))
 	if d.Get("prevent_destroy_if_not_empty").(bool) {
 		// Search for dashboards and fail if any are found
@@ -220,3 +223,44 @@ func GetFolderByIDorUID(client folders.ClientService, id string) (*models.Folder
 	}
 	return resp.GetPayload(), nil
 }
+
+// createFolderIfMissingAttribute is used by resources that can create the folder they belong to on demand.
+func createFolderIfMissingAttribute(folderAttribute string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Create the folder referenced by `" + folderAttribute + "` in the same organization if it doesn't exist. " +
+			"The folder is not managed by Terraform: it isn't deleted along with this resource.",
+	}
+}
+
+func createFolderTitleAttribute() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "{{uid}}",
+		Description: "The title of the folder created by `create_folder_if_missing`. The `{{uid}}` placeholder is replaced by the folder UID.",
+	}
+}
+
+// ensureFolderExists creates the folder with the given UID if `create_folder_if_missing` is set and the folder doesn't exist.
+func ensureFolderExists(client *goapi.GrafanaHTTPAPI, d *schema.ResourceData, uid string) error {
+	if !d.Get("create_folder_if_missing").(bool) || uid == "" {
+		return nil
+	}
+
+	_, err := client.Folders.GetFolderByUID(uid)
+	if err == nil || !common.IsNotFoundError(err) {
+		return err
+	}
+
+	body := models.CreateFolderCommand{
+		UID:   uid,
+		Title: strings.ReplaceAll(d.Get("create_folder_title").(string), "{{uid}}", uid),
+	}
+	if _, err := client.Folders.CreateFolder(&body); err != nil {
+		return fmt.Errorf("failed to create folder %q: %w", uid, err)
+	}
+	return nil
+}
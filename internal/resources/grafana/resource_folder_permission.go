@@ -2,12 +2,19 @@ package grafana
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/folders"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 )
@@ -27,6 +34,7 @@ Manages the entire set of permissions for a folder. Permissions that aren't spec
 		ReadContext:   ReadFolderPermissions,
 		UpdateContext: UpdateFolderPermissions,
 		DeleteContext: DeleteFolderPermissions,
+		CustomizeDiff: customdiff.All(validateRemovedDefaultRoles, diffCascadedFolderPermissions),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -82,17 +90,78 @@ Manages the entire set of permissions for a folder. Permissions that aren't spec
 					},
 				},
 			},
+			"cascade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Apply the same permissions to all the descendant folders of the folder. Requires nested folders to be enabled in Grafana: the resource fails otherwise. " +
+					"The permissions of each descendant that differ from the folder's are replaced, then read back to check that they were applied. " +
+					"If the permissions of a descendant drift, or if a folder is added under the folder, the next plan updates the resource to apply them again. " +
+					"The permissions of the descendants are removed along with the folder's when the resource is deleted.",
+			},
+			"cascaded_folder_uids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The UIDs of the descendant folders that have the same permissions as the folder, when `cascade` is set.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"remove_default_viewer_role": removeDefaultRoleAttribute("Viewer", "folder"),
+			"remove_default_editor_role": removeDefaultRoleAttribute("Editor", "folder"),
+			"effective_permissions":      effectivePermissionsAttribute("folder"),
 		},
 	}
 }
 
 func UpdateFolderPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var list []interface{}
 	if v, ok := d.GetOk("permissions"); ok {
 		list = v.(*schema.Set).List()
 	}
+	permissionList := unpackFolderPermissions(list)
+
+	folderUID := d.Get("folder_uid").(string)
+
+	if _, err := updateResourcePermissions(client, folderUID, foldersPermissionsType, permissionList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("cascade").(bool) {
+		descendants, err := listDescendantFolders(client, folderUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// Only the descendants whose permissions were changed are read back
+		var updated []string
+		for _, uid := range descendants {
+			changed, err := updateResourcePermissions(client, uid, foldersPermissionsType, permissionList)
+			if err != nil {
+				return diag.Errorf("failed to update the permissions of descendant folder %s: %v", uid, err)
+			}
+			if changed {
+				updated = append(updated, uid)
+			}
+		}
+		for _, uid := range updated {
+			match, err := folderPermissionsMatch(client, uid, permissionList)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !match {
+				return diag.Errorf("the permissions of descendant folder %s don't match the folder's after being updated", uid)
+			}
+		}
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, folderUID))
+
+	return ReadFolderPermissions(ctx, d, meta)
+}
+
+func unpackFolderPermissions(list []interface{}) []*models.SetResourcePermissionCommand {
 	var permissionList []*models.SetResourcePermissionCommand
 	for _, permission := range list {
 		permission := permission.(map[string]interface{})
@@ -113,20 +182,27 @@ func UpdateFolderPermissions(ctx context.Context, d *schema.ResourceData, meta i
 		permissionItem.Permission = permission["permission"].(string)
 		permissionList = append(permissionList, &permissionItem)
 	}
+	return permissionList
+}
 
-	folderUID := d.Get("folder_uid").(string)
-
-	if err := updateResourcePermissions(client, folderUID, foldersPermissionsType, permissionList); err != nil {
-		return diag.FromErr(err)
+// flattenEffectiveFolderPermissions lists all the permissions of a folder, including the ones obtained through custom and fixed roles,
+// which aren't managed by the resource.
+func flattenEffectiveFolderPermissions(permissions []*models.ResourcePermissionDTO) []interface{} {
+	effectivePermissions := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		effectivePermissions = append(effectivePermissions, map[string]interface{}{
+			"role":       permission.BuiltInRole,
+			"team_id":    strconv.FormatInt(permission.TeamID, 10),
+			"user_id":    strconv.FormatInt(permission.UserID, 10),
+			"permission": permission.Permission,
+			"inherited":  permission.IsInherited,
+		})
 	}
-
-	d.SetId(MakeOrgResourceID(orgID, folderUID))
-
-	return ReadFolderPermissions(ctx, d, meta)
+	return effectivePermissions
 }
 
 func ReadFolderPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, folderUID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, folderUID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.AccessControl.GetResourcePermissions(folderUID, foldersPermissionsType)
 	if err, shouldReturn := common.CheckReadError("folder permissions", d, err); shouldReturn {
@@ -153,7 +229,48 @@ func ReadFolderPermissions(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("org_id", strconv.FormatInt(orgID, 10))
 	d.Set("folder_uid", folderUID)
 	d.Set("permissions", permissionItems)
+	d.Set("effective_permissions", flattenEffectiveFolderPermissions(folderPermissions))
 
+	var cascadedFolderUIDs []string
+	if d.Get("cascade").(bool) {
+		descendants, err := listDescendantFolders(client, folderUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		permissionList := unpackFolderPermissions(permissionItems)
+		for _, uid := range descendants {
+			match, err := folderPermissionsMatch(client, uid, permissionList)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if match {
+				cascadedFolderUIDs = append(cascadedFolderUIDs, uid)
+			}
+		}
+	}
+	d.Set("cascaded_folder_uids", cascadedFolderUIDs)
+
+	return removedDefaultRolesWarnings(d, "folder")
+}
+
+// diffCascadedFolderPermissions plans an update when a descendant folder isn't in `cascaded_folder_uids`,
+// i.e. when its permissions drifted or when it was added under the folder, so that the permissions are applied to it again.
+func diffCascadedFolderPermissions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("cascade").(bool) {
+		return nil
+	}
+	client, _, folderUID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+	descendants, err := listDescendantFolders(client, folderUID)
+	if err != nil {
+		return err
+	}
+	cascadedFolderUIDs := d.Get("cascaded_folder_uids").(*schema.Set)
+	for _, uid := range descendants {
+		if !cascadedFolderUIDs.Contains(uid) {
+			log.Printf("[DEBUG] descendant folder %s of folder %s doesn't have its permissions", uid, folderUID)
+			return d.SetNewComputed("cascaded_folder_uids")
+		}
+	}
 	return nil
 }
 
@@ -161,10 +278,85 @@ func DeleteFolderPermissions(ctx context.Context, d *schema.ResourceData, meta i
 	// since permissions are tied to folders, we can't really delete the permissions.
 	// we will simply remove all permissions, leaving a folder that only an admin can access.
 	// if for some reason the parent folder doesn't exist, we'll just ignore the error
-	client, _, folderUID := OAPIClientFromExistingOrgResource(meta, d.Id())
-	err := updateResourcePermissions(client, folderUID, foldersPermissionsType, []*models.SetResourcePermissionCommand{})
-	diags, _ := common.CheckReadError("folder permissions", d, err)
-	return diags
+	client, _, folderUID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+	_, err := updateResourcePermissions(client, folderUID, foldersPermissionsType, []*models.SetResourcePermissionCommand{})
+	if diags, shouldReturn := common.CheckReadError("folder permissions", d, err); shouldReturn {
+		return diags
+	}
+
+	if d.Get("cascade").(bool) {
+		descendants, err := listDescendantFolders(client, folderUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, uid := range descendants {
+			if _, err := updateResourcePermissions(client, uid, foldersPermissionsType, []*models.SetResourcePermissionCommand{}); err != nil && !common.IsNotFoundError(err) {
+				return diag.Errorf("failed to remove the permissions of descendant folder %s: %v", uid, err)
+			}
+		}
+	}
+	return nil
+}
+
+// listDescendantFolders lists the UIDs of all the folders nested under the given folder, at any depth.
+// Grafana ignores the parent folder of the listing when nested folders aren't enabled, and lists the top level folders instead:
+// an error is returned in that case, rather than applying permissions to unrelated folders.
+func listDescendantFolders(client *goapi.GrafanaHTTPAPI, folderUID string) ([]string, error) {
+	var descendants []string
+	visited := map[string]bool{folderUID: true}
+	parents := []string{folderUID}
+	for len(parents) > 0 {
+		parentUID := parents[0]
+		parents = parents[1:]
+		var page int64 = 1
+		for {
+			params := folders.NewGetFoldersParams().WithParentUID(&parentUID).WithPage(&page)
+			resp, err := client.Folders.GetFolders(params)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list the folders under folder %s: %w", parentUID, err)
+			}
+			if len(resp.Payload) == 0 {
+				break
+			}
+			for _, f := range resp.Payload {
+				if f.ParentUID != parentUID {
+					return nil, fmt.Errorf("folder %s was listed under folder %s, but its parent is %q: `cascade` requires nested folders to be enabled in Grafana", f.UID, parentUID, f.ParentUID)
+				}
+				// A folder can't be its own ancestor, but a cycle mustn't make the listing loop forever
+				if visited[f.UID] {
+					continue
+				}
+				visited[f.UID] = true
+				descendants = append(descendants, f.UID)
+				parents = append(parents, f.UID)
+			}
+			page++
+		}
+	}
+	return descendants, nil
+}
+
+// folderPermissionsMatch checks that the managed, non-inherited permissions of a folder are exactly the given ones.
+func folderPermissionsMatch(client *goapi.GrafanaHTTPAPI, folderUID string, permissions []*models.SetResourcePermissionCommand) (bool, error) {
+	resp, err := client.AccessControl.GetResourcePermissions(folderUID, foldersPermissionsType)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the permissions of folder %s: %w", folderUID, err)
+	}
+
+	key := func(role string, teamID, userID int64, permission string) string {
+		return fmt.Sprintf("%s/%d/%d/%s", role, teamID, userID, permission)
+	}
+	expected := map[string]bool{}
+	for _, p := range permissions {
+		expected[key(p.BuiltInRole, p.TeamID, p.UserID, p.Permission)] = true
+	}
+	actual := map[string]bool{}
+	for _, p := range resp.Payload {
+		if p.IsManaged && !p.IsInherited {
+			actual[key(p.BuiltInRole, p.TeamID, p.UserID, p.Permission)] = true
+		}
+	}
+	return reflect.DeepEqual(expected, actual), nil
 }
 
 func parsePermissionType(permission string) models.PermissionType {
@@ -179,3 +371,84 @@ func parsePermissionType(permission string) models.PermissionType {
 	}
 	return permissionInt
 }
+
+// removeDefaultRoleAttribute is used by permission resources to state that a basic role must not have access to the resource.
+// Grafana grants access to the Viewer and Editor roles on new folders and dashboards.
+func removeDefaultRoleAttribute(role, resourceType string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: fmt.Sprintf("Make sure that the `%[1]s` role has no access to the %[2]s. "+
+			"Grafana grants access to the `%[1]s` role on new %[2]ss: this access is removed unless a `permissions` item for the role is set, which conflicts with this attribute. "+
+			"A warning is emitted if the role still has access to the %[2]s through inherited permissions.", role, resourceType),
+	}
+}
+
+func effectivePermissionsAttribute(resourceType string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: fmt.Sprintf("All the permissions that apply to the %[1]s, including the ones inherited from its parent folders and the ones that aren't managed by this resource.", resourceType),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"team_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"user_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"permission": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"inherited": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// validateRemovedDefaultRoles prevents granting permissions to a role that `remove_default_<role>_role` removes.
+func validateRemovedDefaultRoles(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, role := range []string{"Viewer", "Editor"} {
+		attribute := "remove_default_" + strings.ToLower(role) + "_role"
+		if !d.Get(attribute).(bool) {
+			continue
+		}
+		for _, permission := range d.Get("permissions").(*schema.Set).List() {
+			if permission.(map[string]interface{})["role"].(string) == role {
+				return fmt.Errorf("permissions can't contain an item for the %s role when %s is set", role, attribute)
+			}
+		}
+	}
+	return nil
+}
+
+func removedDefaultRolesWarnings(d *schema.ResourceData, resourceType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, role := range []string{"Viewer", "Editor"} {
+		if !d.Get("remove_default_" + strings.ToLower(role) + "_role").(bool) {
+			continue
+		}
+		for _, permission := range d.Get("effective_permissions").([]interface{}) {
+			permission := permission.(map[string]interface{})
+			if permission["role"].(string) == role {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("The %s role still has access to the %s", role, resourceType),
+					Detail:   fmt.Sprintf("The %s role has %s access to the %s through a permission that isn't managed by this resource, most likely inherited from a parent folder.", role, permission["permission"], resourceType),
+				})
+			}
+		}
+	}
+	return diags
+}
@@ -0,0 +1,31 @@
+package grafana
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+func TestFlattenEffectiveFolderPermissions(t *testing.T) {
+	permissions := []*models.ResourcePermissionDTO{
+		// Managed permission of the folder
+		{TeamID: 2, Permission: "Edit", IsManaged: true},
+		// Managed permission inherited from a parent folder
+		{BuiltInRole: "Viewer", Permission: "View", IsManaged: true, IsInherited: true},
+		// Permission granted by a fixed role
+		{BuiltInRole: "Admin", Permission: "Admin", RoleName: "fixed:folders:admin"},
+		// Permission granted by a custom role
+		{UserID: 3, Permission: "View", RoleName: "custom:folders:reader"},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"role": "", "team_id": "2", "user_id": "0", "permission": "Edit", "inherited": false},
+		map[string]interface{}{"role": "Viewer", "team_id": "0", "user_id": "0", "permission": "View", "inherited": true},
+		map[string]interface{}{"role": "Admin", "team_id": "0", "user_id": "0", "permission": "Admin", "inherited": false},
+		map[string]interface{}{"role": "", "team_id": "0", "user_id": "3", "permission": "View", "inherited": false},
+	}
+	if actual := flattenEffectiveFolderPermissions(permissions); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -1,12 +1,18 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strconv"
 	"testing"
 
+	"github.com/grafana/grafana-openapi-client-go/client/access_control"
 	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
 	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -73,6 +79,172 @@ func TestAccFolderPermission_basic(t *testing.T) {
 	})
 }
 
+func TestAccFolderPermission_removeDefaultRoles(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0")
+
+	var folder models.Folder
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderPermissionConfigRemoveDefaultRoles(name, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.test", &folder),
+					resource.TestCheckResourceAttr("grafana_folder_permission.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_folder_permission.test", "effective_permissions.*", map[string]string{
+						"role":       "",
+						"permission": "View",
+						"inherited":  "false",
+					}),
+					// The Admin role has access to the folder through a fixed role, which isn't managed by the resource
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_folder_permission.test", "effective_permissions.*", map[string]string{
+						"role":       "Admin",
+						"permission": "Admin",
+					}),
+					checkFolderPermissionsNoRole("grafana_folder_permission.test", "Viewer", "Editor"),
+				),
+			},
+			{
+				Config: testAccFolderPermissionConfigRemoveDefaultRoles(name, `
+  permissions {
+    role       = "Viewer"
+    permission = "View"
+  }`),
+				ExpectError: regexp.MustCompile("permissions can't contain an item for the Viewer role when remove_default_viewer_role is set"),
+			},
+		},
+	})
+}
+
+func TestAccFolderPermission_cascade(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.3.0") // Nested folders
+
+	var child models.Folder
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderPermissionConfigCascade(name, "View"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.child", &child),
+					resource.TestCheckResourceAttr("grafana_folder_permission.test", "cascade", "true"),
+					resource.TestCheckResourceAttr("grafana_folder_permission.test", "cascaded_folder_uids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("grafana_folder_permission.test", "cascaded_folder_uids.*", "grafana_folder.child", "uid"),
+					resource.TestCheckTypeSetElemAttrPair("grafana_folder_permission.test", "cascaded_folder_uids.*", "grafana_folder.grandchild", "uid"),
+				),
+			},
+			// Update the permissions of the whole tree
+			{
+				Config: testAccFolderPermissionConfigCascade(name, "Edit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_folder_permission.test", "cascaded_folder_uids.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_folder_permission.test", "permissions.*", map[string]string{
+						"role":       "Viewer",
+						"permission": "Edit",
+					}),
+				),
+			},
+			// The permissions of a descendant are removed outside of Terraform: the plan applies them again
+			{
+				PreConfig: func() {
+					client := testutils.Provider.Meta().(*common.Client).GrafanaOAPI
+					params := access_control.NewSetResourcePermissionsParams().
+						WithResource("folders").
+						WithResourceID(child.UID).
+						WithBody(&models.SetPermissionsCommand{Permissions: []*models.SetResourcePermissionCommand{{BuiltInRole: "Viewer", Permission: ""}}})
+					if _, err := client.AccessControl.SetResourcePermissions(params); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccFolderPermissionConfigCascade(name, "Edit"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccFolderPermissionConfigCascade(name, "Edit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_folder_permission.test", "cascade", "true"),
+					resource.TestCheckResourceAttr("grafana_folder_permission.test", "cascaded_folder_uids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("grafana_folder_permission.test", "cascaded_folder_uids.*", "grafana_folder.child", "uid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFolderPermissionConfigCascade(name, permission string) string {
+	return fmt.Sprintf(`
+resource "grafana_folder" "parent" {
+  title = "%[1]s"
+}
+
+resource "grafana_folder" "child" {
+  title             = "%[1]s-child"
+  parent_folder_uid = grafana_folder.parent.uid
+}
+
+resource "grafana_folder" "grandchild" {
+  title             = "%[1]s-grandchild"
+  parent_folder_uid = grafana_folder.child.uid
+}
+
+resource "grafana_folder_permission" "test" {
+  folder_uid = grafana_folder.parent.uid
+  cascade    = true
+
+  permissions {
+    role       = "Viewer"
+    permission = "%[2]s"
+  }
+
+  depends_on = [grafana_folder.grandchild]
+}
+`, name, permission)
+}
+
+func checkFolderPermissionsNoRole(rn string, roles ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		attributes := s.RootModule().Resources[rn].Primary.Attributes
+		count, _ := strconv.Atoi(attributes["effective_permissions.#"])
+		for i := 0; i < count; i++ {
+			for _, role := range roles {
+				if attributes[fmt.Sprintf("effective_permissions.%d.role", i)] == role {
+					return fmt.Errorf("the %s role still has access to the folder", role)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccFolderPermissionConfigRemoveDefaultRoles(name, extraPermissions string) string {
+	return fmt.Sprintf(`
+resource "grafana_folder" "test" {
+  title = "%[1]s"
+}
+
+resource "grafana_team" "test" {
+  name = "%[1]s"
+}
+
+resource "grafana_folder_permission" "test" {
+  folder_uid                 = grafana_folder.test.uid
+  remove_default_viewer_role = true
+  remove_default_editor_role = true
+
+  permissions {
+    team_id    = grafana_team.test.id
+    permission = "View"
+  }
+  %[2]s
+}
+`, name, extraPermissions)
+}
+
 func checkFolderPermissionsSet(folder *models.Folder, team *models.TeamDTO, user *models.UserProfileDTO, sa *models.ServiceAccountDTO) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		expectedPerms := []*models.DashboardACLInfoDTO{
@@ -109,7 +281,7 @@ func checkFolderPermissionsEmpty(folder *models.Folder) resource.TestCheckFunc {
 }
 
 func checkFolderPermissions(folder *models.Folder, expectedPerms []*models.DashboardACLInfoDTO) error {
-	client := grafana.OAPIGlobalClient(testutils.Provider.Meta())
+	client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
 	resp, err := client.FolderPermissions.GetFolderPermissionList(folder.UID)
 	if err != nil {
 		return fmt.Errorf("error getting folder permissions: %s", err)
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -191,7 +192,7 @@ func TestAccFolder_PreventDeletion(t *testing.T) {
 					folderCheckExists.exists("grafana_folder.test_folder", &folder),
 					// Create a dashboard in the protected folder
 					func(s *terraform.State) error {
-						client := grafana.OAPIGlobalClient(testutils.Provider.Meta())
+						client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
 						_, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{
 							FolderUID: folder.UID,
 							FolderID:  folder.ID,
@@ -243,7 +244,7 @@ func TestAccFolder_createFromDifferentRoles(t *testing.T) {
 			var name = acctest.RandomWithPrefix(tc.role + "-key")
 
 			// Create an API key with the correct role and inject it in envvars. This auth will be used when the test runs
-			client := grafana.OAPIGlobalClient(testutils.Provider.Meta())
+			client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
 			resp, err := client.APIKeys.AddAPIkey(&models.AddAPIKeyCommand{
 				Name: name,
 				Role: tc.role,
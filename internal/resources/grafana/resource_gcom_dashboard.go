@@ -0,0 +1,250 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceGcomDashboard() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+Installs a dashboard published on grafana.com, by its ID and revision, like the import of dashboards in the Grafana UI.
+The inputs of the dashboard (e.g. its data sources) are mapped to the values of ` + "`inputs`" + `.
+
+When ` + "`revision`" + ` isn't set, the latest revision is installed, and new revisions published on grafana.com are planned as updates.
+Changes made to the dashboard in Grafana are not tracked: use ` + "`grafana_dashboard`" + ` with ` + "`config_url`" + ` to manage the model of the dashboard.
+The dashboards are fetched through the grafana.com proxy of Grafana, which must be able to reach grafana.com.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/manage-dashboards/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/dashboard/)
+`,
+
+		CreateContext: CreateGcomDashboard,
+		ReadContext:   ReadGcomDashboard,
+		UpdateContext: UpdateGcomDashboard,
+		DeleteContext: DeleteGcomDashboard,
+		CustomizeDiff: customdiff.All(
+			setGcomDashboardLatestRevision,
+			customdiff.ComputedIf("title", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("revision")
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"gcom_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The ID of the dashboard on grafana.com, e.g. `1860` for https://grafana.com/grafana/dashboards/1860.",
+			},
+			"revision": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The revision of the dashboard to install. Defaults to the latest revision, which is checked at plan time.",
+			},
+			"inputs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The values of the inputs of the dashboard (its `__inputs`), by input name, e.g. `DS_PROMETHEUS`. " +
+					"Data source inputs are set to the UID of a data source. Constant inputs default to the value proposed by the dashboard.",
+			},
+			"folder": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The UID of the folder to install the dashboard in.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The UID of the installed dashboard. Defaults to the UID set by the dashboard's author. Set it to install a dashboard more than once in an organization.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The title of the installed dashboard.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full URL of the installed dashboard.",
+			},
+		},
+	}
+}
+
+func CreateGcomDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return importGcomDashboard(ctx, d, meta, false)
+}
+
+func UpdateGcomDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return importGcomDashboard(ctx, d, meta, true)
+}
+
+func ReadGcomDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+	resp, err := client.Dashboards.GetDashboardByUID(uid)
+	if err, shouldReturn := common.CheckReadError("dashboard", d, err); shouldReturn {
+		return err
+	}
+	dashboard := resp.GetPayload()
+	model, _ := dashboard.Dashboard.(map[string]interface{})
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("uid", uid)
+	d.Set("title", model["title"])
+	d.Set("folder", dashboard.Meta.FolderUID)
+	d.Set("url", meta.(*common.Client).GrafanaSubpath(dashboard.Meta.URL))
+	return nil
+}
+
+func DeleteGcomDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+	_, deleteErr := client.Dashboards.DeleteDashboardByUID(uid)
+	err, _ := common.CheckReadError("dashboard", d, deleteErr)
+	return err
+}
+
+// importGcomDashboard fetches the dashboard's revision from grafana.com and imports it with its inputs.
+func importGcomDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}, overwrite bool) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	gcomID := d.Get("gcom_id").(int)
+
+	revision := d.Get("revision").(int)
+	if revision == 0 {
+		latest, err := gcomDashboardLatestRevision(ctx, meta.(*common.Client), orgID, gcomID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		revision = latest
+	}
+
+	var model map[string]interface{}
+	path := fmt.Sprintf("/api/gnet/dashboards/%d/revisions/%d/download", gcomID, revision)
+	if err := gcomRequest(ctx, meta.(*common.Client), orgID, path, &model); err != nil {
+		return diag.FromErr(err)
+	}
+	inputs, err := gcomDashboardInputs(model, d.Get("inputs").(map[string]interface{}))
+	if err != nil {
+		return diag.Errorf("dashboard %d (revision %d): %v", gcomID, revision, err)
+	}
+	delete(model, "id")
+	if uid := d.Get("uid").(string); uid != "" {
+		model["uid"] = uid
+	}
+
+	resp, err := client.Dashboards.ImportDashboard(&models.ImportDashboardRequest{
+		Dashboard: model,
+		FolderUID: d.Get("folder").(string),
+		Inputs:    inputs,
+		Overwrite: overwrite,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(MakeOrgResourceID(orgID, resp.Payload.UID))
+	d.Set("revision", revision)
+	return ReadGcomDashboard(ctx, d, meta)
+}
+
+// gcomDashboardInputs maps the inputs declared by a dashboard (`__inputs`) to the configured values, like the import form of the Grafana UI.
+func gcomDashboardInputs(model map[string]interface{}, values map[string]interface{}) ([]*models.ImportDashboardInput, error) {
+	declared, _ := model["__inputs"].([]interface{})
+	inputs := make([]*models.ImportDashboardInput, 0, len(declared))
+	known := map[string]bool{}
+	var missing []string
+	for _, in := range declared {
+		in, _ := in.(map[string]interface{})
+		input := &models.ImportDashboardInput{}
+		input.Name, _ = in["name"].(string)
+		input.Type, _ = in["type"].(string)
+		input.PluginID, _ = in["pluginId"].(string)
+		input.Value, _ = in["value"].(string)
+		known[input.Name] = true
+		if value, ok := values[input.Name]; ok {
+			input.Value = value.(string)
+		}
+		if input.Value == "" {
+			missing = append(missing, fmt.Sprintf("%s (%s %s)", input.Name, input.PluginID, input.Type))
+		}
+		inputs = append(inputs, input)
+	}
+
+	var unknown []string
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("the dashboard has no inputs named %s", strings.Join(unknown, ", "))
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("the following inputs must be set in `inputs`: %s", strings.Join(missing, ", "))
+	}
+	return inputs, nil
+}
+
+// setGcomDashboardLatestRevision plans the installation of the latest revision of the dashboard, when `revision` isn't set.
+func setGcomDashboardLatestRevision(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.GetRawConfig().GetAttr("revision").IsNull() || !d.NewValueKnown("gcom_id") || !d.NewValueKnown("org_id") {
+		return nil
+	}
+	client, ok := meta.(*common.Client)
+	if !ok || client.GrafanaAPIConfig == nil {
+		return nil
+	}
+	orgID, _ := strconv.ParseInt(d.Get("org_id").(string), 10, 64)
+	latest, err := gcomDashboardLatestRevision(ctx, client, orgID, d.Get("gcom_id").(int))
+	if err != nil {
+		log.Printf("[WARN] could not check the latest revision of dashboard %d on grafana.com: %v", d.Get("gcom_id").(int), err)
+		return nil
+	}
+	if latest == d.Get("revision").(int) {
+		return nil
+	}
+	return d.SetNew("revision", latest)
+}
+
+func gcomDashboardLatestRevision(ctx context.Context, client *common.Client, orgID int64, gcomID int) (int, error) {
+	var dashboard struct {
+		Revision int `json:"revision"`
+	}
+	if err := gcomRequest(ctx, client, orgID, fmt.Sprintf("/api/gnet/dashboards/%d", gcomID), &dashboard); err != nil {
+		return 0, err
+	}
+	return dashboard.Revision, nil
+}
+
+// gcomRequest calls the grafana.com API through the proxy of Grafana (`/api/gnet`), which is used by the import of dashboards in the Grafana UI.
+func gcomRequest(ctx context.Context, client *common.Client, orgID int64, path string, result interface{}) error {
+	status, body, err := grafanaAPIRequest(ctx, client, orgID, http.MethodGet, path, nil, "", nil)
+	if err != nil {
+		return err
+	}
+	if status >= 400 {
+		return fmt.Errorf("failed to fetch %s from grafana.com: status: %d, body: %s", strings.TrimPrefix(path, "/api/gnet"), status, body)
+	}
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,75 @@
+package grafana_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGcomDashboard_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+	config := func(revision string, inputs string) string {
+		return fmt.Sprintf(`
+resource "grafana_data_source" "prometheus" {
+	type = "prometheus"
+	name = "%[1]s"
+	url  = "http://prometheus:9090"
+}
+
+resource "grafana_gcom_dashboard" "test" {
+	gcom_id = 1860
+	uid     = "%[1]s"
+	%[2]s
+	%[3]s
+}`, uid, revision, inputs)
+	}
+	inputs := `inputs = { DS_PROMETHEUS = grafana_data_source.prometheus.uid }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config:      config("revision = 30", ""),
+				ExpectError: regexp.MustCompile(`the following inputs must be set in ` + "`inputs`" + `: DS_PROMETHEUS`),
+			},
+			{
+				Config:      config("revision = 30", `inputs = { DS_PROMETHEUS = grafana_data_source.prometheus.uid, DS_OTHER = "x" }`),
+				ExpectError: regexp.MustCompile(`the dashboard has no inputs named DS_OTHER`),
+			},
+			{
+				Config: config("revision = 30", inputs),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_gcom_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_gcom_dashboard.test", "uid", uid),
+					resource.TestCheckResourceAttr("grafana_gcom_dashboard.test", "revision", "30"),
+					resource.TestCheckResourceAttr("grafana_gcom_dashboard.test", "title", "Node Exporter Full"),
+					resource.TestCheckResourceAttr("grafana_gcom_dashboard.test", "folder", ""),
+				),
+			},
+			// Without a revision, the dashboard is upgraded to the latest revision
+			{
+				Config: config("", inputs),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_gcom_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_gcom_dashboard.test", "uid", uid),
+					resource.TestCheckResourceAttrWith("grafana_gcom_dashboard.test", "revision", func(value string) error {
+						if revision, _ := strconv.Atoi(value); revision <= 30 {
+							return fmt.Errorf("expected a revision newer than 30, got %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package grafana
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceGroupRoleMapping() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Manages the RBAC roles granted to the members of an external group (group attribute sync).
+Users who log in through an SSO provider that sends the group are granted the fixed, plugin or custom roles mapped to it.
+**Note:** This resource is available only with Grafana Enterprise 11.1+, with the ` + "`groupAttributeSync`" + ` feature toggle enabled.
+* [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-security/configure-group-attribute-sync/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/group_attribute_sync/)
+`,
+		CreateContext: CreateGroupRoleMapping,
+		UpdateContext: UpdateGroupRoleMapping,
+		ReadContext:   ReadGroupRoleMapping,
+		DeleteContext: DeleteGroupRoleMapping,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the external group, as sent by the SSO provider.",
+			},
+			"role_uids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "UIDs of the RBAC roles granted to the members of the group. Fixed, plugin and custom roles are supported.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func CreateGroupRoleMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	groupID := d.Get("group_id").(string)
+
+	if err := groupSyncRequest(ctx, client, http.MethodPost, groupID, "", groupRoleMappingBody(d), nil); err != nil {
+		return diag.Errorf("failed to create the role mapping of group %q: %s", groupID, err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, groupID))
+	return ReadGroupRoleMapping(ctx, d, meta)
+}
+
+func ReadGroupRoleMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, groupID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	var roles []*models.RoleDTO
+	err := groupSyncRequest(ctx, client, http.MethodGet, groupID, "/roles", nil, &roles)
+	if err, shouldReturn := common.CheckReadError("group role mapping", d, err); shouldReturn {
+		return err
+	}
+	// A group without any mapped role has no mapping.
+	if len(roles) == 0 {
+		return common.WarnMissing("group role mapping", d)
+	}
+
+	roleUIDs := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleUIDs = append(roleUIDs, role.UID)
+	}
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("group_id", groupID)
+	d.Set("role_uids", roleUIDs)
+
+	return nil
+}
+
+func UpdateGroupRoleMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, groupID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	if err := groupSyncRequest(ctx, client, http.MethodPut, groupID, "", groupRoleMappingBody(d), nil); err != nil {
+		return diag.Errorf("failed to update the role mapping of group %q: %s", groupID, err)
+	}
+
+	return ReadGroupRoleMapping(ctx, d, meta)
+}
+
+func DeleteGroupRoleMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, groupID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	err := groupSyncRequest(ctx, client, http.MethodDelete, groupID, "", nil, nil)
+	diags, _ := common.CheckReadError("group role mapping", d, err)
+	return diags
+}
+
+func groupRoleMappingBody(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"roleUids": common.SetToStringSlice(d.Get("role_uids").(*schema.Set)),
+	}
+}
+
+// groupSyncRequest calls the group attribute sync API, which isn't supported by the OpenAPI client.
+// The call goes through the client's transport, so that it's authenticated and scoped to the client's organization.
+func groupSyncRequest(ctx context.Context, client *goapi.GrafanaHTTPAPI, method, groupID, subPath string, body, result interface{}) error {
+	op := &runtime.ClientOperation{
+		ID:                 "groupSync",
+		Method:             method,
+		PathPattern:        "/groupsync/groups/{group_id}" + subPath,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Context:            ctx,
+	}
+	op.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		if err := r.SetPathParam("group_id", groupID); err != nil {
+			return err
+		}
+		if body != nil {
+			return r.SetBodyParam(body)
+		}
+		return nil
+	})
+	op.Reader = runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+		if resp.Code() >= http.StatusMultipleChoices {
+			payload, _ := io.ReadAll(resp.Body())
+			return nil, runtime.NewAPIError(op.ID, string(payload), resp.Code())
+		}
+		if result == nil {
+			return nil, nil
+		}
+		return nil, consumer.Consume(resp.Body(), result)
+	})
+
+	_, err := client.Transport.Submit(op)
+	return err
+}
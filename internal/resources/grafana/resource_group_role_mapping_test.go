@@ -0,0 +1,55 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGroupRoleMapping_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=11.1.0")
+
+	name := acctest.RandString(10)
+	replacements := map[string]string{
+		"platform-engineers":          name,
+		"platform-datasource-reader":  name + "-datasource-reader",
+		"platform-folder-creator":     name + "-folder-creator",
+		"Platform data source reader": name + " data source reader",
+		"Platform folder creator":     name + " folder creator",
+	}
+	config := testutils.TestAccExampleWithReplace(t, "resources/grafana_group_role_mapping/resource.tf", replacements)
+	replacements["grafana_role.folder_creator.uid,"] = ""
+	configWithoutFolderCreator := testutils.TestAccExampleWithReplace(t, "resources/grafana_group_role_mapping/resource.tf", replacements)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_group_role_mapping.platform", "id", "1:"+name),
+					resource.TestCheckResourceAttr("grafana_group_role_mapping.platform", "group_id", name),
+					resource.TestCheckResourceAttr("grafana_group_role_mapping.platform", "role_uids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("grafana_group_role_mapping.platform", "role_uids.*", name+"-datasource-reader"),
+					resource.TestCheckTypeSetElemAttr("grafana_group_role_mapping.platform", "role_uids.*", name+"-folder-creator"),
+				),
+			},
+			{
+				ResourceName:      "grafana_group_role_mapping.platform",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove one of the roles, the mapping is updated in place
+			{
+				Config: configWithoutFolderCreator,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_group_role_mapping.platform", "id", "1:"+name),
+					resource.TestCheckResourceAttr("grafana_group_role_mapping.platform", "role_uids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("grafana_group_role_mapping.platform", "role_uids.*", name+"-datasource-reader"),
+				),
+			},
+		},
+	})
+}
@@ -26,11 +26,12 @@ Manages Grafana library panels.
 		ReadContext:   readLibraryPanel,
 		UpdateContext: updateLibraryPanel,
 		DeleteContext: deleteLibraryPanel,
+		CustomizeDiff: setJSONFromConfigURL("model_json", normalizeLibraryPanelModelJSON),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: withConfigURLAttributes("model_json", map[string]*schema.Schema{
 			"org_id": orgIDAttribute(),
 			"uid": {
 				Type:     schema.TypeString,
@@ -72,10 +73,11 @@ Manages Grafana library panels.
 			},
 			"model_json": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				StateFunc:    normalizeLibraryPanelModelJSON,
 				ValidateFunc: validateLibraryPanelModelJSON,
-				Description:  "The JSON model for the library panel.",
+				Description:  "The JSON model for the library panel. Either `model_json` or `config_url` must be set.",
 			},
 			"version": {
 				Type:        schema.TypeInt,
@@ -108,12 +110,12 @@ Manages Grafana library panels.
 				Description: "Numerical IDs of Grafana dashboards containing the library panel.",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
 func createLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	panel := makeLibraryPanel(d)
 	resp, err := client.LibraryElements.CreateLibraryElement(&panel)
@@ -126,7 +128,7 @@ func createLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func readLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.LibraryElements.GetLibraryElementByUID(uid)
 	if err, shouldReturn := common.CheckReadError("library panel", d, err); shouldReturn {
@@ -175,7 +177,7 @@ func readLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interfac
 }
 
 func updateLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	modelJSON := d.Get("model_json").(string)
 	panelJSON, _ := unmarshalLibraryPanelModelJSON(modelJSON)
@@ -199,7 +201,7 @@ func updateLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func deleteLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	_, err := client.LibraryElements.DeleteLibraryElementByUID(uid)
 	diag, _ := common.CheckReadError("library panel", d, err)
 	return diag
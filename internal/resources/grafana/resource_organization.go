@@ -145,7 +145,7 @@ set to true. This feature is only available in Grafana 10.2+.
 }
 
 func CreateOrganization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	name := d.Get("name").(string)
 
 	resp, err := client.Orgs.CreateOrg(&models.CreateOrgCommand{Name: name})
@@ -156,7 +156,7 @@ func CreateOrganization(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 	d.SetId(strconv.FormatInt(*resp.Payload.OrgID, 10))
-	if err = UpdateUsers(d, meta); err != nil {
+	if err = UpdateUsers(ctx, d, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -164,7 +164,7 @@ func CreateOrganization(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func ReadOrganization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	resp, err := client.Orgs.GetOrgByID(orgID)
@@ -175,14 +175,14 @@ func ReadOrganization(ctx context.Context, d *schema.ResourceData, meta interfac
 	org := resp.Payload
 	d.Set("org_id", org.ID)
 	d.Set("name", org.Name)
-	if err := ReadUsers(d, meta); err != nil {
+	if err := ReadUsers(ctx, d, meta); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
 func UpdateOrganization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
@@ -190,7 +190,7 @@ func UpdateOrganization(ctx context.Context, d *schema.ResourceData, meta interf
 			return diag.FromErr(err)
 		}
 	}
-	if err := UpdateUsers(d, meta); err != nil {
+	if err := UpdateUsers(ctx, d, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -198,15 +198,15 @@ func UpdateOrganization(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func DeleteOrganization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
 	_, err := client.Orgs.DeleteOrgByID(orgID)
 	diag, _ := common.CheckReadError("organization", d, err)
 	return diag
 }
 
-func ReadUsers(d *schema.ResourceData, meta interface{}) error {
-	client := OAPIGlobalClient(meta)
+func ReadUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := OAPIGlobalClient(ctx, meta)
 	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
 	resp, err := client.Orgs.GetOrgUsers(orgID)
 	if err != nil {
@@ -225,18 +225,18 @@ func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func UpdateUsers(d *schema.ResourceData, meta interface{}) error {
+func UpdateUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	stateUsers, configUsers, err := collectUsers(d)
 	if err != nil {
 		return err
 	}
 	changes := changes(stateUsers, configUsers)
 	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
-	changes, err = addIdsToChanges(d, meta, changes)
+	changes, err = addIdsToChanges(ctx, d, meta, changes)
 	if err != nil {
 		return err
 	}
-	return applyChanges(meta, orgID, changes)
+	return applyChanges(ctx, meta, orgID, changes)
 }
 
 func collectUsers(d *schema.ResourceData) (map[string]OrgUser, map[string]OrgUser, error) {
@@ -291,8 +291,8 @@ func changes(stateUsers, configUsers map[string]OrgUser) []UserChange {
 	return changes
 }
 
-func addIdsToChanges(d *schema.ResourceData, meta interface{}, changes []UserChange) ([]UserChange, error) {
-	client := OAPIGlobalClient(meta)
+func addIdsToChanges(ctx context.Context, d *schema.ResourceData, meta interface{}, changes []UserChange) ([]UserChange, error) {
+	client := OAPIGlobalClient(ctx, meta)
 	gUserMap := make(map[string]int64)
 	gUsers, err := getAllUsers(client)
 	if err != nil {
@@ -313,7 +313,7 @@ func addIdsToChanges(d *schema.ResourceData, meta interface{}, changes []UserCha
 			return nil, fmt.Errorf("error adding user %s. User does not exist in Grafana", change.User.Email)
 		}
 		if !ok && create {
-			id, err = createUser(meta, change.User.Email)
+			id, err = createUser(ctx, meta, change.User.Email)
 			if err != nil {
 				return nil, err
 			}
@@ -324,8 +324,8 @@ func addIdsToChanges(d *schema.ResourceData, meta interface{}, changes []UserCha
 	return output, nil
 }
 
-func createUser(meta interface{}, user string) (int64, error) {
-	client := OAPIGlobalClient(meta)
+func createUser(ctx context.Context, meta interface{}, user string) (int64, error) {
+	client := OAPIGlobalClient(ctx, meta)
 	n := 64
 	bytes := make([]byte, n)
 	_, err := rand.Read(bytes)
@@ -346,9 +346,9 @@ func createUser(meta interface{}, user string) (int64, error) {
 	return resp.Payload.ID, err
 }
 
-func applyChanges(meta interface{}, orgID int64, changes []UserChange) error {
+func applyChanges(ctx context.Context, meta interface{}, orgID int64, changes []UserChange) error {
 	var err error
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	for _, change := range changes {
 		u := change.User
 		switch change.Type {
@@ -0,0 +1,293 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceOrganizationBootstrap() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+Creates an organization together with a service account and a token to manage it, and sets its preferences.
+
+The token can be used to configure a provider for the new organization in the same apply, instead of creating the organization,
+its service account and its token with separate resources and applies.
+If the service account or its token is deleted outside of Terraform, it is created again on the next apply.
+Changing the name of the service account renames it, and changing the name or the lifetime of the token replaces the token: the organization is kept.
+Deleting this resource deletes the organization and everything it contains.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/organization-management/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/org/)
+
+This resource represents an instance-scoped resource and uses Grafana's admin APIs.
+It does not work with API tokens or service accounts which are org-scoped.
+You must use basic auth.
+
+**Note:** This resource is available only with Grafana 9.1+.
+`,
+
+		CreateContext: CreateOrganizationBootstrap,
+		ReadContext:   ReadOrganizationBootstrap,
+		UpdateContext: UpdateOrganizationBootstrap,
+		DeleteContext: DeleteOrganizationBootstrap,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// Recreate the credentials deleted outside of Terraform, and the token whose name or lifetime changes
+			if d.Id() == "" || (d.Get("token_id").(string) != "" && !d.HasChanges("token_name", "token_seconds_to_live")) {
+				return nil
+			}
+			if d.Get("service_account_id").(string) == "" {
+				if err := d.SetNewComputed("service_account_id"); err != nil {
+					return err
+				}
+			}
+			if err := d.SetNewComputed("token_id"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("token")
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the organization.",
+			},
+			"org_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the organization.",
+			},
+			"service_account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "terraform",
+				Description: "The name of the service account created in the organization.",
+			},
+			"service_account_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Admin",
+				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin"}, false),
+				Description:  "The basic role of the service account in the organization.",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the service account, in the format of `grafana_service_account` IDs.",
+			},
+			"token_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "terraform",
+				Description: "The name of the token of the service account. Changing it replaces the token.",
+			},
+			"token_seconds_to_live": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The lifetime of the token, in seconds. The token doesn't expire if not set. Changing it replaces the token.",
+			},
+			"token_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the token.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token of the service account, to authenticate to the organization.",
+			},
+			"theme": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The organization theme. Available values are `light`, `dark`, `system`, or an empty string for the default.",
+				ValidateFunc: validation.StringInSlice([]string{"light", "dark", "system", ""}, false),
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The organization timezone. Available values are `utc`, `browser`, a location of the IANA time zone database (e.g. `Europe/Paris`), or an empty string for the default.",
+				ValidateFunc: validatePreferencesTimezone,
+			},
+			"week_start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The organization week start day. Available values are `sunday`, `monday`, `saturday`, or an empty string for the default.",
+				ValidateFunc: validation.StringInSlice([]string{"sunday", "monday", "saturday", ""}, false),
+			},
+		},
+	}
+}
+
+func CreateOrganizationBootstrap(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := OAPIGlobalClient(ctx, meta)
+	name := d.Get("name").(string)
+
+	resp, err := client.Orgs.CreateOrg(&models.CreateOrgCommand{Name: name})
+	if err != nil && strings.Contains(err.Error(), "409") {
+		return diag.Errorf("Error: A Grafana Organization with the name '%s' already exists.", name)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.FormatInt(*resp.Payload.OrgID, 10))
+
+	return UpdateOrganizationBootstrap(ctx, d, meta)
+}
+
+func ReadOrganizationBootstrap(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	resp, err := OAPIGlobalClient(ctx, meta).Orgs.GetOrgByID(orgID)
+	if err, shouldReturn := common.CheckReadError("organization", d, err); shouldReturn {
+		return err
+	}
+	d.Set("org_id", resp.Payload.ID)
+	d.Set("name", resp.Payload.Name)
+
+	client := organizationBootstrapClient(ctx, meta, orgID)
+	prefs, err := client.OrgPreferences.GetOrgPreferences()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("theme", prefs.Payload.Theme)
+	d.Set("timezone", prefs.Payload.Timezone)
+	d.Set("week_start", prefs.Payload.WeekStart)
+
+	// The credentials deleted outside of Terraform are created again by the next update
+	saID, tokenID := organizationBootstrapCredentialIDs(d)
+	if saID == 0 {
+		return nil
+	}
+	sa, err := client.ServiceAccounts.RetrieveServiceAccount(saID)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			d.Set("service_account_id", "")
+			d.Set("token_id", "")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	d.Set("service_account_name", sa.Payload.Name)
+	d.Set("service_account_role", sa.Payload.Role)
+
+	tokens, err := client.ServiceAccounts.ListTokens(saID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, token := range tokens.Payload {
+		if token.ID == tokenID {
+			return nil
+		}
+	}
+	d.Set("token_id", "")
+	return nil
+}
+
+func UpdateOrganizationBootstrap(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if !d.IsNewResource() && d.HasChange("name") {
+		if _, err := OAPIGlobalClient(ctx, meta).Orgs.UpdateOrg(orgID, &models.UpdateOrgForm{Name: d.Get("name").(string)}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	client := organizationBootstrapClient(ctx, meta, orgID)
+	if _, err := client.OrgPreferences.UpdateOrgPreferences(&models.UpdatePrefsCmd{
+		Theme:     d.Get("theme").(string),
+		Timezone:  d.Get("timezone").(string),
+		WeekStart: d.Get("week_start").(string),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	saID, tokenID := organizationBootstrapCredentialIDs(d)
+	if saID == 0 {
+		if err := createOrganizationBootstrapServiceAccount(d, client, orgID); err != nil {
+			return diag.FromErr(err)
+		}
+		saID, tokenID = organizationBootstrapCredentialIDs(d)
+	} else if d.HasChanges("service_account_name", "service_account_role") {
+		params := service_accounts.NewUpdateServiceAccountParams().
+			WithBody(&models.UpdateServiceAccountForm{
+				Name: d.Get("service_account_name").(string),
+				Role: d.Get("service_account_role").(string),
+			}).
+			WithServiceAccountID(saID)
+		if _, err := client.ServiceAccounts.UpdateServiceAccount(params); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The token is deleted before it's created again, since its name may not change
+	if tokenID != 0 && !d.IsNewResource() && d.HasChanges("token_name", "token_seconds_to_live") {
+		if _, err := client.ServiceAccounts.DeleteToken(tokenID, saID); err != nil && !common.IsNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+		tokenID = 0
+	}
+	if tokenID == 0 {
+		params := service_accounts.NewCreateTokenParams().WithServiceAccountID(saID).WithBody(&models.AddServiceAccountTokenCommand{
+			Name:          d.Get("token_name").(string),
+			SecondsToLive: int64(d.Get("token_seconds_to_live").(int)),
+		})
+		resp, err := client.ServiceAccounts.CreateToken(params)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("token_id", strconv.FormatInt(resp.Payload.ID, 10))
+		d.Set("token", resp.Payload.Key)
+	}
+
+	return ReadOrganizationBootstrap(ctx, d, meta)
+}
+
+func DeleteOrganizationBootstrap(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The service account and its token are deleted with the organization
+	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
+	_, err := OAPIGlobalClient(ctx, meta).Orgs.DeleteOrgByID(orgID)
+	diag, _ := common.CheckReadError("organization", d, err)
+	return diag
+}
+
+func createOrganizationBootstrapServiceAccount(d *schema.ResourceData, client *goapi.GrafanaHTTPAPI, orgID int64) error {
+	serviceAccountCreateMutex.Lock()
+	defer serviceAccountCreateMutex.Unlock()
+
+	params := service_accounts.NewCreateServiceAccountParams().WithBody(&models.CreateServiceAccountForm{
+		Name: d.Get("service_account_name").(string),
+		Role: d.Get("service_account_role").(string),
+	})
+	resp, err := client.ServiceAccounts.CreateServiceAccount(params)
+	if err != nil {
+		return err
+	}
+	d.Set("service_account_id", MakeOrgResourceID(orgID, resp.Payload.ID))
+	// The tokens of the previous service account were deleted with it
+	d.Set("token_id", "")
+	return nil
+}
+
+// organizationBootstrapCredentialIDs returns the IDs of the service account and its token, 0 when they must be created.
+func organizationBootstrapCredentialIDs(d *schema.ResourceData) (int64, int64) {
+	_, saIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
+	saID, _ := strconv.ParseInt(saIDStr, 10, 64)
+	tokenID, _ := strconv.ParseInt(d.Get("token_id").(string), 10, 64)
+	return saID, tokenID
+}
+
+// organizationBootstrapClient creates a client scoped to the bootstrapped organization.
+func organizationBootstrapClient(ctx context.Context, meta interface{}, orgID int64) *goapi.GrafanaHTTPAPI {
+	return common.OAPIWithContext(ctx, meta.(*common.Client).GrafanaOAPIWithOrgID(orgID))
+}
@@ -0,0 +1,130 @@
+package grafana_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/resources/grafana"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOrganizationBootstrap_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var org models.OrgDetailsDTO
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      orgCheckExists.destroyed(&org, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationBootstrapConfig(name, "Admin", "utc"),
+				Check: resource.ComposeTestCheckFunc(
+					orgCheckExists.exists("grafana_organization_bootstrap.test", &org),
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "name", name),
+					resource.TestMatchResourceAttr("grafana_organization_bootstrap.test", "service_account_id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "service_account_role", "Admin"),
+					resource.TestMatchResourceAttr("grafana_organization_bootstrap.test", "token_id", common.IDRegexp),
+					resource.TestCheckResourceAttrSet("grafana_organization_bootstrap.test", "token"),
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "timezone", "utc"),
+					testAccOrganizationBootstrapCheckServiceAccount(&org, "Admin"),
+				),
+			},
+			{
+				Config: testAccOrganizationBootstrapConfig(name+"-updated", "Editor", "browser"),
+				Check: resource.ComposeTestCheckFunc(
+					orgCheckExists.exists("grafana_organization_bootstrap.test", &org),
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "name", name+"-updated"),
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "service_account_role", "Editor"),
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "timezone", "browser"),
+					testAccOrganizationBootstrapCheckServiceAccount(&org, "Editor"),
+				),
+			},
+			// Renaming the credentials doesn't replace the organization
+			{
+				Config: testAccOrganizationBootstrapCredentialsConfig(name+"-updated", "renamed", "renamed-token"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if orgID := s.RootModule().Resources["grafana_organization_bootstrap.test"].Primary.Attributes["org_id"]; orgID != strconv.FormatInt(org.ID, 10) {
+							return fmt.Errorf("expected the organization %d to be kept, got %s", org.ID, orgID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "service_account_name", "renamed"),
+					resource.TestCheckResourceAttr("grafana_organization_bootstrap.test", "token_name", "renamed-token"),
+					resource.TestCheckResourceAttrSet("grafana_organization_bootstrap.test", "token"),
+					testAccOrganizationBootstrapCheckServiceAccount(&org, "Editor"),
+				),
+			},
+			// Delete the service account outside of Terraform, it is created again
+			{
+				Config: testAccOrganizationBootstrapConfig(name+"-updated", "Editor", "browser"),
+				Check: func(s *terraform.State) error {
+					client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta()).WithOrgID(org.ID)
+					_, saID := grafana.SplitOrgResourceID(s.RootModule().Resources["grafana_organization_bootstrap.test"].Primary.Attributes["service_account_id"])
+					id, _ := strconv.ParseInt(saID, 10, 64)
+					_, err := client.ServiceAccounts.DeleteServiceAccount(id)
+					return err
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccOrganizationBootstrapConfig(name+"-updated", "Editor", "browser"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_organization_bootstrap.test", "token_id", common.IDRegexp),
+					resource.TestCheckResourceAttrSet("grafana_organization_bootstrap.test", "token"),
+					testAccOrganizationBootstrapCheckServiceAccount(&org, "Editor"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationBootstrapCheckServiceAccount(org *models.OrgDetailsDTO, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta()).WithOrgID(org.ID)
+		_, saID := grafana.SplitOrgResourceID(s.RootModule().Resources["grafana_organization_bootstrap.test"].Primary.Attributes["service_account_id"])
+		id, _ := strconv.ParseInt(saID, 10, 64)
+		resp, err := client.ServiceAccounts.RetrieveServiceAccount(id)
+		if err != nil {
+			return err
+		}
+		if resp.Payload.Role != role {
+			return fmt.Errorf("expected the service account to have the %s role, got %s", role, resp.Payload.Role)
+		}
+		if resp.Payload.Tokens != 1 {
+			return fmt.Errorf("expected the service account to have 1 token, got %d", resp.Payload.Tokens)
+		}
+		return nil
+	}
+}
+
+func testAccOrganizationBootstrapConfig(name, role, timezone string) string {
+	return fmt.Sprintf(`
+resource "grafana_organization_bootstrap" "test" {
+  name                 = "%s"
+  service_account_role = "%s"
+  timezone             = "%s"
+}
+`, name, role, timezone)
+}
+
+func testAccOrganizationBootstrapCredentialsConfig(name, serviceAccountName, tokenName string) string {
+	return fmt.Sprintf(`
+resource "grafana_organization_bootstrap" "test" {
+  name                 = "%s"
+  service_account_name = "%s"
+  service_account_role = "Editor"
+  token_name           = "%s"
+  timezone             = "browser"
+}
+`, name, serviceAccountName, tokenName)
+}
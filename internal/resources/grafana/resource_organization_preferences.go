@@ -2,8 +2,11 @@ package grafana
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -59,8 +62,8 @@ func ResourceOrganizationPreferences() *schema.Resource {
 			"timezone": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "The Organization timezone. Available values are `utc`, `browser`, or an empty string for the default.",
-				ValidateFunc: validation.StringInSlice([]string{"utc", "browser", ""}, false),
+				Description:  "The Organization timezone. Available values are `utc`, `browser`, a location of the IANA time zone database (e.g. `Europe/Paris`), or an empty string for the default.",
+				ValidateFunc: validatePreferencesTimezone,
 			},
 			"week_start": {
 				Type:         schema.TypeString,
@@ -74,7 +77,7 @@ func ResourceOrganizationPreferences() *schema.Resource {
 }
 
 func CreateOrganizationPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	_, err := client.OrgPreferences.UpdateOrgPreferences(&models.UpdatePrefsCmd{
 		Theme:            d.Get("theme").(string),
@@ -93,10 +96,7 @@ func CreateOrganizationPreferences(ctx context.Context, d *schema.ResourceData,
 }
 
 func ReadOrganizationPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
-	if id, _ := strconv.ParseInt(d.Id(), 10, 64); id > 0 {
-		client = client.WithOrgID(id)
-	}
+	client := organizationPreferencesClient(ctx, d, meta)
 
 	resp, err := client.OrgPreferences.GetOrgPreferences()
 	if err, shouldReturn := common.CheckReadError("organization preferences", d, err); shouldReturn {
@@ -119,10 +119,7 @@ func UpdateOrganizationPreferences(ctx context.Context, d *schema.ResourceData,
 }
 
 func DeleteOrganizationPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
-	if id, _ := strconv.ParseInt(d.Id(), 10, 64); id > 0 {
-		client = client.WithOrgID(id)
-	}
+	client := organizationPreferencesClient(ctx, d, meta)
 
 	if _, err := client.OrgPreferences.UpdateOrgPreferences(&models.UpdatePrefsCmd{}); err != nil {
 		return diag.FromErr(err)
@@ -130,3 +127,29 @@ func DeleteOrganizationPreferences(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// organizationPreferencesClient creates a client scoped to the organization whose ID is the resource ID.
+func organizationPreferencesClient(ctx context.Context, d *schema.ResourceData, meta interface{}) *goapi.GrafanaHTTPAPI {
+	var orgID int64
+	if id, _ := strconv.ParseInt(d.Id(), 10, 64); id > 0 {
+		orgID = id
+	}
+	return common.OAPIWithContext(ctx, meta.(*common.Client).GrafanaOAPIWithOrgID(orgID))
+}
+
+// validatePreferencesTimezone validates the timezone of org or team preferences: `utc`, `browser`, an IANA time zone or an empty string.
+func validatePreferencesTimezone(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	switch v {
+	case "", "utc", "browser":
+		return nil, nil
+	}
+	// LoadLocation also accepts "UTC" and "Local", which Grafana doesn't
+	if _, err := time.LoadLocation(v); err != nil || v == "UTC" || v == "Local" {
+		return nil, []error{fmt.Errorf("expected %s to be `utc`, `browser`, or a location of the IANA time zone database, got %q", k, v)}
+	}
+	return nil, nil
+}
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -117,7 +118,7 @@ func testAccResourceOrganizationPreferences(t *testing.T, withUID bool) {
 
 func testAccCheckOrganizationPreferences(org *models.OrgDetailsDTO, expectedPrefs models.Preferences) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := grafana.OAPIGlobalClient(testutils.Provider.Meta()).WithOrgID(org.ID)
+		client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta()).WithOrgID(org.ID)
 		resp, err := client.OrgPreferences.GetOrgPreferences()
 		if err != nil {
 			return fmt.Errorf("error getting organization preferences: %s", err)
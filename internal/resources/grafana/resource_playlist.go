@@ -3,8 +3,12 @@ package grafana
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/playlists"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -77,7 +81,7 @@ func ResourcePlaylist() *schema.Resource {
 }
 
 func CreatePlaylist(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	playlist := models.CreatePlaylistCommand{
 		Name:     d.Get("name").(string),
@@ -100,8 +104,23 @@ func CreatePlaylist(ctx context.Context, d *schema.ResourceData, meta interface{
 	return ReadPlaylist(ctx, d, meta)
 }
 
+const playlistImportNamePrefix = "name/"
+
 func ReadPlaylist(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, id := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	// Support names (prefixed with `name/`), so that we can import an existing playlist without knowing its ID.
+	// Following the read, it's normalized to the playlist's ID.
+	if name, ok := strings.CutPrefix(id, playlistImportNamePrefix); ok {
+		playlist, err := findPlaylistByName(client, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		id = playlist.UID
+		if id == "" {
+			id = strconv.FormatInt(playlist.ID, 10)
+		}
+	}
 
 	resp, err := client.Playlists.GetPlaylist(id)
 	// In Grafana 9.0+, if the playlist doesn't exist, the API returns an empty playlist but not a notfound error
@@ -130,7 +149,7 @@ func ReadPlaylist(ctx context.Context, d *schema.ResourceData, meta interface{})
 }
 
 func UpdatePlaylist(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, id := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	playlist := models.UpdatePlaylistCommand{
 		Name:     d.Get("name").(string),
@@ -147,12 +166,35 @@ func UpdatePlaylist(ctx context.Context, d *schema.ResourceData, meta interface{
 }
 
 func DeletePlaylist(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, id := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	_, err := client.Playlists.DeletePlaylist(id)
 	diag, _ := common.CheckReadError("playlist", d, err)
 	return diag
 }
 
+// findPlaylistByName returns the playlist with the given name. Names aren't unique, so an error is returned if several playlists have the name.
+func findPlaylistByName(client *goapi.GrafanaHTTPAPI, name string) (*models.Playlist, error) {
+	resp, err := client.Playlists.SearchPlaylists(playlists.NewSearchPlaylistsParams().WithQuery(&name))
+	if err != nil {
+		return nil, err
+	}
+
+	var found *models.Playlist
+	for _, playlist := range resp.Payload {
+		if playlist.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("several playlists are named %q, import the playlist by ID", name)
+		}
+		found = playlist
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no playlist named %q", name)
+	}
+	return found, nil
+}
+
 func expandPlaylistItems(items []interface{}) []*models.PlaylistItem {
 	playlistItems := make([]*models.PlaylistItem, 0)
 	for _, item := range items {
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -46,6 +47,13 @@ func TestAccPlaylist_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Test import using name
+			{
+				ResourceName:      paylistResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "name/" + rName,
+			},
 		},
 	})
 }
@@ -173,7 +181,7 @@ func testAccPlaylistDisappears() resource.TestCheckFunc {
 			return fmt.Errorf("resource id not set")
 		}
 
-		client, _, playlistID := grafana.OAPIClientFromExistingOrgResource(testutils.Provider.Meta(), rs.Primary.ID)
+		client, _, playlistID := grafana.OAPIClientFromExistingOrgResource(context.Background(), testutils.Provider.Meta(), rs.Primary.ID)
 		_, err := client.Playlists.DeletePlaylist(playlistID)
 		return err
 	}
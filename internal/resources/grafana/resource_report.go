@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -273,36 +274,83 @@ func ResourceReport() *schema.Resource {
 								return oldValue == "1" && newValue == "0"
 							},
 						},
+						"report_variables": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Description: "Values of the template variables of the dashboard in the report, by variable name. " +
+								"Multiple values of a variable are separated by commas, and the whitespace around each value is trimmed, so a value can't contain a comma. " +
+								"The variables which aren't set use the values saved in the dashboard.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+								return strings.Join(splitReportVariableValues(oldValue), ",") == strings.Join(splitReportVariableValues(newValue), ",")
+							},
+						},
 					},
 				},
 				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 					return d.Get("dashboard_id").(int) != 0 && d.Get("dashboard_uid").(string) != ""
 				},
 			},
+			"test_email": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "When set, a one-off copy of the report is rendered and emailed to the given recipients each time the report is created or updated. " +
+					"Rendering errors (e.g. a missing image renderer plugin or a dashboard too large to render) are reported as apply-time diagnostics. " +
+					"The scheduled recipients don't receive the test email.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recipients": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "List of recipients of the test email.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(common.EmailRegexp, "must be an email address"),
+							},
+						},
+						"fail_on_error": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether a failure to render or send the test email fails the apply, in which case the report isn't created or updated. Otherwise, the failure is reported as a warning.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func CreateReport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	report, err := schemaToReport(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// The test email is sent first, so that a failure with `fail_on_error` doesn't leave a tainted report behind
+	diags := sendReportTestEmail(client, report, d)
+	if diags.HasError() {
+		return diags
+	}
+
 	res, err := client.Reports.CreateReport(&report)
 	if err != nil {
 		data, _ := json.Marshal(report)
-		return diag.Errorf("error creating the following report:\n%s\n%v", string(data), err)
+		return append(diags, diag.Errorf("error creating the following report:\n%s\n%v", string(data), err)...)
 	}
 
 	d.SetId(MakeOrgResourceID(orgID, res.Payload.ID))
-	return ReadReport(ctx, d, meta)
+	return append(diags, ReadReport(ctx, d, meta)...)
 }
 
 func ReadReport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -379,6 +427,7 @@ func ReadReport(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 					"from": dashboard.TimeRange.From,
 				},
 			},
+			"report_variables": reportVariablesToSchema(dashboard.ReportVariables),
 		}
 	}
 
@@ -388,7 +437,7 @@ func ReadReport(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 }
 
 func UpdateReport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -399,15 +448,21 @@ func UpdateReport(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
+	// The test email is sent first, so that a failure with `fail_on_error` leaves the report unchanged
+	diags := sendReportTestEmail(client, report, d)
+	if diags.HasError() {
+		return diags
+	}
+
 	if _, err := client.Reports.UpdateReport(id, &report); err != nil {
 		data, _ := json.Marshal(report)
-		return diag.Errorf("error updating the following report:\n%s\n%v", string(data), err)
+		return append(diags, diag.Errorf("error updating the following report:\n%s\n%v", string(data), err)...)
 	}
-	return ReadReport(ctx, d, meta)
+	return append(diags, ReadReport(ctx, d, meta)...)
 }
 
 func DeleteReport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -418,6 +473,45 @@ func DeleteReport(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return diag
 }
 
+// sendReportTestEmail renders the report and emails it to the recipients of the `test_email` block, if any.
+// The report is sent with the same config as the one that was just saved, so rendering issues show up at apply time instead of at the next scheduled run.
+func sendReportTestEmail(client *goapi.GrafanaHTTPAPI, report models.CreateOrUpdateReportConfig, d *schema.ResourceData) diag.Diagnostics {
+	testEmail, ok := d.GetOk("test_email.0")
+	if !ok || testEmail == nil {
+		return nil
+	}
+	testEmailConfig := testEmail.(map[string]interface{})
+
+	report.Recipients = strings.Join(common.ListToStringSlice(testEmailConfig["recipients"].([]interface{})), ",")
+	_, err := client.Reports.SendTestEmail(&report)
+	if err == nil {
+		return nil
+	}
+
+	severity := diag.Warning
+	if testEmailConfig["fail_on_error"].(bool) {
+		severity = diag.Error
+	}
+	return diag.Diagnostics{{
+		Severity: severity,
+		Summary:  fmt.Sprintf("failed to send a test email for report %q", report.Name),
+		Detail:   reportTestEmailErrorDetail(err),
+	}}
+}
+
+// reportTestEmailErrorDetail adds a hint to the known rendering errors, which Grafana only reports as generic server errors.
+func reportTestEmailErrorDetail(err error) string {
+	detail := err.Error()
+	lower := strings.ToLower(detail)
+	switch {
+	case strings.Contains(lower, "renderer"):
+		detail += "\nThe image renderer plugin (or remote rendering service) may not be installed or reachable from the Grafana server."
+	case strings.Contains(lower, "timeout") || strings.Contains(lower, "deadline exceeded") || strings.Contains(lower, "too large"):
+		detail += "\nThe dashboard may be too large to render. Consider reducing the number of panels or the time range of the report."
+	}
+	return detail
+}
+
 func schemaToReport(d *schema.ResourceData) (models.CreateOrUpdateReportConfig, error) {
 	frequency := d.Get("schedule.0.frequency").(string)
 	report := models.CreateOrUpdateReportConfig{
@@ -511,7 +605,8 @@ func setDashboards(report models.CreateOrUpdateReportConfig, d *schema.ResourceD
 				Dashboard: &models.ReportDashboardID{
 					UID: dash["uid"].(string),
 				},
-				TimeRange: tr,
+				TimeRange:       tr,
+				ReportVariables: reportVariablesFromSchema(dash["report_variables"].(map[string]interface{})),
 			})
 		}
 		return report
@@ -546,6 +641,42 @@ func setDashboards(report models.CreateOrUpdateReportConfig, d *schema.ResourceD
 	return report
 }
 
+// reportVariablesFromSchema converts the comma-separated values of the variables of a dashboard to the lists of values of the API.
+func reportVariablesFromSchema(variables map[string]interface{}) interface{} {
+	if len(variables) == 0 {
+		return nil
+	}
+	values := make(map[string][]string, len(variables))
+	for name, value := range variables {
+		values[name] = splitReportVariableValues(value.(string))
+	}
+	return values
+}
+
+// splitReportVariableValues splits the comma-separated values of a variable, trimming the whitespace around each value.
+// The values can't contain commas, since the map of variables can only hold strings.
+func splitReportVariableValues(value string) []string {
+	values := strings.Split(value, ",")
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values
+}
+
+func reportVariablesToSchema(variables interface{}) map[string]interface{} {
+	values := map[string]interface{}{}
+	raw, _ := variables.(map[string]interface{})
+	for name, value := range raw {
+		switch v := value.(type) {
+		case []interface{}:
+			values[name] = strings.Join(common.ListToStringSlice(v), ",")
+		case string:
+			values[name] = v
+		}
+	}
+	return values
+}
+
 func reportWorkdaysOnlyConfigAllowed(frequency string) bool {
 	return frequency == reportFrequencyHourly || frequency == reportFrequencyDaily || frequency == reportFrequencyCustom
 }
@@ -48,6 +48,10 @@ func TestAccResourceReport_Multiple_Dashboards(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.1.time_range.0.from", ""),
 					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.1.time_range.0.to", ""),
 					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.1.uid", "report2"),
+					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.0.report_variables.%", "0"),
+					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.1.report_variables.%", "2"),
+					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.1.report_variables.query0", "a,b"),
+					resource.TestCheckResourceAttr("grafana_report.test", "dashboards.1.report_variables.query1", "c"),
 				),
 			},
 		},
@@ -200,6 +204,40 @@ func TestAccResourceReport_InOrg(t *testing.T) {
 	})
 }
 
+func TestAccResourceReport_TestEmail(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t)
+
+	var report models.Report
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      reportCheckExists.destroyed(&report, nil),
+		Steps: []resource.TestStep{
+			{
+				// The test instance may not be able to render or send emails, the failure is then only a warning
+				Config: testAccReportWithTestEmail(name, "my report"),
+				Check: resource.ComposeTestCheckFunc(
+					reportCheckExists.exists("grafana_report.test", &report),
+					resource.TestCheckResourceAttr("grafana_report.test", "recipients.#", "1"),
+					resource.TestCheckResourceAttr("grafana_report.test", "recipients.0", "some@email.com"),
+					resource.TestCheckResourceAttr("grafana_report.test", "test_email.#", "1"),
+					resource.TestCheckResourceAttr("grafana_report.test", "test_email.0.recipients.0", "test@email.com"),
+					resource.TestCheckResourceAttr("grafana_report.test", "test_email.0.fail_on_error", "false"),
+				),
+			},
+			{
+				Config: testAccReportWithTestEmail(name, "my report updated"),
+				Check: resource.ComposeTestCheckFunc(
+					reportCheckExists.exists("grafana_report.test", &report),
+					resource.TestCheckResourceAttr("grafana_report.test", "name", "my report updated"),
+					resource.TestCheckResourceAttr("grafana_report.test", "recipients.0", "some@email.com"),
+				),
+			},
+		},
+	})
+}
+
 func testAccReportCreateFromID(uid string) string {
 	return fmt.Sprintf(`resource "grafana_dashboard" "test" {
 	config_json = <<EOD
@@ -249,3 +287,27 @@ resource "grafana_report" "test" {
 	}
 }`, name)
 }
+
+func testAccReportWithTestEmail(uid, name string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	config_json = <<EOD
+{
+	"title": "Dashboard for report with test email",
+	"uid": "%s"
+}
+EOD
+}
+
+resource "grafana_report" "test" {
+	name          = "%s"
+	dashboard_uid = grafana_dashboard.test.uid
+	recipients    = ["some@email.com"]
+	schedule {
+		frequency = "hourly"
+	}
+	test_email {
+		recipients = ["test@email.com"]
+	}
+}`, uid, name)
+}
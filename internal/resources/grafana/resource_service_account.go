@@ -2,9 +2,12 @@ package grafana
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"strconv"
 	"sync"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
@@ -13,6 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// serviceAccountNoneRoleMinimumVersion is the first Grafana version supporting service accounts without a basic role.
+var serviceAccountNoneRoleMinimumVersion = semver.MustParse("10.2.0")
+
 // Service Accounts have issues with concurrent creation, so we need to lock them.
 var serviceAccountCreateMutex sync.Mutex
 
@@ -29,6 +35,7 @@ func ResourceServiceAccount() *schema.Resource {
 		ReadContext:   ReadServiceAccount,
 		UpdateContext: UpdateServiceAccount,
 		DeleteContext: DeleteServiceAccount,
+		CustomizeDiff: validateServiceAccountRole,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -43,7 +50,7 @@ func ResourceServiceAccount() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin", "None"}, false),
-				Description:  "The basic role of the service account in the organization.",
+				Description:  "The basic role of the service account in the organization. The `None` role, for service accounts whose permissions are only granted by RBAC roles, requires Grafana 10.2+.",
 			},
 			"is_disabled": {
 				Type:        schema.TypeBool,
@@ -59,7 +66,7 @@ func CreateServiceAccount(ctx context.Context, d *schema.ResourceData, meta inte
 	serviceAccountCreateMutex.Lock()
 	defer serviceAccountCreateMutex.Unlock()
 
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	req := models.CreateServiceAccountForm{
 		Name:       d.Get("name").(string),
 		Role:       d.Get("role").(string),
@@ -77,7 +84,7 @@ func CreateServiceAccount(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func ReadServiceAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -98,7 +105,7 @@ func ReadServiceAccount(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func UpdateServiceAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -125,8 +132,30 @@ func UpdateServiceAccount(ctx context.Context, d *schema.ResourceData, meta inte
 	return ReadServiceAccount(ctx, d, meta)
 }
 
+// validateServiceAccountRole checks that the `None` role is supported by the Grafana instance, which would otherwise reject it with an unclear error.
+// The check is skipped if the version can't be detected.
+func validateServiceAccountRole(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("role").(string) != "None" || (d.Id() != "" && !d.HasChange("role")) {
+		return nil
+	}
+	client, ok := meta.(*common.Client)
+	if !ok || client.GrafanaOAPI == nil {
+		return nil
+	}
+
+	version, err := client.GrafanaVersion()
+	if err != nil {
+		log.Printf("[WARN] skipping the validation of the service account role: %v", err)
+		return nil
+	}
+	if version.LessThan(serviceAccountNoneRoleMinimumVersion) {
+		return fmt.Errorf("the `None` role requires Grafana >= %s, target is %s. Use the `Viewer`, `Editor` or `Admin` role", serviceAccountNoneRoleMinimumVersion, version)
+	}
+	return nil
+}
+
 func DeleteServiceAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
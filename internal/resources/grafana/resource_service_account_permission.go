@@ -87,7 +87,7 @@ func ReadServiceAccountPermissions(ctx context.Context, d *schema.ResourceData,
 }
 
 func CreateServiceAccountPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	_, idStr := SplitOrgResourceID(d.Get("service_account_id").(string))
 	d.SetId(MakeOrgResourceID(orgID, idStr))
 
@@ -105,7 +105,7 @@ func CreateServiceAccountPermissions(ctx context.Context, d *schema.ResourceData
 }
 
 func UpdateServiceAccountPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	old, new := d.GetChange("permissions")
 	err := updateServiceAccountPermissions(client, idStr, old, new)
@@ -117,7 +117,7 @@ func UpdateServiceAccountPermissions(ctx context.Context, d *schema.ResourceData
 }
 
 func DeleteServiceAccountPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	_, serviceAccountID := SplitOrgResourceID(d.Get("service_account_id").(string))
 	id, err := strconv.ParseInt(serviceAccountID, 10, 64)
@@ -134,7 +134,7 @@ func DeleteServiceAccountPermissions(ctx context.Context, d *schema.ResourceData
 }
 
 func getServiceAccountPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, diag.Diagnostics) {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.AccessControl.GetResourcePermissions(idStr, serviceAccountsPermissionsType)
 	if err, shouldReturn := common.CheckReadError("service account permissions", d, err); shouldReturn {
@@ -223,6 +223,11 @@ func updateServiceAccountPermissions(client *goapi.GrafanaHTTPAPI, idStr string,
 		})
 	}
 
+	// All the changes are sent in a single call, which is skipped if there are none
+	if len(permissionList) == 0 {
+		return nil
+	}
+
 	params := access_control.NewSetResourcePermissionsParams().
 		WithResource(serviceAccountsPermissionsType).
 		WithResourceID(idStr).
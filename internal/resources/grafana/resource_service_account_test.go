@@ -83,6 +83,22 @@ func TestAccServiceAccount_NoneRole(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccount_NoneRoleUnsupported(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0,<10.2.0")
+
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceAccountConfig(name, "None"),
+				ExpectError: regexp.MustCompile("the `None` role requires Grafana >= 10.2.0"),
+			},
+		},
+	})
+}
+
 func TestAccServiceAccount_many_longtest(t *testing.T) {
 	if testing.Short() { // Also named "longtest" to allow targeting with -run=.*longtest
 		t.Skip("skipping test in short mode")
@@ -59,7 +59,7 @@ func ResourceServiceAccountToken() *schema.Resource {
 
 func serviceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
-	c := m.(*common.Client).GrafanaOAPI.Clone().WithOrgID(orgID)
+	c := common.OAPIWithContext(ctx, m.(*common.Client).GrafanaOAPIWithOrgID(orgID))
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -91,7 +91,7 @@ func serviceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m in
 
 func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
-	c := m.(*common.Client).GrafanaOAPI.Clone().WithOrgID(orgID)
+	c := common.OAPIWithContext(ctx, m.(*common.Client).GrafanaOAPIWithOrgID(orgID))
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -133,7 +133,7 @@ func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m inte
 
 func serviceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
-	c := m.(*common.Client).GrafanaOAPI.Clone().WithOrgID(orgID)
+	c := common.OAPIWithContext(ctx, m.(*common.Client).GrafanaOAPIWithOrgID(orgID))
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -113,7 +114,7 @@ func TestAccServiceAccountToken_inOrg(t *testing.T) {
 
 func checkServiceAccountTokens(sa *models.ServiceAccountDTO, expectNames []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := grafana.OAPIGlobalClient(testutils.Provider.Meta()).WithOrgID(sa.OrgID)
+		client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta()).WithOrgID(sa.OrgID)
 		resp, err := client.ServiceAccounts.ListTokens(sa.ID)
 		if err != nil {
 			return err
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/search"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,6 +55,11 @@ func ResourceTeam() *schema.Resource {
 				Computed:    true,
 				Description: "The team id assigned to this team by Grafana.",
 			},
+			"team_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The team uid assigned to this team by Grafana.",
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -104,16 +110,17 @@ Team Sync can be provisioned using [grafana_team_external_group resource](https:
 							Default:      "",
 						},
 						"home_dashboard_uid": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The UID of the dashboard to display when a team member logs in.",
-							Default:     "",
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "The UID of the dashboard to display when a team member logs in, e.g. the `uid` attribute of a `grafana_dashboard` resource. " +
+								"It's cleared, with a warning, when the dashboard is deleted.",
+							Default: "",
 						},
 						"timezone": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"utc", "browser", ""}, false),
-							Description:  "The default timezone for this team. Available values are `utc`, `browser`, or an empty string for the default.",
+							ValidateFunc: validatePreferencesTimezone,
+							Description:  "The default timezone for this team. Available values are `utc`, `browser`, a location of the IANA time zone database (e.g. `Europe/Paris`), or an empty string for the default.",
 							Default:      "",
 						},
 						"week_start": {
@@ -151,7 +158,7 @@ Team Sync can be provisioned using [grafana_team_external_group resource](https:
 }
 
 func CreateTeam(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	body := models.CreateTeamCommand{
 		Name:  d.Get("name").(string),
 		Email: d.Get("email").(string),
@@ -182,7 +189,7 @@ func CreateTeam(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 }
 
 func ReadTeam(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 	_, readTeamSync := d.GetOk("team_sync")
 	return readTeamFromID(client, teamID, d, readTeamSync)
@@ -197,6 +204,7 @@ func readTeamFromID(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.Resour
 
 	d.SetId(MakeOrgResourceID(team.OrgID, teamID))
 	d.Set("team_id", teamID)
+	d.Set("team_uid", team.UID)
 	d.Set("name", team.Name)
 	d.Set("org_id", strconv.FormatInt(team.OrgID, 10))
 	if team.Email != "" {
@@ -227,6 +235,24 @@ func readTeamFromID(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.Resour
 		})
 	}
 
+	// Grafana versions before 9.0 only return the ID of the home dashboard.
+	// The preferences still reference the home dashboard after it's deleted, it's then cleared rather than failing the read.
+	var diags diag.Diagnostics
+	if preferences.HomeDashboardUID != "" || preferences.HomeDashboardID != 0 {
+		uid, err := findDashboardUID(client, preferences.HomeDashboardUID, preferences.HomeDashboardID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if uid == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The home dashboard of team %q doesn't exist anymore", team.Name),
+				Detail:   "The home_dashboard_uid preference is cleared. Grafana uses the home dashboard of the organization until the team's preferences are updated.",
+			})
+		}
+		preferences.HomeDashboardUID = uid
+	}
+
 	if preferences.Theme+preferences.Timezone+preferences.HomeDashboardUID+preferences.WeekStart != "" {
 		d.Set("preferences", []map[string]interface{}{
 			{
@@ -238,11 +264,11 @@ func readTeamFromID(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.Resour
 		})
 	}
 
-	return readTeamMembers(client, d)
+	return append(diags, readTeamMembers(client, d)...)
 }
 
 func UpdateTeam(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 	if d.HasChange("name") || d.HasChange("email") {
 		name := d.Get("name").(string)
@@ -273,12 +299,43 @@ func UpdateTeam(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 }
 
 func DeleteTeam(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	_, err := client.Teams.DeleteTeamByID(idStr)
 	diag, _ := common.CheckReadError("team", d, err)
 	return diag
 }
 
+// dashboardUIDFromID returns the UID of the dashboard with the given ID.
+func dashboardUIDFromID(client *goapi.GrafanaHTTPAPI, id int64) (string, error) {
+	uid, err := findDashboardUID(client, "", id)
+	if err == nil && uid == "" {
+		return "", fmt.Errorf("no dashboard with id %d", id)
+	}
+	return uid, err
+}
+
+// findDashboardUID returns the UID of the dashboard with the given UID or, if it's empty, with the given ID.
+// It returns an empty string if the dashboard doesn't exist.
+func findDashboardUID(client *goapi.GrafanaHTTPAPI, uid string, id int64) (string, error) {
+	searchType := "dash-db"
+	params := search.NewSearchParams().WithType(&searchType)
+	if uid != "" {
+		params.SetDashboardUIDs([]string{uid})
+	} else {
+		params.SetDashboardIds([]int64{id})
+	}
+	resp, err := client.Search.Search(params)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range resp.GetPayload() {
+		if (uid != "" && d.UID == uid) || (uid == "" && d.ID == id) {
+			return d.UID, nil
+		}
+	}
+	return "", nil
+}
+
 func updateTeamPreferences(client *goapi.GrafanaHTTPAPI, teamID int64, d *schema.ResourceData) diag.Diagnostics {
 	if d.IsNewResource() || d.HasChanges("preferences.0.theme", "preferences.0.home_dashboard_uid", "preferences.0.timezone", "preferences.0.week_start") {
 		body := models.UpdatePrefsCmd{
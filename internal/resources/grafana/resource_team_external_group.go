@@ -54,7 +54,7 @@ func CreateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta i
 	orgID, teamIDStr := SplitOrgResourceID(d.Get("team_id").(string))
 	teamID, _ := strconv.ParseInt(teamIDStr, 10, 64)
 	d.SetId(MakeOrgResourceID(orgID, teamID))
-	client, _, _ := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, _ := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	if err := manageTeamExternalGroup(client, teamID, d, "groups"); err != nil {
 		return diag.FromErr(err)
@@ -64,7 +64,7 @@ func CreateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func ReadTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 
 	resp, err := client.SyncTeamGroups.GetTeamGroupsAPI(teamID)
@@ -85,7 +85,7 @@ func ReadTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func UpdateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 
 	if err := manageTeamExternalGroup(client, teamID, d, "groups"); err != nil {
@@ -96,7 +96,7 @@ func UpdateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func DeleteTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 	if err := applyTeamExternalGroup(client, teamID, nil, common.SetToStringSlice(d.Get("groups").(*schema.Set))); err != nil {
 		return diag.FromErr(err)
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -73,7 +74,7 @@ func TestAccTeamExternalGroup_basic(t *testing.T) {
 
 func testAccTeamExternalGroupCheck(team *models.TeamDTO, expectedGroups []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := grafana.OAPIGlobalClient(testutils.Provider.Meta())
+		client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
 
 		resp, err := client.SyncTeamGroups.GetTeamGroupsAPI(team.ID)
 		if err != nil {
@@ -1,7 +1,9 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -103,6 +105,66 @@ func TestAccTeam_preferences(t *testing.T) {
 	})
 }
 
+func TestAccTeam_preferencesTimezone(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0")
+
+	var team models.TeamDTO
+	teamName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      teamCheckExists.destroyed(&team, nil),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTeamPreferencesTimezone(teamName, "Not/A_Timezone"),
+				ExpectError: regexp.MustCompile(`IANA time zone database, got "Not/A_Timezone"`),
+			},
+			{
+				Config: testAccTeamPreferencesTimezone(teamName, "Europe/Paris"),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.test", &team),
+					resource.TestCheckResourceAttr("grafana_team.test", "preferences.0.timezone", "Europe/Paris"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeam_preferencesDeletedHomeDashboard(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0")
+
+	var team models.TeamDTO
+	teamName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      teamCheckExists.destroyed(&team, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamPreferencesHomeDashboard(teamName),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.test", &team),
+					resource.TestCheckResourceAttr("grafana_team.test", "preferences.0.home_dashboard_uid", teamName),
+				),
+			},
+			// The home dashboard is deleted outside of Terraform: the read clears it instead of failing
+			{
+				PreConfig: func() {
+					client := testutils.Provider.Meta().(*common.Client).GrafanaOAPI
+					if _, err := client.Dashboards.DeleteDashboardByUID(teamName); err != nil {
+						t.Fatal(err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_team.test", "preferences.0.home_dashboard_uid", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTeam_teamSync(t *testing.T) {
 	testutils.CheckEnterpriseTestsEnabled(t, ">= 8.0.0")
 
@@ -234,7 +296,7 @@ func TestAccTeam_Members(t *testing.T) {
 // Test that deleted users can still be removed as members of a team
 func TestAccTeam_RemoveUnexistingMember(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t)
-	client := grafana.OAPIGlobalClient(testutils.Provider.Meta())
+	client := grafana.OAPIGlobalClient(context.Background(), testutils.Provider.Meta())
 
 	var team models.TeamDTO
 	var userID int64 = -1
@@ -391,3 +453,32 @@ resource "grafana_team" "test" {
 	members = [ ]
 }`, orgName)
 }
+
+func testAccTeamPreferencesTimezone(name, timezone string) string {
+	return fmt.Sprintf(`
+resource "grafana_team" "test" {
+	name = "%s"
+	preferences {
+		timezone = "%s"
+	}
+}
+`, name, timezone)
+}
+
+func testAccTeamPreferencesHomeDashboard(name string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "home" {
+	config_json = jsonencode({
+		uid   = "%[1]s"
+		title = "%[1]s"
+	})
+}
+
+resource "grafana_team" "test" {
+	name = "%[1]s"
+	preferences {
+		home_dashboard_uid = grafana_dashboard.home.uid
+	}
+}
+`, name)
+}
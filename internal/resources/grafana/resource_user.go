@@ -62,12 +62,18 @@ You must use basic auth.
 				Default:     false,
 				Description: "Whether to make user an admin.",
 			},
+			"is_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the user is disabled. Disabled users can't log in, and their sessions are revoked.",
+			},
 		},
 	}
 }
 
 func CreateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	user := models.AdminCreateUserForm{
 		Email:    d.Get("email").(string),
 		Name:     d.Get("name").(string),
@@ -85,11 +91,16 @@ func CreateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
+	if d.Get("is_disabled").(bool) {
+		if _, err := client.AdminUsers.AdminDisableUser(resp.Payload.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return ReadUser(ctx, d, meta)
 }
 
 func ReadUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -105,11 +116,12 @@ func ReadUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	d.Set("name", user.Name)
 	d.Set("login", user.Login)
 	d.Set("is_admin", user.IsGrafanaAdmin)
+	d.Set("is_disabled", user.IsDisabled)
 	return nil
 }
 
 func UpdateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -134,11 +146,22 @@ func UpdateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 			return diag.FromErr(err)
 		}
 	}
+
+	if d.HasChange("is_disabled") {
+		if d.Get("is_disabled").(bool) {
+			_, err = client.AdminUsers.AdminDisableUser(id)
+		} else {
+			_, err = client.AdminUsers.AdminEnableUser(id)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return ReadUser(ctx, d, meta)
 }
 
 func DeleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := OAPIGlobalClient(meta)
+	client := OAPIGlobalClient(ctx, meta)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
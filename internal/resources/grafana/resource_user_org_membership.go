@@ -0,0 +1,170 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/orgs"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceUserOrgMembership() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+Manages the membership of a user in an organization, and the role of the user in it.
+
+This is the per-membership alternative to the ` + "`admins`" + `, ` + "`editors`" + `, ` + "`viewers`" + ` and ` + "`users_without_access`" + ` attributes of the ` + "`grafana_organization`" + ` resource,
+which manage the complete list of the users of an organization. Don't use both to manage the users of the same organization:
+the organization resource removes the users that it doesn't list, unless changes to its user lists are ignored as in the example below.
+
+If the user is already a member of the organization, e.g. because Grafana added it to its default organization, the membership is adopted and its role is updated.
+Destroying this resource removes the user from the organization.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/user-management/manage-org-users/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/org/#add-user-in-organization)
+
+This resource represents an instance-scoped resource and uses Grafana's admin APIs.
+It does not work with API tokens or service accounts which are org-scoped.
+You must use basic auth.
+`,
+
+		CreateContext: CreateUserOrgMembership,
+		ReadContext:   ReadUserOrgMembership,
+		UpdateContext: UpdateUserOrgMembership,
+		DeleteContext: DeleteUserOrgMembership,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(common.IDRegexp, "must be a numeric ID"),
+				Description:  "The ID of the organization, i.e. the `org_id` attribute of a `grafana_organization` resource.",
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(common.IDRegexp, "must be a numeric ID"),
+				Description:  "The ID of the user, i.e. the `id` attribute of a `grafana_user` resource.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Admin", "Editor", "Viewer", "None"}, false),
+				Description:  "The role of the user in the organization. Available values are `Admin`, `Editor`, `Viewer` and `None`. The `None` role requires Grafana 10.2+.",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The login of the user.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email of the user.",
+			},
+		},
+	}
+}
+
+func CreateUserOrgMembership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := OAPIGlobalClient(ctx, meta)
+	orgID, _ := strconv.ParseInt(d.Get("org_id").(string), 10, 64)
+	userID, _ := strconv.ParseInt(d.Get("user_id").(string), 10, 64)
+	role := d.Get("role").(string)
+
+	user, err := client.Users.GetUserByID(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.Orgs.AddOrgUser(orgID, &models.AddOrgUserCommand{LoginOrEmail: user.Payload.Login, Role: role})
+	if err != nil && strings.Contains(err.Error(), "409") {
+		log.Printf("[INFO] user %s is already a member of organization %d, updating its role", user.Payload.Login, orgID)
+		err = updateUserOrgRole(client, orgID, userID, role)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, userID))
+	return ReadUserOrgMembership(ctx, d, meta)
+}
+
+func ReadUserOrgMembership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := OAPIGlobalClient(ctx, meta)
+	orgID, userID, err := splitUserOrgMembershipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Orgs.GetOrgUsers(orgID)
+	if err, shouldReturn := common.CheckReadError("organization", d, err); shouldReturn {
+		return err
+	}
+	var member *models.OrgUserDTO
+	for _, u := range resp.Payload {
+		if u.UserID == userID {
+			member = u
+			break
+		}
+	}
+	if member == nil {
+		log.Printf("[WARN] removing the membership of user %d in organization %d from state because the user isn't a member", userID, orgID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("user_id", strconv.FormatInt(userID, 10))
+	d.Set("role", member.Role)
+	d.Set("login", member.Login)
+	d.Set("email", member.Email)
+	return nil
+}
+
+func UpdateUserOrgMembership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := OAPIGlobalClient(ctx, meta)
+	orgID, userID, _ := splitUserOrgMembershipID(d.Id())
+	if err := updateUserOrgRole(client, orgID, userID, d.Get("role").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return ReadUserOrgMembership(ctx, d, meta)
+}
+
+func DeleteUserOrgMembership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := OAPIGlobalClient(ctx, meta)
+	orgID, userID, _ := splitUserOrgMembershipID(d.Id())
+	_, err := client.Orgs.RemoveOrgUser(userID, orgID)
+	diag, _ := common.CheckReadError("organization membership", d, err)
+	return diag
+}
+
+func updateUserOrgRole(client *goapi.GrafanaHTTPAPI, orgID, userID int64, role string) error {
+	params := orgs.NewUpdateOrgUserParams().WithOrgID(orgID).WithUserID(userID).WithBody(&models.UpdateOrgUserCommand{Role: role})
+	_, err := client.Orgs.UpdateOrgUser(params)
+	return err
+}
+
+// splitUserOrgMembershipID splits the ID of a membership into the IDs of its organization and user.
+// Unlike org-scoped resources, the org ID of a membership can't be omitted.
+func splitUserOrgMembershipID(id string) (int64, int64, error) {
+	orgID, userIDStr := SplitOrgResourceID(id)
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if orgID <= 0 || err != nil {
+		return 0, 0, fmt.Errorf("invalid ID %q, the ID of a membership must be in the <org ID>:<user ID> format", id)
+	}
+	return orgID, userID, nil
+}
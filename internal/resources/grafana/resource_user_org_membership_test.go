@@ -0,0 +1,146 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccUserOrgMembership_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var org models.OrgDetailsDTO
+	var user models.UserProfileDTO
+	name := acctest.RandomWithPrefix("membership")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			orgCheckExists.destroyed(&org, nil),
+			userCheckExists.destroyed(&user, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserOrgMembershipConfig(name, "Editor", "Admin"),
+				Check: resource.ComposeTestCheckFunc(
+					orgCheckExists.exists("grafana_organization.test", &org),
+					userCheckExists.exists("grafana_user.test", &user),
+					checkUserOrgMembership("grafana_user_org_membership.test", "Editor"),
+					resource.TestCheckResourceAttr("grafana_user_org_membership.test", "role", "Editor"),
+					resource.TestCheckResourceAttr("grafana_user_org_membership.test", "login", name),
+					resource.TestCheckResourceAttr("grafana_user_org_membership.test", "email", name+"@example.com"),
+					resource.TestCheckResourceAttrPair("grafana_user_org_membership.test", "org_id", "grafana_organization.test", "org_id"),
+					resource.TestCheckResourceAttrPair("grafana_user_org_membership.test", "user_id", "grafana_user.test", "id"),
+					// The membership in the default org, which Grafana creates with the user, is adopted
+					checkUserOrgMembership("grafana_user_org_membership.default", "Admin"),
+					resource.TestCheckResourceAttr("grafana_user_org_membership.default", "org_id", "1"),
+				),
+			},
+			{
+				Config: testAccUserOrgMembershipConfig(name, "Viewer", "Admin"),
+				Check: resource.ComposeTestCheckFunc(
+					checkUserOrgMembership("grafana_user_org_membership.test", "Viewer"),
+					resource.TestCheckResourceAttr("grafana_user_org_membership.test", "role", "Viewer"),
+				),
+			},
+			{
+				ResourceName:      "grafana_user_org_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the membership removes the user from the org
+			{
+				Config: testAccUserOrgMembershipConfigDefaultOrg(name, "Admin"),
+				Check:  checkUserOrgMembershipRemoved(&org, &user),
+			},
+		},
+	})
+}
+
+// checkUserOrgMembership checks that the user of a membership has the given role in its organization.
+func checkUserOrgMembership(rn, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+		member, err := findOrgUser(mustParseInt64(rs.Primary.Attributes["org_id"]), mustParseInt64(rs.Primary.Attributes["user_id"]))
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return fmt.Errorf("user %s isn't a member of org %s", rs.Primary.Attributes["user_id"], rs.Primary.Attributes["org_id"])
+		}
+		if member.Role != role {
+			return fmt.Errorf("expected role %s, got %s", role, member.Role)
+		}
+		return nil
+	}
+}
+
+func checkUserOrgMembershipRemoved(org *models.OrgDetailsDTO, user *models.UserProfileDTO) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		member, err := findOrgUser(org.ID, user.ID)
+		if err != nil {
+			return err
+		}
+		if member != nil {
+			return fmt.Errorf("user %d is still a member of org %d", user.ID, org.ID)
+		}
+		return nil
+	}
+}
+
+func findOrgUser(orgID, userID int64) (*models.OrgUserDTO, error) {
+	client := testutils.Provider.Meta().(*common.Client).GrafanaOAPI.WithOrgID(0)
+	resp, err := client.Orgs.GetOrgUsers(orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range resp.Payload {
+		if u.UserID == userID {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func testAccUserOrgMembershipConfigDefaultOrg(name, role string) string {
+	return fmt.Sprintf(`
+resource "grafana_organization" "test" {
+  name = "%[1]s"
+
+  lifecycle {
+    ignore_changes = [admins, editors, viewers, users_without_access]
+  }
+}
+
+resource "grafana_user" "test" {
+  email    = "%[1]s@example.com"
+  login    = "%[1]s"
+  password = "abc123"
+}
+
+resource "grafana_user_org_membership" "default" {
+  org_id  = "1"
+  user_id = grafana_user.test.id
+  role    = "%[2]s"
+}
+`, name, role)
+}
+
+func testAccUserOrgMembershipConfig(name, role, defaultOrgRole string) string {
+	return testAccUserOrgMembershipConfigDefaultOrg(name, defaultOrgRole) + fmt.Sprintf(`
+resource "grafana_user_org_membership" "test" {
+  org_id  = grafana_organization.test.org_id
+  user_id = grafana_user.test.id
+  role    = %q
+}
+`, role)
+}
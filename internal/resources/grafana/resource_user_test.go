@@ -1,9 +1,11 @@
 package grafana_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
@@ -70,6 +72,60 @@ func TestAccUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccUser_disabled(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var user models.UserProfileDTO
+	checkDisabled := func(disabled bool) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if user.IsDisabled != disabled {
+				return fmt.Errorf("expected the user to be disabled: %t, got %t", disabled, user.IsDisabled)
+			}
+			return nil
+		}
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      userCheckExists.destroyed(&user, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfigDisabled(false),
+				Check: resource.ComposeTestCheckFunc(
+					userCheckExists.exists("grafana_user.test", &user),
+					checkDisabled(false),
+					resource.TestCheckResourceAttr("grafana_user.test", "is_disabled", "false"),
+				),
+			},
+			{
+				Config: testAccUserConfigDisabled(true),
+				Check: resource.ComposeTestCheckFunc(
+					userCheckExists.exists("grafana_user.test", &user),
+					checkDisabled(true),
+					resource.TestCheckResourceAttr("grafana_user.test", "is_disabled", "true"),
+				),
+			},
+			{
+				Config: testAccUserConfigDisabled(false),
+				Check: resource.ComposeTestCheckFunc(
+					userCheckExists.exists("grafana_user.test", &user),
+					checkDisabled(false),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserConfigDisabled(disabled bool) string {
+	return fmt.Sprintf(`
+resource "grafana_user" "test" {
+  email       = "terraform-disabled@localhost"
+  login       = "tt-disabled"
+  password    = "abc123"
+  is_disabled = %t
+}
+`, disabled)
+}
+
 const testAccUserConfig_basic = `
 resource "grafana_user" "test" {
   email    = "terraform-test@localhost"
@@ -0,0 +1,186 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// dashboardModelFields are the top-level fields of the dashboard model known to Grafana, including the fields of exported dashboards.
+// It's the union of the fields of all the Grafana versions, rather than a list per version: a field added in a version newer than the instance isn't reported.
+var dashboardModelFields = map[string]bool{
+	"__elements": true, "__inputs": true, "__requires": true,
+	"annotations": true, "description": true, "editable": true, "fiscalYearStartMonth": true, "gnetId": true, "graphTooltip": true,
+	"hideControls": true, "id": true, "iteration": true, "links": true, "liveNow": true, "panels": true, "preload": true, "refresh": true,
+	"revision": true, "rows": true, "schemaVersion": true, "sharedCrosshair": true, "snapshot": true, "style": true, "tags": true,
+	"templating": true, "time": true, "timepicker": true, "timezone": true, "title": true, "uid": true, "version": true, "weekStart": true,
+}
+
+// dashboardSchemaVersions lists the dashboard schema versions with the first Grafana version which migrates dashboards to them.
+// Grafana doesn't migrate dashboards with a newer schema version, which may use panel options it doesn't know.
+var dashboardSchemaVersions = []struct {
+	schemaVersion  int
+	grafanaVersion string
+}{
+	{27, "7.4.0"},
+	{30, "8.0.0"},
+	{36, "8.5.0"},
+	{37, "9.1.0"},
+	{38, "10.0.0"},
+	{39, "10.2.0"},
+}
+
+// dashboardStrictSchemaWarnings returns warnings about the parts of a dashboard model unknown to the Grafana instance, if the provider's `strict_schema` attribute is set.
+func dashboardStrictSchemaWarnings(ctx context.Context, meta interface{}, orgID int64, model map[string]interface{}) diag.Diagnostics {
+	client, ok := meta.(*common.Client)
+	if !ok || !client.StrictSchema {
+		return nil
+	}
+
+	var findings []string
+	for _, field := range unknownDashboardFields(model) {
+		findings = append(findings, fmt.Sprintf("field %q is unknown to Grafana", field))
+	}
+	if schemaVersion, ok := model["schemaVersion"].(float64); ok {
+		if required, known := dashboardSchemaVersionRequirement(int(schemaVersion)); !known {
+			latest := dashboardSchemaVersions[len(dashboardSchemaVersions)-1].schemaVersion
+			findings = append(findings, fmt.Sprintf("schema version %d is unknown or newer than supported by the provider (%d), it can't be checked", int(schemaVersion), latest))
+		} else if version, err := client.GrafanaVersion(); err != nil {
+			log.Printf("[WARN] skipping the check of the dashboard schema version: %v", err)
+		} else if version.LessThan(required) {
+			findings = append(findings, fmt.Sprintf("schema version %d requires Grafana >= %s, target is %s", int(schemaVersion), required, version))
+		}
+	}
+	if installed, err := installedPlugins(ctx, client, orgID, "panel"); err != nil {
+		log.Printf("[WARN] skipping the check of the dashboard panel types: %v", err)
+	} else {
+		for _, panelType := range dashboardPanelTypes(model) {
+			if !installed[panelType] {
+				findings = append(findings, fmt.Sprintf("panel type %q isn't installed", panelType))
+			}
+		}
+	}
+
+	if len(findings) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "The dashboard JSON isn't supported by the Grafana instance",
+		Detail:   strings.Join(findings, "\n"),
+	}}
+}
+
+// dataSourceStrictSchemaWarnings returns a warning if the plugin of a data source isn't installed on the Grafana instance, if the provider's `strict_schema` attribute is set.
+func dataSourceStrictSchemaWarnings(ctx context.Context, meta interface{}, orgID int64, dataSourceType string) diag.Diagnostics {
+	client, ok := meta.(*common.Client)
+	if !ok || !client.StrictSchema {
+		return nil
+	}
+
+	installed, err := installedPlugins(ctx, client, orgID, "datasource")
+	if err != nil {
+		log.Printf("[WARN] skipping the check of the data source type: %v", err)
+		return nil
+	}
+	if installed[dataSourceType] {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "The data source type isn't supported by the Grafana instance",
+		Detail:   fmt.Sprintf("data source type %q isn't installed", dataSourceType),
+	}}
+}
+
+// unknownDashboardFields returns the top-level fields of a dashboard model which aren't in dashboardModelFields, sorted by name.
+func unknownDashboardFields(model map[string]interface{}) []string {
+	var unknown []string
+	for field := range model {
+		if !dashboardModelFields[field] {
+			unknown = append(unknown, field)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// dashboardSchemaVersionRequirement returns the minimum Grafana version supporting a dashboard schema version.
+// It returns false for the schema versions newer than the ones of dashboardSchemaVersions, whose requirement isn't known.
+func dashboardSchemaVersionRequirement(schemaVersion int) (*semver.Version, bool) {
+	for _, v := range dashboardSchemaVersions {
+		if schemaVersion <= v.schemaVersion {
+			return semver.MustParse(v.grafanaVersion), true
+		}
+	}
+	return nil, false
+}
+
+// dashboardPanelTypes returns the plugin types of the panels of a dashboard model, including the panels of collapsed rows and of the legacy `rows` schema, sorted by name.
+// Rows and library panels, which don't have a plugin type, are left out.
+func dashboardPanelTypes(model map[string]interface{}) []string {
+	types := map[string]bool{}
+	var walk func(panels interface{})
+	walk = func(panels interface{}) {
+		list, _ := panels.([]interface{})
+		for _, p := range list {
+			panel, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if panelType, _ := panel["type"].(string); panelType != "" && panelType != "row" {
+				types[panelType] = true
+			}
+			walk(panel["panels"])
+		}
+	}
+
+	walk(model["panels"])
+	rows, _ := model["rows"].([]interface{})
+	for _, r := range rows {
+		if row, ok := r.(map[string]interface{}); ok {
+			walk(row["panels"])
+		}
+	}
+
+	sorted := make([]string, 0, len(types))
+	for t := range types {
+		sorted = append(sorted, t)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// installedPlugins returns the IDs of the plugins of the given type installed on the Grafana instance, including its core plugins.
+func installedPlugins(ctx context.Context, client *common.Client, orgID int64, pluginType string) (map[string]bool, error) {
+	return common.MemoizeList(client, "plugins/"+pluginType, func() (map[string]bool, error) {
+		status, body, err := grafanaAPIRequest(ctx, client, orgID, http.MethodGet, "/api/plugins", url.Values{"type": {pluginType}}, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		if status >= 400 {
+			return nil, fmt.Errorf("failed to list the %s plugins: status: %d, body: %s", pluginType, status, body)
+		}
+		var plugins []struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(body, &plugins); err != nil {
+			return nil, fmt.Errorf("failed to decode the %s plugins: %w", pluginType, err)
+		}
+		installed := make(map[string]bool, len(plugins))
+		for _, p := range plugins {
+			installed[p.ID] = true
+		}
+		return installed, nil
+	})
+}
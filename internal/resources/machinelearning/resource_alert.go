@@ -0,0 +1,282 @@
+package machinelearning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+var (
+	alertAnomalyConditions = []string{"any", "low", "high"}
+	alertNoDataStates      = []string{"Alerting", "NoData", "OK"}
+)
+
+// mlAlert is an alert of a forecast job or an outlier detector. The alerts API isn't supported by the ML client.
+type mlAlert struct {
+	ID               string            `json:"id,omitempty"`
+	Title            string            `json:"title"`
+	AnomalyCondition string            `json:"anomalyCondition,omitempty"`
+	For              string            `json:"for,omitempty"`
+	Threshold        string            `json:"threshold,omitempty"`
+	Window           string            `json:"window,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Annotations      map[string]string `json:"annotations,omitempty"`
+	NoDataState      string            `json:"noDataState,omitempty"`
+}
+
+func ResourceAlert() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+An alert fires when a forecast job or an outlier detector finds anomalies.
+
+The alerts are evaluated by Grafana Alerting: their notifications are routed to contact points by the notification policies matching their labels.
+`,
+
+		CreateContext: ResourceAlertCreate,
+		ReadContext:   ResourceAlertRead,
+		UpdateContext: ResourceAlertUpdate,
+		DeleteContext: ResourceAlertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the alert, in the `job:{{job_id}}:{{alert_id}}` or `outlier:{{outlier_id}}:{{alert_id}}` format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"job_id": {
+				Description:  "The forecast this alert belongs to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"job_id", "outlier_id"},
+			},
+			"outlier_id": {
+				Description: "The outlier detector this alert belongs to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"title": {
+				Description: "The title of the alert.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"anomaly_condition": {
+				Description:  common.AllowedValuesDescription("The condition for when to consider a point as anomalous: above the forecast (`high`), below it (`low`) or both (`any`). Only used by the alerts of forecasts", alertAnomalyConditions),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(alertAnomalyConditions, false),
+			},
+			"threshold": {
+				Description: "The threshold of points over the window that need to be anomalous to alert, e.g. `>0.8`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"window": {
+				Description:      "How much time to average values over, e.g. `30m`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: common.ValidateDuration,
+				DiffSuppressFunc: suppressEquivalentDurations,
+			},
+			"for": {
+				Description:      "The time the alert condition must be met before the alert fires, e.g. `5m`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0s",
+				ValidateDiagFunc: common.ValidateDuration,
+				DiffSuppressFunc: suppressEquivalentDurations,
+			},
+			"labels": {
+				Description: "Labels to add to the alert, used to route its notifications to contact points with the notification policies.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Description: "Annotations to add to the alert, e.g. `summary` or `runbook_url`.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"no_data_state": {
+				Description:  common.AllowedValuesDescription("The state of the alert when the job or outlier detector has no data", alertNoDataStates),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(alertNoDataStates, false),
+			},
+		},
+	}
+}
+
+func ResourceAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kind, parentID := "job", d.Get("job_id").(string)
+	if outlierID := d.Get("outlier_id").(string); outlierID != "" {
+		kind, parentID = "outlier", outlierID
+	}
+
+	var alert mlAlert
+	if err := mlAlertRequest(ctx, meta, http.MethodPost, alertsPath(kind, parentID), makeMLAlert(d), &alert); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s", kind, parentID, alert.ID))
+	return ResourceAlertRead(ctx, d, meta)
+}
+
+func ResourceAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kind, parentID, alertID, err := splitAlertID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var alert mlAlert
+	err = mlAlertRequest(ctx, meta, http.MethodGet, alertsPath(kind, parentID)+"/"+alertID, nil, &alert)
+	if err, shouldReturn := common.CheckReadError("alert", d, err); shouldReturn {
+		return err
+	}
+
+	if kind == "job" {
+		d.Set("job_id", parentID)
+		d.Set("outlier_id", nil)
+	} else {
+		d.Set("job_id", nil)
+		d.Set("outlier_id", parentID)
+	}
+	d.Set("title", alert.Title)
+	d.Set("anomaly_condition", alert.AnomalyCondition)
+	d.Set("threshold", alert.Threshold)
+	d.Set("window", alert.Window)
+	forDuration := alert.For
+	if forDuration == "" {
+		forDuration = "0s"
+	}
+	d.Set("for", forDuration)
+	d.Set("labels", alert.Labels)
+	d.Set("annotations", alert.Annotations)
+	d.Set("no_data_state", alert.NoDataState)
+
+	return nil
+}
+
+func ResourceAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kind, parentID, alertID, err := splitAlertID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alert := makeMLAlert(d)
+	alert.ID = alertID
+	if err := mlAlertRequest(ctx, meta, http.MethodPut, alertsPath(kind, parentID)+"/"+alertID, alert, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	return ResourceAlertRead(ctx, d, meta)
+}
+
+func ResourceAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kind, parentID, alertID, err := splitAlertID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = mlAlertRequest(ctx, meta, http.MethodDelete, alertsPath(kind, parentID)+"/"+alertID, nil, nil)
+	diag, _ := common.CheckReadError("alert", d, err)
+	return diag
+}
+
+func makeMLAlert(d *schema.ResourceData) mlAlert {
+	return mlAlert{
+		Title:            d.Get("title").(string),
+		AnomalyCondition: d.Get("anomaly_condition").(string),
+		For:              d.Get("for").(string),
+		Threshold:        d.Get("threshold").(string),
+		Window:           d.Get("window").(string),
+		Labels:           common.InterfaceMapToStringMap(d.Get("labels").(map[string]interface{})),
+		Annotations:      common.InterfaceMapToStringMap(d.Get("annotations").(map[string]interface{})),
+		NoDataState:      d.Get("no_data_state").(string),
+	}
+}
+
+func alertsPath(kind, parentID string) string {
+	if kind == "outlier" {
+		return "/manage/api/v1/outliers/" + parentID + "/alerts"
+	}
+	return "/manage/api/v1/jobs/" + parentID + "/alerts"
+}
+
+func splitAlertID(id string) (kind, parentID, alertID string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || (parts[0] != "job" && parts[0] != "outlier") {
+		return "", "", "", fmt.Errorf("invalid alert ID %q, expected `job:{{job_id}}:{{alert_id}}` or `outlier:{{outlier_id}}:{{alert_id}}`", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// suppressEquivalentDurations suppresses the diff of durations written differently, e.g. `1h` and `60m0s`.
+func suppressEquivalentDurations(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldDuration, oldErr := time.ParseDuration(oldValue)
+	newDuration, newErr := time.ParseDuration(newValue)
+	return oldErr == nil && newErr == nil && oldDuration == newDuration
+}
+
+// mlAlertRequest calls the alerts API of the Machine Learning plugin, which isn't supported by the ML client.
+// Errors are formatted like those of the ML client, so that `common.CheckReadError` handles them the same way.
+func mlAlertRequest(ctx context.Context, meta interface{}, method, path string, body interface{}, result interface{}) error {
+	client := meta.(*common.Client)
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := client.NewGrafanaRequest(ctx, method, "api/plugins/grafana-ml-app/resources"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := &http.Client{
+		Transport: client.GrafanaTransport(),
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("status: %d, body: %s", resp.StatusCode, respBody)
+	}
+	if result == nil || len(respBody) == 0 {
+		return nil
+	}
+	// The API wraps the alerts in a `data` field
+	var wrapped struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &wrapped); err == nil && len(wrapped.Data) > 0 {
+		respBody = wrapped.Data
+	}
+	return json.Unmarshal(respBody, result)
+}
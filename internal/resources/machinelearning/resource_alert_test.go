@@ -0,0 +1,76 @@
+package machinelearning_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/machine-learning-go-client/mlapi"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceJobAlert(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	randomName := acctest.RandomWithPrefix("Test Job")
+
+	var job mlapi.Job
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccMLJobCheckDestroy(&job),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_machine_learning_alert/job_alert.tf", map[string]string{
+					"Test Job": randomName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMLJobCheckExists("grafana_machine_learning_job.test_alert_job", &job),
+					resource.TestMatchResourceAttr("grafana_machine_learning_alert.test_job_alert", "id", regexp.MustCompile(`^job:.+:.+$`)),
+					resource.TestCheckResourceAttrPair("grafana_machine_learning_alert.test_job_alert", "job_id", "grafana_machine_learning_job.test_alert_job", "id"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_alert.test_job_alert", "title", "Test Alert"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_alert.test_job_alert", "anomaly_condition", "any"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_alert.test_job_alert", "threshold", ">0.8"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_alert.test_job_alert", "window", "15m"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_alert.test_job_alert", "labels.team", "platform"),
+				),
+			},
+			{
+				ResourceName:      "grafana_machine_learning_alert.test_job_alert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceOutlierAlert(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	randomName := acctest.RandomWithPrefix("Test Outlier")
+
+	var outlier mlapi.OutlierDetector
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccMLOutlierCheckDestroy(&outlier),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_machine_learning_alert/outlier_alert.tf", map[string]string{
+					"Test Outlier": randomName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMLOutlierCheckExists("grafana_machine_learning_outlier_detector.test_alert_outlier_detector", &outlier),
+					resource.TestMatchResourceAttr("grafana_machine_learning_alert.test_outlier_alert", "id", regexp.MustCompile(`^outlier:.+:.+$`)),
+					resource.TestCheckResourceAttrPair("grafana_machine_learning_alert.test_outlier_alert", "outlier_id", "grafana_machine_learning_outlier_detector.test_alert_outlier_detector", "id"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_alert.test_outlier_alert", "title", "Test Alert"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_alert.test_outlier_alert", "window", "1h"),
+				),
+			},
+			{
+				ResourceName:      "grafana_machine_learning_alert.test_outlier_alert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
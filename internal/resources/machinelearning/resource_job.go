@@ -3,6 +3,7 @@ package machinelearning
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +27,7 @@ A job defines the queries and model parameters for a machine learning task.
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: common.CustomizeDiffDefaultLabels,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -49,14 +51,16 @@ A job defines the queries and model parameters for a machine learning task.
 				Optional:    true,
 			},
 			"datasource_id": {
-				Description: "The id of the datasource to query.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+				Description:   "The id of the datasource to query.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"query"},
 			},
 			"datasource_uid": {
-				Description: "The uid of the datasource to query.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:   "The uid of the datasource to query.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"query"},
 			},
 			"datasource_type": {
 				Description: "The type of datasource being queried. Currently allowed values are prometheus, graphite, loki, postgres, and datadog.",
@@ -64,9 +68,41 @@ A job defines the queries and model parameters for a machine learning task.
 				Required:    true,
 			},
 			"query_params": {
-				Description: "An object representing the query params to query Grafana with.",
-				Type:        schema.TypeMap,
-				Required:    true,
+				Description:  "An object representing the query params to query Grafana with. Use the `query` block instead for simple queries.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ExactlyOneOf: []string{"query_params", "query"},
+			},
+			"query": {
+				Description: "The query to train the model on. This is a structured alternative to `datasource_uid` and `query_params`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"datasource_uid": {
+							Description: "The uid of the datasource to query. For example, `grafana_data_source.prometheus.uid`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"expr": {
+							Description: "The query expression.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"interval": {
+							Description: "The query interval (step), for example `1m`. Defaults to the interval chosen by the datasource.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"ref_id": {
+							Description: "The reference ID of the query.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "A",
+						},
+					},
+				},
 			},
 			"custom_labels": {
 				Description: "An object representing the custom labels added on the forecast.",
@@ -74,6 +110,7 @@ A job defines the queries and model parameters for a machine learning task.
 				Optional:    true,
 				Default:     nil,
 			},
+			common.AppliedDefaultLabelsAttribute: common.AppliedDefaultLabelsSchema(),
 			"interval": {
 				Description: "The data interval in seconds to train the data on.",
 				Type:        schema.TypeInt,
@@ -93,8 +130,10 @@ A job defines the queries and model parameters for a machine learning task.
 				Default:     int(90 * 24 * time.Hour / time.Second),
 			},
 			"holidays": {
-				Description: "A list of holiday IDs or names to take into account when training the model.",
-				Type:        schema.TypeList,
+				Description: "A list of holiday IDs or names to take into account when training the model. " +
+					"Holidays can be referenced directly, for example with `grafana_machine_learning_holiday.example.id`. " +
+					"Referenced holiday IDs must exist.",
+				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -110,11 +149,15 @@ func ResourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := validateMLJobHolidays(ctx, c, job.Holidays); err != nil {
+		return diag.FromErr(err)
+	}
 	job, err = c.NewJob(ctx, job)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(job.ID)
+	meta.(*common.Client).SetAppliedDefaultLabels(d)
 	return ResourceJobRead(ctx, d, meta)
 }
 
@@ -133,16 +176,22 @@ func ResourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 	} else {
 		d.Set("datasource_id", nil)
 	}
-	if job.DatasourceUID != "" {
-		d.Set("datasource_uid", job.DatasourceUID)
+	d.Set("datasource_type", job.DatasourceType)
+	if _, ok := d.GetOk("query"); ok {
+		d.Set("query", flattenMLJobQuery(job))
 	} else {
-		d.Set("datasource_uid", nil)
+		if job.DatasourceUID != "" {
+			d.Set("datasource_uid", job.DatasourceUID)
+		} else {
+			d.Set("datasource_uid", nil)
+		}
+		d.Set("query_params", job.QueryParams)
 	}
-	d.Set("datasource_type", job.DatasourceType)
-	d.Set("query_params", job.QueryParams)
 	d.Set("interval", job.Interval)
 	d.Set("hyper_params", job.HyperParams)
-	d.Set("custom_labels", job.CustomLabels)
+	customLabels := common.InterfaceMapToStringMap(job.CustomLabels)
+	priorCustomLabels := common.InterfaceMapToStringMap(d.Get("custom_labels").(map[string]interface{}))
+	d.Set("custom_labels", meta.(*common.Client).WithoutDefaultLabels(customLabels, priorCustomLabels))
 	d.Set("training_window", job.TrainingWindow)
 	d.Set("holidays", job.Holidays)
 
@@ -155,10 +204,14 @@ func ResourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := validateMLJobHolidays(ctx, c, job.Holidays); err != nil {
+		return diag.FromErr(err)
+	}
 	_, err = c.UpdateJob(ctx, job)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*common.Client).SetAppliedDefaultLabels(d)
 	return ResourceJobRead(ctx, d, meta)
 }
 
@@ -175,9 +228,17 @@ func ResourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 func makeMLJob(d *schema.ResourceData, meta interface{}) (mlapi.Job, error) {
 	datasourceID := uint(d.Get("datasource_id").(int))
 	datasourceUID := d.Get("datasource_uid").(string)
+	queryParams := d.Get("query_params").(map[string]interface{})
+	if query, ok := d.GetOk("query"); ok {
+		datasourceUID, queryParams = expandMLJobQuery(query.([]interface{})[0].(map[string]interface{}))
+	}
 	if datasourceID == 0 && datasourceUID == "" {
 		return mlapi.Job{}, fmt.Errorf("either datasource_id or datasource_uid must be set")
 	}
+	customLabels := map[string]interface{}{}
+	for k, v := range meta.(*common.Client).WithDefaultLabels(common.InterfaceMapToStringMap(d.Get("custom_labels").(map[string]interface{}))) {
+		customLabels[k] = v
+	}
 	return mlapi.Job{
 		ID:                d.Id(),
 		Name:              d.Get("name").(string),
@@ -187,13 +248,55 @@ func makeMLJob(d *schema.ResourceData, meta interface{}) (mlapi.Job, error) {
 		DatasourceID:      datasourceID,
 		DatasourceUID:     datasourceUID,
 		DatasourceType:    d.Get("datasource_type").(string),
-		QueryParams:       d.Get("query_params").(map[string]interface{}),
+		QueryParams:       queryParams,
 		Interval:          uint(d.Get("interval").(int)),
 		Algorithm:         "grafana_prophet_1_0_1",
 		HyperParams:       d.Get("hyper_params").(map[string]interface{}),
-		CustomLabels:      d.Get("custom_labels").(map[string]interface{}),
+		CustomLabels:      customLabels,
 		TrainingWindow:    uint(d.Get("training_window").(int)),
 		TrainingFrequency: uint(24 * time.Hour / time.Second),
 		Holidays:          common.ListToStringSlice(d.Get("holidays").([]interface{})),
 	}, nil
 }
+
+func expandMLJobQuery(query map[string]interface{}) (string, map[string]interface{}) {
+	params := map[string]interface{}{
+		"expr":  query["expr"].(string),
+		"refId": query["ref_id"].(string),
+	}
+	if interval := query["interval"].(string); interval != "" {
+		params["interval"] = interval
+	}
+	return query["datasource_uid"].(string), params
+}
+
+func flattenMLJobQuery(job mlapi.Job) []interface{} {
+	query := map[string]interface{}{
+		"datasource_uid": job.DatasourceUID,
+	}
+	for tfKey, apiKey := range map[string]string{"expr": "expr", "interval": "interval", "ref_id": "refId"} {
+		if v, ok := job.QueryParams[apiKey].(string); ok {
+			query[tfKey] = v
+		}
+	}
+	return []interface{}{query}
+}
+
+var mlHolidayIDRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// validateMLJobHolidays checks that the holidays referenced by ID exist. Holidays referenced by name are not checked.
+// Errors other than a missing holiday, e.g. a lack of permissions, are returned as is.
+func validateMLJobHolidays(ctx context.Context, c *mlapi.Client, holidays []string) error {
+	for _, holiday := range holidays {
+		if !mlHolidayIDRegexp.MatchString(holiday) {
+			continue
+		}
+		if _, err := c.Holiday(ctx, holiday); err != nil {
+			if common.IsNotFoundError(err) {
+				return fmt.Errorf("holiday %s could not be found: %w", holiday, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
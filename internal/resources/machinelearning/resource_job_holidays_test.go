@@ -0,0 +1,64 @@
+package machinelearning
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grafana/machine-learning-go-client/mlapi"
+)
+
+func TestValidateMLJobHolidays(t *testing.T) {
+	const holidayID = "0b0a0c3e-3b3a-4f8e-9f7e-6d8c1b2a3c4d"
+
+	for _, tc := range []struct {
+		name          string
+		status        int
+		expectedError string
+	}{
+		{
+			name:   "existing holiday",
+			status: http.StatusOK,
+		},
+		{
+			name:          "missing holiday",
+			status:        http.StatusNotFound,
+			expectedError: "holiday " + holidayID + " could not be found: status: 404",
+		},
+		{
+			name:          "other error",
+			status:        http.StatusForbidden,
+			expectedError: "status: 403",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/manage/api/v1/holidays/"+holidayID {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.WriteHeader(tc.status)
+				w.Write([]byte(`{"data":{}}`))
+			}))
+			defer server.Close()
+
+			client, err := mlapi.New(server.URL, mlapi.Config{Client: server.Client()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Holidays referenced by name are not checked
+			err = validateMLJobHolidays(context.Background(), client, []string{"My Holiday", holidayID})
+
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tc.expectedError) {
+				t.Fatalf("expected an error starting with %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
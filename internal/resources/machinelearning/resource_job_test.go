@@ -89,6 +89,27 @@ func TestAccResourceJob(t *testing.T) {
 					resource.TestCheckResourceAttrSet("grafana_machine_learning_job.test_job", "holidays.0"),
 				),
 			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_machine_learning_job/query_job.tf", map[string]string{
+					"Test Job": randomName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMLJobCheckExists("grafana_machine_learning_job.test_job", &job),
+					resource.TestCheckResourceAttr("grafana_machine_learning_job.test_job", "name", randomName),
+					resource.TestCheckResourceAttr("grafana_machine_learning_job.test_job", "query.0.datasource_uid", "grafanacloud-usage"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_job.test_job", "query.0.expr", "grafanacloud_grafana_instance_active_user_count"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_job.test_job", "query.0.interval", "5m"),
+					resource.TestCheckResourceAttr("grafana_machine_learning_job.test_job", "query.0.ref_id", "A"),
+					resource.TestCheckNoResourceAttr("grafana_machine_learning_job.test_job", "datasource_uid"),
+					resource.TestCheckResourceAttrSet("grafana_machine_learning_job.test_job", "holidays.0"),
+					func(s *terraform.State) error {
+						if job.DatasourceUID != "grafanacloud-usage" || job.QueryParams["expr"] != "grafanacloud_grafana_instance_active_user_count" {
+							return fmt.Errorf("unexpected job query: %s %v", job.DatasourceUID, job.QueryParams)
+						}
+						return nil
+					},
+				),
+			},
 		},
 	})
 }
@@ -155,6 +176,19 @@ resource "grafana_machine_learning_job" "invalid" {
 }
 `
 
+const machineLearningJobMissingHoliday = `
+resource "grafana_machine_learning_job" "invalid" {
+  name            = "Test Job"
+  metric          = "tf_test_job"
+  datasource_type = "prometheus"
+  query {
+    datasource_uid = "grafanacloud-usage"
+    expr           = "grafanacloud_grafana_instance_active_user_count"
+  }
+  holidays = ["00000000-0000-0000-0000-000000000000"]
+}
+`
+
 func TestAccResourceInvalidMachineLearningJob(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t)
 
@@ -169,6 +203,10 @@ func TestAccResourceInvalidMachineLearningJob(t *testing.T) {
 				Config:      machineLearningJobMissingDatasourceIDOrUID,
 				ExpectError: regexp.MustCompile(".*datasource_id or datasource_uid.*"),
 			},
+			{
+				Config:      machineLearningJobMissingHoliday,
+				ExpectError: regexp.MustCompile("holiday 00000000-0000-0000-0000-000000000000 could not be found"),
+			},
 		},
 	})
 }
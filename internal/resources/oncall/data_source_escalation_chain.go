@@ -21,6 +21,12 @@ func DataSourceEscalationChain() *schema.Resource {
 				Required:    true,
 				Description: "The escalation chain name.",
 			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team of the escalation chain, to find it when escalation chains of several teams have the same name. Set to the team of the escalation chain found when not set.",
+			},
 		},
 	}
 }
@@ -29,6 +35,7 @@ func dataSourceEscalationChainRead(ctx context.Context, d *schema.ResourceData,
 	client := m.(*common.Client).OnCallClient
 	options := &onCallAPI.ListEscalationChainOptions{}
 	nameData := d.Get("name").(string)
+	teamID := d.Get("team_id").(string)
 
 	options.Name = nameData
 
@@ -37,15 +44,23 @@ func dataSourceEscalationChainRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	if len(escalationChainsResponse.EscalationChains) == 0 {
+	var escalationChains []*onCallAPI.EscalationChain
+	for _, escalationChain := range escalationChainsResponse.EscalationChains {
+		if teamID == "" || escalationChain.TeamId == teamID {
+			escalationChains = append(escalationChains, escalationChain)
+		}
+	}
+
+	if len(escalationChains) == 0 {
 		return diag.Errorf("couldn't find an escalation chain matching: %s", options.Name)
-	} else if len(escalationChainsResponse.EscalationChains) != 1 {
-		return diag.Errorf("more than one escalation chain found matching: %s", options.Name)
+	} else if len(escalationChains) != 1 {
+		return diag.Errorf("more than one escalation chain found matching: %s, set team_id to select one", options.Name)
 	}
 
-	escalationChain := escalationChainsResponse.EscalationChains[0]
+	escalationChain := escalationChains[0]
 
 	d.Set("name", escalationChain.Name)
+	d.Set("team_id", escalationChain.TeamId)
 
 	d.SetId(escalationChain.ID)
 
@@ -0,0 +1,95 @@
+package oncall
+
+import (
+	"context"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Finds an integration by name, e.g. to add routes to an integration managed in another Terraform configuration.
+
+* [Official documentation](https://grafana.com/docs/oncall/latest/integrations/)
+* [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/)
+`,
+		ReadContext: dataSourceIntegrationRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The integration name.",
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team of the integration, to find it when integrations of several teams have the same name. Set to the team of the integration found when not set.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the integration.",
+			},
+			"link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link for using in an integrated tool.",
+			},
+			"default_route_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the default route of the integration.",
+			},
+		},
+	}
+}
+
+func dataSourceIntegrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.Client).OnCallClient
+	name := d.Get("name").(string)
+	teamID := d.Get("team_id").(string)
+
+	// The integrations can't be listed by name
+	var integrations []*onCallAPI.Integration
+	options := &onCallAPI.ListIntegrationOptions{ListOptions: onCallAPI.ListOptions{Page: 1}}
+	for {
+		resp, _, err := client.Integrations.ListIntegrations(options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, i := range resp.Integrations {
+			if i.Name == name && (teamID == "" || i.TeamId == teamID) {
+				integrations = append(integrations, i)
+			}
+		}
+		if resp.Next == nil {
+			break
+		}
+		options.Page++
+	}
+
+	if len(integrations) == 0 {
+		return diag.Errorf("couldn't find an integration matching: %s", name)
+	} else if len(integrations) != 1 {
+		return diag.Errorf("more than one integration found matching: %s, set team_id to select one", name)
+	}
+
+	integration := integrations[0]
+
+	d.SetId(integration.ID)
+	d.Set("team_id", integration.TeamId)
+	d.Set("type", integration.Type)
+	d.Set("link", integration.Link)
+	defaultRouteID := ""
+	if integration.DefaultRoute != nil {
+		defaultRouteID = integration.DefaultRoute.ID
+	}
+	d.Set("default_route_id", defaultRouteID)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package oncall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIntegration_Basic(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceIntegrationConfig(name, ""),
+				ExpectError: regexp.MustCompile(`couldn't find an integration`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_oncall_integration" "test" {
+	name = "%[1]s"
+	type = "grafana"
+}
+
+resource "grafana_oncall_escalation_chain" "test" {
+	name = "%[1]s"
+}
+`, name) + testAccDataSourceIntegrationConfig(name, "depends_on = [grafana_oncall_integration.test, grafana_oncall_escalation_chain.test]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.grafana_oncall_integration.test", "id", "grafana_oncall_integration.test", "id"),
+					resource.TestCheckResourceAttr("data.grafana_oncall_integration.test", "type", "grafana"),
+					resource.TestCheckResourceAttrPair("data.grafana_oncall_integration.test", "link", "grafana_oncall_integration.test", "link"),
+					resource.TestCheckResourceAttrSet("data.grafana_oncall_integration.test", "default_route_id"),
+					resource.TestCheckResourceAttrPair("data.grafana_oncall_escalation_chain.test", "id", "grafana_oncall_escalation_chain.test", "id"),
+					resource.TestCheckResourceAttr("data.grafana_oncall_escalation_chain.test", "team_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIntegrationConfig(name, dependsOn string) string {
+	return fmt.Sprintf(`
+data "grafana_oncall_integration" "test" {
+	name = "%[1]s"
+	%[2]s
+}
+
+data "grafana_oncall_escalation_chain" "test" {
+	name = "%[1]s"
+	%[2]s
+}
+`, name, dependsOn)
+}
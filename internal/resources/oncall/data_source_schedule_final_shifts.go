@@ -0,0 +1,223 @@
+package oncall
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const finalShiftsDateFormat = "2006-01-02"
+
+var finalShiftsDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func DataSourceScheduleFinalShifts() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Exports the final shifts of an OnCall schedule for a time window, once its rotations and overrides are resolved.
+The periods of the window that aren't covered by any shift are listed in ` + "`gaps`" + `.
+
+* [Official documentation](https://grafana.com/docs/oncall/latest/on-call-schedules/)
+* [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/schedules/)
+`,
+		ReadContext: DataSourceScheduleFinalShiftsRead,
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the schedule.",
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(finalShiftsDateRegexp, "must be in the YYYY-MM-DD format"),
+				Description:  "The first day of the window, in the `YYYY-MM-DD` format. The window starts at midnight UTC.",
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(finalShiftsDateRegexp, "must be in the YYYY-MM-DD format"),
+				Description:  "The last day of the window (inclusive), in the `YYYY-MM-DD` format.",
+			},
+			"shifts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The final shifts of the schedule in the window, sorted by start.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the user on call.",
+						},
+						"user_email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email of the user on call.",
+						},
+						"user_username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The username of the user on call.",
+						},
+						"shift_start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start of the shift, in RFC3339 format.",
+						},
+						"shift_end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end of the shift, in RFC3339 format.",
+						},
+					},
+				},
+			},
+			"gaps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The periods of the window during which nobody is on call.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start of the gap, in RFC3339 format.",
+						},
+						"end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end of the gap, in RFC3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// finalShift is a shift of the final schedule. The final shifts aren't supported by the OnCall API client.
+type finalShift struct {
+	UserID       string    `json:"user_pk"`
+	UserEmail    string    `json:"user_email"`
+	UserUsername string    `json:"user_username"`
+	ShiftStart   time.Time `json:"shift_start"`
+	ShiftEnd     time.Time `json:"shift_end"`
+}
+
+type listFinalShiftsOptions struct {
+	onCallAPI.ListOptions
+	StartDate string `url:"start_date"`
+	EndDate   string `url:"end_date"`
+}
+
+func DataSourceScheduleFinalShiftsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.Client).OnCallClient
+	scheduleID := d.Get("schedule_id").(string)
+
+	start, err := time.Parse(finalShiftsDateFormat, d.Get("start_date").(string))
+	if err != nil {
+		return diag.Errorf("invalid start_date: %v", err)
+	}
+	end, err := time.Parse(finalShiftsDateFormat, d.Get("end_date").(string))
+	if err != nil {
+		return diag.Errorf("invalid end_date: %v", err)
+	}
+	if end.Before(start) {
+		return diag.Errorf("end_date must not be before start_date")
+	}
+
+	shifts, err := listFinalShifts(client, scheduleID, start, end)
+	if err != nil {
+		return diag.Errorf("failed to list the final shifts of schedule %s: %v", scheduleID, err)
+	}
+
+	packedShifts := make([]interface{}, 0, len(shifts))
+	periods := make([][2]time.Time, 0, len(shifts))
+	for _, s := range shifts {
+		periods = append(periods, [2]time.Time{s.ShiftStart, s.ShiftEnd})
+		packedShifts = append(packedShifts, map[string]interface{}{
+			"user_id":       s.UserID,
+			"user_email":    s.UserEmail,
+			"user_username": s.UserUsername,
+			"shift_start":   s.ShiftStart.UTC().Format(time.RFC3339),
+			"shift_end":     s.ShiftEnd.UTC().Format(time.RFC3339),
+		})
+	}
+	var packedGaps []interface{}
+	for _, gap := range CoverageGaps(periods, start, end.AddDate(0, 0, 1)) {
+		packedGaps = append(packedGaps, map[string]interface{}{
+			"start": gap[0].UTC().Format(time.RFC3339),
+			"end":   gap[1].UTC().Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", scheduleID, start.Format(finalShiftsDateFormat), end.Format(finalShiftsDateFormat)))
+	d.Set("shifts", packedShifts)
+	d.Set("gaps", packedGaps)
+
+	return nil
+}
+
+// listFinalShifts lists the final shifts of a schedule between two days (inclusive), sorted by start.
+func listFinalShifts(client *onCallAPI.Client, scheduleID string, start, end time.Time) ([]finalShift, error) {
+	var shifts []finalShift
+	options := &listFinalShiftsOptions{
+		ListOptions: onCallAPI.ListOptions{Page: 1},
+		StartDate:   start.Format(finalShiftsDateFormat),
+		EndDate:     end.Format(finalShiftsDateFormat),
+	}
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("schedules/%s/final_shifts/", scheduleID), options)
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			onCallAPI.PaginatedResponse
+			Results []finalShift `json:"results"`
+		}
+		if _, err := client.Do(req, &resp); err != nil {
+			return nil, err
+		}
+		shifts = append(shifts, resp.Results...)
+		if resp.Next == nil {
+			break
+		}
+		options.Page++
+	}
+
+	sort.SliceStable(shifts, func(i, j int) bool { return shifts[i].ShiftStart.Before(shifts[j].ShiftStart) })
+	return shifts, nil
+}
+
+// CoverageGaps returns the periods between `from` and `to` that aren't covered by any of the given periods, sorted by start.
+// The periods must be sorted by start.
+func CoverageGaps(periods [][2]time.Time, from, to time.Time) [][2]time.Time {
+	var gaps [][2]time.Time
+	covered := from
+	for _, p := range periods {
+		if !covered.Before(to) {
+			return gaps
+		}
+		if gapEnd := p[0]; gapEnd.After(covered) {
+			if gapEnd.After(to) {
+				gapEnd = to
+			}
+			gaps = append(gaps, [2]time.Time{covered, gapEnd})
+		}
+		if p[1].After(covered) {
+			covered = p[1]
+		}
+	}
+	if covered.Before(to) {
+		gaps = append(gaps, [2]time.Time{covered, to})
+	}
+	return gaps
+}
@@ -0,0 +1,50 @@
+package oncall_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/internal/resources/oncall"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestCoverageGaps(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	at := func(hour int) time.Time {
+		return time.Date(2024, 1, 1, hour, 0, 0, 0, time.UTC)
+	}
+	from, to := at(0), at(24)
+
+	for _, tc := range []struct {
+		name    string
+		periods [][2]time.Time
+		want    [][2]time.Time
+	}{
+		{
+			name: "no shifts",
+			want: [][2]time.Time{{from, to}},
+		},
+		{
+			name:    "fully covered",
+			periods: [][2]time.Time{{at(-2), at(12)}, {at(12), at(26)}},
+		},
+		{
+			name:    "gaps between, before and after shifts",
+			periods: [][2]time.Time{{at(2), at(8)}, {at(6), at(10)}, {at(12), at(20)}},
+			want:    [][2]time.Time{{from, at(2)}, {at(10), at(12)}, {at(20), to}},
+		},
+		{
+			name:    "shift after the window",
+			periods: [][2]time.Time{{at(0), at(20)}, {at(30), at(40)}},
+			want:    [][2]time.Time{{at(20), to}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := oncall.CoverageGaps(tc.periods, from, to); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected gaps %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
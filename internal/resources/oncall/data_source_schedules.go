@@ -0,0 +1,111 @@
+package oncall
+
+import (
+	"context"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Lists the OnCall schedules, optionally filtered by team.
+
+* [Official documentation](https://grafana.com/docs/oncall/latest/on-call-schedules/)
+* [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/schedules/)
+`,
+		ReadContext: DataSourceSchedulesRead,
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the schedules of this team. The ID can be found with the `grafana_oncall_team` data source.",
+			},
+			"schedules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The schedules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the schedule.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the schedule.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the schedule.",
+						},
+						"team_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the team of the schedule.",
+						},
+						"time_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time zone of the schedule.",
+						},
+						"on_call_now": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The IDs of the users on call when the data source is read.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.Client).OnCallClient
+	teamID := d.Get("team_id").(string)
+
+	var schedules []interface{}
+	options := &onCallAPI.ListScheduleOptions{ListOptions: onCallAPI.ListOptions{Page: 1}}
+	for {
+		resp, _, err := client.Schedules.ListSchedules(options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, s := range resp.Schedules {
+			if teamID != "" && s.TeamId != teamID {
+				continue
+			}
+			schedules = append(schedules, map[string]interface{}{
+				"id":          s.ID,
+				"name":        s.Name,
+				"type":        s.Type,
+				"team_id":     s.TeamId,
+				"time_zone":   s.TimeZone,
+				"on_call_now": s.OnCallNow,
+			})
+		}
+		if resp.Next == nil {
+			break
+		}
+		options.Page++
+	}
+
+	id := "schedules"
+	if teamID != "" {
+		id += ":" + teamID
+	}
+	d.SetId(id)
+	d.Set("schedules", schedules)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package oncall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSchedules_Basic(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	scheduleName := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSchedulesConfig(scheduleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_oncall_schedules.all", "schedules.*", map[string]string{
+						"name":      scheduleName,
+						"type":      "calendar",
+						"time_zone": "America/New_York",
+					}),
+					resource.TestCheckResourceAttr("data.grafana_oncall_schedule_final_shifts.test", "shifts.#", "0"),
+					resource.TestCheckResourceAttr("data.grafana_oncall_schedule_final_shifts.test", "gaps.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_oncall_schedule_final_shifts.test", "gaps.0.start", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.grafana_oncall_schedule_final_shifts.test", "gaps.0.end", "2024-01-08T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSchedulesConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+resource "grafana_oncall_schedule" "test" {
+	name = "%s"
+	type = "calendar"
+	time_zone = "America/New_York"
+}
+
+data "grafana_oncall_schedules" "all" {
+	depends_on = [grafana_oncall_schedule.test]
+}
+
+data "grafana_oncall_schedule_final_shifts" "test" {
+	schedule_id = grafana_oncall_schedule.test.id
+	start_date  = "2024-01-01"
+	end_date    = "2024-01-07"
+}
+`, scheduleName)
+}
@@ -0,0 +1,136 @@
+package oncall
+
+import (
+	"context"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// onCallUser is a user of the OnCall API, with its teams and the status of its notification methods, which aren't exposed by the OnCall client.
+type onCallUser struct {
+	ID                    string   `json:"id"`
+	Username              string   `json:"username"`
+	Email                 string   `json:"email"`
+	Role                  string   `json:"role"`
+	Teams                 []string `json:"teams"`
+	IsPhoneNumberVerified bool     `json:"is_phone_number_verified"`
+	Slack                 *struct {
+		UserID string `json:"user_id"`
+	} `json:"slack"`
+}
+
+func DataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Lists the OnCall users, with the status of their notification methods, e.g. to check that the users of a schedule can be paged.
+
+* [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/users/)
+`,
+		ReadContext: DataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only list the users with these IDs, e.g. the users on call in the `grafana_oncall_schedules` data source. If unset, all the users are listed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the user.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The username of the user.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email of the user.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the user.",
+						},
+						"phone_number_verified": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the user has verified their phone number, to be notified by SMS and phone calls.",
+						},
+						"slack_user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the Slack user linked to the user, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.Client).OnCallClient
+
+	// An empty set of IDs lists no users, e.g. when nobody is on call
+	filterIDs := !d.GetRawConfig().GetAttr("ids").IsNull()
+	ids := map[string]bool{}
+	for _, id := range d.Get("ids").(*schema.Set).List() {
+		ids[id.(string)] = true
+	}
+
+	var users []interface{}
+	options := &onCallAPI.ListUserOptions{ListOptions: onCallAPI.ListOptions{Page: 1}}
+	for {
+		req, err := client.NewRequest("GET", "users/", options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		var resp struct {
+			onCallAPI.PaginatedResponse
+			Results []onCallUser `json:"results"`
+		}
+		if _, err := client.Do(req, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+		for _, u := range resp.Results {
+			if filterIDs && !ids[u.ID] {
+				continue
+			}
+			slackUserID := ""
+			if u.Slack != nil {
+				slackUserID = u.Slack.UserID
+			}
+			users = append(users, map[string]interface{}{
+				"id":                    u.ID,
+				"username":              u.Username,
+				"email":                 u.Email,
+				"role":                  u.Role,
+				"phone_number_verified": u.IsPhoneNumberVerified,
+				"slack_user_id":         slackUserID,
+			})
+		}
+		if resp.Next == nil {
+			break
+		}
+		options.Page++
+	}
+
+	d.SetId("users")
+	d.Set("users", users)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package oncall_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUsers_Basic(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "grafana_oncall_users" "all" {}
+
+data "grafana_oncall_users" "none" {
+	ids = []
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafana_oncall_users.all", "users.0.id"),
+					resource.TestCheckResourceAttrSet("data.grafana_oncall_users.all", "users.0.phone_number_verified"),
+					resource.TestCheckResourceAttr("data.grafana_oncall_users.none", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -1,14 +1,21 @@
 package oncall
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -57,6 +64,7 @@ func ResourceIntegration() *schema.Resource {
 		ReadContext:   ResourceIntegrationRead,
 		UpdateContext: ResourceIntegrationUpdate,
 		DeleteContext: ResourceIntegrationDelete,
+		CustomizeDiff: customizeDiffIntegrationMaintenance,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -71,7 +79,7 @@ func ResourceIntegration() *schema.Resource {
 			"team_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource.",
+				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource, or the `oncall_team_id` attribute of a `grafana_oncall_team_link` resource, which waits for the sync.",
 			},
 			"type": {
 				Type:         schema.TypeString,
@@ -232,6 +240,72 @@ func ResourceIntegration() *schema.Resource {
 					return true
 				},
 			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The labels of the integration. They are added to the alert groups of the integration, and can be used to route alerts (see `routing_labels` of `grafana_oncall_route`). The keys and values missing from the Grafana labels repository are created, which requires the provider's `url` and `auth` to be set. Requires a version of OnCall supporting labels.",
+				Elem:        integrationLabelSchema("The value of the label."),
+			},
+			"alert_group_labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The labels computed for each alert group of the integration, in addition to the labels of the integration. Requires a version of OnCall supporting labels.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Labels with a static value, or with a value computed from the first alert of the group.",
+							Elem:        integrationLabelSchema("The value of the label. Values containing a Jinja2 expression (`{{ ... }}`) are templates computed from the alert payload."),
+						},
+						"template": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Jinja2 template rendering a JSON object of labels (e.g. `{{ payload.labels | tojson }}`), to extract multiple labels from the alert payload.",
+						},
+					},
+				},
+			},
+			"maintenance": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Puts the integration in maintenance or debug mode during a window, for example to suppress alerts during a planned release. The mode is started when the resource is applied within the window, and stopped when this block is removed, or replaced by a window which hasn't started yet.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"maintenance", "debug"}, false),
+							Description:  "The mode of the integration during the window. In `maintenance` mode, alerts are collected in a single alert group and no one is notified. In `debug` mode, alert groups are created but no one is notified. Can be `maintenance` or `debug`.",
+						},
+						"start": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "The start of the window, in RFC3339 format. Defaults to the time the block is applied. If the window hasn't started yet when the block is applied, the mode is started by the first apply after the start of the window.",
+						},
+						"duration": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateIntegrationMaintenanceDuration,
+							Description:      "The duration of the window, for example `1h` or `30m`. The maximum is `24h`.",
+						},
+					},
+				},
+			},
+			"maintenance_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mode the integration is currently in. Empty if the integration isn't in maintenance or debug mode.",
+			},
+			"maintenance_end_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The end of the current maintenance or debug mode. Empty if the integration isn't in maintenance or debug mode.",
+			},
 		},
 	}
 }
@@ -298,7 +372,18 @@ func ResourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	d.SetId(integration.ID)
 
-	return ResourceIntegrationRead(ctx, d, m)
+	if _, labelsOk := d.GetOk("labels"); labelsOk || len(d.Get("alert_group_labels").([]interface{})) > 0 {
+		if err := updateIntegrationLabels(ctx, m.(*common.Client), d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	diags := applyIntegrationMaintenance(client, d, time.Now())
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, ResourceIntegrationRead(ctx, d, m)...)
 }
 
 func ResourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -323,7 +408,20 @@ func ResourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	d.SetId(integration.ID)
 
-	return ResourceIntegrationRead(ctx, d, m)
+	if d.HasChanges("labels", "alert_group_labels") {
+		if err := updateIntegrationLabels(ctx, m.(*common.Client), d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var diags diag.Diagnostics
+	if oldMode, _ := d.GetChange("maintenance_mode"); d.HasChange("maintenance") || oldMode.(string) == "" {
+		if diags = applyIntegrationMaintenance(client, d, time.Now()); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, ResourceIntegrationRead(ctx, d, m)...)
 }
 
 func ResourceIntegrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -346,6 +444,377 @@ func ResourceIntegrationRead(ctx context.Context, d *schema.ResourceData, m inte
 	d.Set("templates", flattenTemplates(integration.Templates))
 	d.Set("link", integration.Link)
 
+	maintenance, err := getIntegrationMaintenance(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("maintenance_mode", maintenance.Mode)
+	d.Set("maintenance_end_at", maintenance.EndAt)
+
+	labels, err := getIntegrationLabels(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("labels", flattenIntegrationLabels(labels.Labels))
+	d.Set("alert_group_labels", flattenIntegrationAlertGroupLabels(labels.AlertGroupLabels))
+
+	return nil
+}
+
+func integrationLabelSchema(valueDescription string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The key of the label.",
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  valueDescription,
+			},
+		},
+	}
+}
+
+// integrationLabels are the labels of an integration. They aren't supported by the OnCall API client.
+type integrationLabels struct {
+	Labels           []integrationLabel           `json:"labels"`
+	AlertGroupLabels *integrationAlertGroupLabels `json:"alert_group_labels,omitempty"`
+}
+
+type integrationLabel struct {
+	Key   integrationLabelPart `json:"key"`
+	Value integrationLabelPart `json:"value"`
+}
+
+// integrationLabelPart is the key or value of a label. The ID of templated values is null.
+type integrationLabelPart struct {
+	ID        *string `json:"id"`
+	Name      string  `json:"name"`
+	Prototype bool    `json:"prototype"`
+}
+
+type integrationAlertGroupLabels struct {
+	Inheritable map[string]bool    `json:"inheritable"`
+	Custom      []integrationLabel `json:"custom"`
+	Template    *string            `json:"template"`
+}
+
+func getIntegrationLabels(client *onCallAPI.Client, id string) (*integrationLabels, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("integrations/%s/", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	labels := &integrationLabels{}
+	if _, err := client.Do(req, labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// updateIntegrationLabels sets the labels of the integration and of its alert groups.
+// The labels of the integration are inherited by its alert groups.
+func updateIntegrationLabels(ctx context.Context, client *common.Client, d *schema.ResourceData) error {
+	labels := &integrationLabels{
+		Labels: expandIntegrationLabels(d.Get("labels").(*schema.Set).List(), false),
+		AlertGroupLabels: &integrationAlertGroupLabels{
+			Inheritable: map[string]bool{},
+			Custom:      []integrationLabel{},
+		},
+	}
+	if list := d.Get("alert_group_labels").([]interface{}); len(list) > 0 && list[0] != nil {
+		alertGroupLabels := list[0].(map[string]interface{})
+		labels.AlertGroupLabels.Custom = expandIntegrationLabels(alertGroupLabels["custom"].([]interface{}), true)
+		if template := alertGroupLabels["template"].(string); template != "" {
+			labels.AlertGroupLabels.Template = &template
+		}
+	}
+	repository := map[string]*grafanaLabel{}
+	if err := resolveIntegrationLabelIDs(ctx, client, repository, labels.Labels); err != nil {
+		return err
+	}
+	if err := resolveIntegrationLabelIDs(ctx, client, repository, labels.AlertGroupLabels.Custom); err != nil {
+		return err
+	}
+	for _, label := range labels.Labels {
+		labels.AlertGroupLabels.Inheritable[*label.Key.ID] = true
+	}
+
+	req, err := client.OnCallClient.NewRequest("PUT", fmt.Sprintf("integrations/%s/", d.Id()), labels)
+	if err != nil {
+		return err
+	}
+	_, err = client.OnCallClient.Do(req, nil)
+	return err
+}
+
+// expandIntegrationLabels converts `key`/`value` blocks to labels. Labels are referenced by name, their IDs are set by resolveIntegrationLabelIDs.
+// Values containing a Jinja2 expression are templates if allowed, which have no ID.
+func expandIntegrationLabels(in []interface{}, allowTemplates bool) []integrationLabel {
+	labels := make([]integrationLabel, 0, len(in))
+	for _, l := range in {
+		l := l.(map[string]interface{})
+		key, value := l["key"].(string), l["value"].(string)
+		label := integrationLabel{
+			Key:   integrationLabelPart{Name: key},
+			Value: integrationLabelPart{ID: &value, Name: value},
+		}
+		if allowTemplates && strings.Contains(value, "{{") {
+			label.Value.ID = nil
+		}
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// grafanaLabel is a key of the Grafana labels repository, with its values.
+type grafanaLabel struct {
+	Key    integrationLabelPart   `json:"key"`
+	Values []integrationLabelPart `json:"values"`
+}
+
+// resolveIntegrationLabelIDs sets the IDs of the keys and values of the labels, which OnCall expects to be those of the Grafana labels repository.
+// The keys and values missing from the repository are created, like the OnCall UI does. The keys already fetched are kept in the given map.
+func resolveIntegrationLabelIDs(ctx context.Context, client *common.Client, repository map[string]*grafanaLabel, labels []integrationLabel) error {
+	for i := range labels {
+		label := &labels[i]
+		key, ok := repository[label.Key.Name]
+		if !ok {
+			var err error
+			if key, err = getOrCreateGrafanaLabel(ctx, client, label.Key.Name, label.Value); err != nil {
+				return err
+			}
+			repository[label.Key.Name] = key
+		}
+		label.Key.ID = key.Key.ID
+		if label.Value.ID == nil {
+			continue
+		}
+
+		label.Value.ID = key.valueID(label.Value.Name)
+		if label.Value.ID == nil {
+			path := fmt.Sprintf("id/%s/values", url.PathEscape(*key.Key.ID))
+			if _, err := grafanaLabelsRequest(ctx, client, http.MethodPost, path, map[string]string{"name": label.Value.Name}, key); err != nil {
+				return fmt.Errorf("failed to create the value %q of label %q: %w", label.Value.Name, label.Key.Name, err)
+			}
+			if label.Value.ID = key.valueID(label.Value.Name); label.Value.ID == nil {
+				return fmt.Errorf("the value %q of label %q wasn't created", label.Value.Name, label.Key.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// valueID returns the ID of a value of the key, or nil if the key doesn't have it.
+func (l *grafanaLabel) valueID(name string) *string {
+	for _, value := range l.Values {
+		if value.Name == name {
+			return value.ID
+		}
+	}
+	return nil
+}
+
+// getOrCreateGrafanaLabel gets a key of the Grafana labels repository by name, or creates it with the given value if it doesn't exist.
+func getOrCreateGrafanaLabel(ctx context.Context, client *common.Client, name string, value integrationLabelPart) (*grafanaLabel, error) {
+	label := &grafanaLabel{}
+	status, err := grafanaLabelsRequest(ctx, client, http.MethodGet, "name/"+url.PathEscape(name), nil, label)
+	if err == nil {
+		return label, nil
+	}
+	if status != http.StatusNotFound {
+		return nil, fmt.Errorf("failed to get label %q: %w", name, err)
+	}
+
+	body := map[string]interface{}{"key": map[string]string{"name": name}, "values": []interface{}{}}
+	if value.ID != nil {
+		body["values"] = []interface{}{map[string]string{"name": value.Name}}
+	}
+	if _, err := grafanaLabelsRequest(ctx, client, http.MethodPost, "", body, label); err != nil {
+		return nil, fmt.Errorf("failed to create label %q: %w", name, err)
+	}
+	return label, nil
+}
+
+// grafanaLabelsRequest calls the API of the Grafana labels repository, which OnCall uses for the labels of integrations.
+// It returns the status code of the response, which is 0 if the request wasn't sent.
+func grafanaLabelsRequest(ctx context.Context, client *common.Client, method, path string, body, result interface{}) (int, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := client.NewGrafanaRequest(ctx, method, "api/plugins/grafana-labels-app/resources/v1/labels/"+path, reqBody)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := &http.Client{
+		Transport: client.GrafanaTransport(),
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode >= 400 {
+		return resp.StatusCode, fmt.Errorf("status: %d, body: %s", resp.StatusCode, respBody)
+	}
+	return resp.StatusCode, json.Unmarshal(respBody, result)
+}
+
+func flattenIntegrationLabels(in []integrationLabel) []interface{} {
+	labels := make([]interface{}, 0, len(in))
+	for _, label := range in {
+		labels = append(labels, map[string]interface{}{
+			"key":   label.Key.Name,
+			"value": label.Value.Name,
+		})
+	}
+	return labels
+}
+
+func flattenIntegrationAlertGroupLabels(in *integrationAlertGroupLabels) []interface{} {
+	if in == nil || (len(in.Custom) == 0 && (in.Template == nil || *in.Template == "")) {
+		return nil
+	}
+	out := map[string]interface{}{
+		"custom":   flattenIntegrationLabels(in.Custom),
+		"template": "",
+	}
+	if in.Template != nil {
+		out["template"] = *in.Template
+	}
+	return []interface{}{out}
+}
+
+// integrationMaintenance is the maintenance state of an integration. It isn't supported by the OnCall API client.
+type integrationMaintenance struct {
+	Mode  string `json:"maintenance_mode"`
+	EndAt string `json:"maintenance_end_at"`
+}
+
+func getIntegrationMaintenance(client *onCallAPI.Client, id string) (*integrationMaintenance, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("integrations/%s/", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	maintenance := &integrationMaintenance{}
+	if _, err := client.Do(req, maintenance); err != nil {
+		return nil, err
+	}
+	return maintenance, nil
+}
+
+// applyIntegrationMaintenance starts or stops the maintenance mode of the integration according to the `maintenance` block.
+// The mode is started with the remaining duration of the window, and restarted if the window changed.
+func applyIntegrationMaintenance(client *onCallAPI.Client, d *schema.ResourceData, now time.Time) diag.Diagnostics {
+	current, err := getIntegrationMaintenance(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stop := func() error {
+		if current.Mode == "" {
+			return nil
+		}
+		req, err := client.NewRequest("POST", fmt.Sprintf("integrations/%s/maintenance_stop/", d.Id()), nil)
+		if err != nil {
+			return err
+		}
+		_, err = client.Do(req, nil)
+		return err
+	}
+
+	maintenance, start, end := integrationMaintenanceWindow(d.Get("maintenance").([]interface{}), now)
+	if maintenance == nil {
+		return diag.FromErr(stop())
+	}
+	if maintenance["start"].(string) == "" {
+		// Anchor the window, so that it doesn't move with later applies
+		maintenance["start"] = start.UTC().Format(time.RFC3339)
+		d.Set("maintenance", []interface{}{maintenance})
+	}
+
+	if now.Before(start) {
+		// The mode of a replaced window which is active is stopped until the new window starts
+		if err := stop(); err != nil {
+			return diag.FromErr(err)
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "The maintenance window hasn't started yet",
+			Detail:   fmt.Sprintf("The %s mode of the integration will be started by the first apply after %s.", maintenance["mode"], start.Format(time.RFC3339)),
+		}}
+	}
+	if !now.Before(end) {
+		return diag.FromErr(stop())
+	}
+
+	if err := stop(); err != nil {
+		return diag.FromErr(err)
+	}
+	req, err := client.NewRequest("POST", fmt.Sprintf("integrations/%s/maintenance_start/", d.Id()), map[string]interface{}{
+		"mode":     maintenance["mode"],
+		"duration": int64(math.Ceil(end.Sub(now).Seconds())),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.Do(req, nil)
+	return diag.FromErr(err)
+}
+
+// integrationMaintenanceWindow returns the `maintenance` block and the bounds of its window. The block is nil if it isn't set.
+func integrationMaintenanceWindow(list []interface{}, now time.Time) (maintenance map[string]interface{}, start, end time.Time) {
+	if len(list) == 0 || list[0] == nil {
+		return nil, start, end
+	}
+	maintenance = list[0].(map[string]interface{})
+
+	start = now
+	if v := maintenance["start"].(string); v != "" {
+		start, _ = time.Parse(time.RFC3339, v)
+	}
+	duration, _ := time.ParseDuration(maintenance["duration"].(string))
+	return maintenance, start, start.Add(duration)
+}
+
+// customizeDiffIntegrationMaintenance plans an update when a maintenance window that was applied before its start has started.
+func customizeDiffIntegrationMaintenance(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || d.HasChange("maintenance") || d.Get("maintenance_mode").(string) != "" {
+		return nil
+	}
+
+	now := time.Now()
+	maintenance, start, end := integrationMaintenanceWindow(d.Get("maintenance").([]interface{}), now)
+	if maintenance != nil && !now.Before(start) && now.Before(end) {
+		return d.SetNewComputed("maintenance_mode")
+	}
+	return nil
+}
+
+func validateIntegrationMaintenanceDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return diag.Errorf("invalid duration %q: %s", v, err)
+	}
+	if duration <= 0 || duration > 24*time.Hour {
+		return diag.Errorf("the duration must be between 0 and 24h, got %s", duration)
+	}
 	return nil
 }
 
@@ -0,0 +1,33 @@
+package oncall
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+func TestGetOrCreateGrafanaLabelEscapesName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/api/plugins/grafana-labels-app/resources/v1/labels/name/team%2Fa%3Fb"; r.URL.EscapedPath() != expected {
+			t.Errorf("expected the path %s, got %s", expected, r.URL.EscapedPath())
+		}
+		w.Write([]byte(`{"key": {"id": "1", "name": "team/a?b"}, "values": []}`))
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	client := &common.Client{GrafanaAPIURLParsed: serverURL, GrafanaAPIConfig: &goapi.TransportConfig{}}
+	label, err := getOrCreateGrafanaLabel(context.Background(), client, "team/a?b", integrationLabelPart{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label.Key.Name != "team/a?b" {
+		t.Errorf("unexpected label %+v", label)
+	}
+}
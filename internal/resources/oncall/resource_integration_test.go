@@ -70,6 +70,113 @@ func TestAccOnCallIntegration_basic(t *testing.T) {
 	})
 }
 
+func TestAccOnCallIntegration_maintenance(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	rName := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+	rType := "grafana"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccCheckOnCallIntegrationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOnCallIntegrationConfig(rName, rType, `maintenance {
+					mode     = "maintenance"
+					duration = "1h"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnCallIntegrationResourceExists("grafana_oncall_integration.test-acc-integration"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "maintenance_mode", "maintenance"),
+					resource.TestCheckResourceAttrSet("grafana_oncall_integration.test-acc-integration", "maintenance_end_at"),
+				),
+			},
+			{
+				Config: testAccOnCallIntegrationConfig(rName, rType, `maintenance {
+					mode     = "debug"
+					duration = "1h"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "maintenance_mode", "debug"),
+				),
+			},
+			// Replacing the active window by one that hasn't started yet stops the mode until it starts
+			{
+				Config: testAccOnCallIntegrationConfig(rName, rType, `maintenance {
+					mode     = "maintenance"
+					start    = "2100-01-01T00:00:00Z"
+					duration = "1h"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "maintenance_mode", ""),
+				),
+			},
+			{
+				Config: testAccOnCallIntegrationConfig(rName, rType, `maintenance {
+					mode     = "debug"
+					duration = "1h"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "maintenance_mode", "debug"),
+				),
+			},
+			// Removing the block stops the mode
+			{
+				Config: testAccOnCallIntegrationConfig(rName, rType, ``),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "maintenance_mode", ""),
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "maintenance_end_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOnCallIntegration_labels(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	rName := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+	rType := "grafana"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccCheckOnCallIntegrationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOnCallIntegrationConfig(rName, rType, `
+				labels {
+					key   = "team"
+					value = "platform"
+				}
+				alert_group_labels {
+					custom {
+						key   = "severity"
+						value = "{{ payload.severity }}"
+					}
+					template = "{{ payload.labels | tojson }}"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnCallIntegrationResourceExists("grafana_oncall_integration.test-acc-integration"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "labels.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_oncall_integration.test-acc-integration", "labels.*", map[string]string{"key": "team", "value": "platform"}),
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "alert_group_labels.0.custom.0.key", "severity"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "alert_group_labels.0.custom.0.value", "{{ payload.severity }}"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "alert_group_labels.0.template", "{{ payload.labels | tojson }}"),
+					testAccCheckOnCallIntegrationLabelIDs("grafana_oncall_integration.test-acc-integration"),
+				),
+			},
+			// Removing the blocks removes the labels
+			{
+				Config: testAccOnCallIntegrationConfig(rName, rType, ``),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "labels.#", "0"),
+					resource.TestCheckResourceAttr("grafana_oncall_integration.test-acc-integration", "alert_group_labels.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOnCallIntegrationResourceDestroy(s *terraform.State) error {
 	client := testutils.Provider.Meta().(*common.Client).OnCallClient
 	for _, r := range s.RootModule().Resources {
@@ -103,6 +210,39 @@ resource "grafana_oncall_integration" "test-acc-integration" {
 `, rName, rType, additionalConfigs)
 }
 
+// testAccCheckOnCallIntegrationLabelIDs checks that the labels of the integration reference the Grafana labels repository by ID, rather than by name.
+func testAccCheckOnCallIntegrationLabelIDs(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client).OnCallClient
+		req, err := client.NewRequest("GET", fmt.Sprintf("integrations/%s/", rs.Primary.ID), nil)
+		if err != nil {
+			return err
+		}
+		var integration struct {
+			Labels []struct {
+				Key struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"key"`
+			} `json:"labels"`
+		}
+		if _, err := client.Do(req, &integration); err != nil {
+			return err
+		}
+		for _, label := range integration.Labels {
+			if label.Key.ID == "" || label.Key.ID == label.Key.Name {
+				return fmt.Errorf("the label %q isn't referenced by the ID of the Grafana labels repository: %q", label.Key.Name, label.Key.ID)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckOnCallIntegrationResourceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -2,15 +2,43 @@ package oncall
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"strings"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var outgoingWebhookTriggerTypes = []string{
+	"escalation",
+	"alert group created",
+	"acknowledge",
+	"resolve",
+	"silence",
+	"unsilence",
+	"unresolve",
+	"unacknowledge",
+	"status change",
+	"personal notification",
+}
+
+var outgoingWebhookTriggerTypesVerbal = strings.Join(outgoingWebhookTriggerTypes, ", ")
+
+var outgoingWebhookHTTPMethods = []string{
+	"GET",
+	"POST",
+	"PUT",
+	"DELETE",
+	"OPTIONS",
+}
+
+var outgoingWebhookHTTPMethodsVerbal = strings.Join(outgoingWebhookHTTPMethods, ", ")
+
 func ResourceOutgoingWebhook() *schema.Resource {
 	return &schema.Resource{
 		Description: `
@@ -33,7 +61,7 @@ func ResourceOutgoingWebhook() *schema.Resource {
 			"team_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource.",
+				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource, or the `oncall_team_id` attribute of a `grafana_oncall_team_link` resource, which waits for the sync.",
 			},
 			"url": {
 				Type:        schema.TypeString,
@@ -43,7 +71,7 @@ func ResourceOutgoingWebhook() *schema.Resource {
 			"data": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The data of the webhook.",
+				Description: "The data of the webhook, a Jinja2 template rendered with the payload of the event. Ignored when `forward_whole_payload` is set.",
 			},
 			"user": {
 				Type:        schema.TypeString,
@@ -68,16 +96,18 @@ func ResourceOutgoingWebhook() *schema.Resource {
 				Description: "Toggle to send the entire webhook payload instead of using the values in the Data field.",
 			},
 			"trigger_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The type of event that will cause this outgoing webhook to execute. The types of triggers are: `escalation`, `alert group created`, `acknowledge`, `resolve`, `silence`, `unsilence`, `unresolve`, `unacknowledge`.",
-				Default:     "escalation",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(outgoingWebhookTriggerTypes, false),
+				Description:  fmt.Sprintf("The type of event that will cause this outgoing webhook to execute. Can be %s. `status change` triggers on acknowledge, resolve, silence and their opposites, `personal notification` triggers when a user is notified by an escalation.", outgoingWebhookTriggerTypesVerbal),
+				Default:      "escalation",
 			},
 			"http_method": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The HTTP method used in the request made by the outgoing webhook.",
-				Default:     "POST",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(outgoingWebhookHTTPMethods, false),
+				Description:  fmt.Sprintf("The HTTP method used in the request made by the outgoing webhook. Can be %s.", outgoingWebhookHTTPMethodsVerbal),
+				Default:      "POST",
 			},
 			"trigger_template": {
 				Type:        schema.TypeString,
@@ -98,7 +128,8 @@ func ResourceOutgoingWebhook() *schema.Resource {
 			"is_webhook_enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Controls whether the outgoing webhook will trigger or is ignored. The default is `true`.",
+				Default:     true,
+				Description: "Controls whether the outgoing webhook will trigger or is ignored.",
 			},
 		},
 	}
@@ -2,6 +2,7 @@ package oncall_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
@@ -27,6 +28,20 @@ func TestAccOnCallOutgoingWebhook_basic(t *testing.T) {
 					testAccCheckOnCallOutgoingWebhookResourceExists("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook"),
 				),
 			},
+			// The webhook is enabled unless disabled explicitly
+			{
+				Config: testAccOnCallOutgoingWebhookConfigMinimal(webhookName, "status change", "PUT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnCallOutgoingWebhookResourceExists("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook"),
+					resource.TestCheckResourceAttr("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook", "is_webhook_enabled", "true"),
+					resource.TestCheckResourceAttr("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook", "trigger_type", "status change"),
+					resource.TestCheckResourceAttr("grafana_oncall_outgoing_webhook.test-acc-outgoing_webhook", "http_method", "PUT"),
+				),
+			},
+			{
+				Config:      testAccOnCallOutgoingWebhookConfigMinimal(webhookName, "escalation", "PATCHED"),
+				ExpectError: regexp.MustCompile(`expected http_method to be one of`),
+			},
 		},
 	})
 }
@@ -65,6 +80,17 @@ resource "grafana_oncall_outgoing_webhook" "test-acc-outgoing_webhook" {
 `, webhookName)
 }
 
+func testAccOnCallOutgoingWebhookConfigMinimal(webhookName, triggerType, httpMethod string) string {
+	return fmt.Sprintf(`
+resource "grafana_oncall_outgoing_webhook" "test-acc-outgoing_webhook" {
+	name         = "%s"
+	url          = "https://example.com"
+	trigger_type = "%s"
+	http_method  = "%s"
+}
+`, webhookName, triggerType, httpMethod)
+}
+
 func testAccCheckOnCallOutgoingWebhookResourceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
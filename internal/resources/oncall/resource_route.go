@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -60,9 +61,17 @@ func ResourceRoute() *schema.Resource {
 				Description:  fmt.Sprintf("The type of route. Can be %s", routeTypeOptionsVerbal),
 			},
 			"routing_regex": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Python Regex query. Route is chosen for an alert if there is a match inside the alert payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"routing_regex", "routing_labels"},
+				Description:  "Python Regex query. Route is chosen for an alert if there is a match inside the alert payload.",
+			},
+			"routing_labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Route the alert groups having all these labels, by key. The route is created with a Jinja2 routing template matching the labels: `routing_type` is ignored. " +
+					"The labels come from the integration's `labels` and `alert_group_labels`. Requires a version of OnCall supporting labels.",
 			},
 			"slack": {
 				Type:     schema.TypeList,
@@ -136,8 +145,7 @@ func ResourceRouteCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	integrationID := d.Get("integration_id").(string)
 	escalationChainID := d.Get("escalation_chain_id").(string)
-	routingType := d.Get("routing_type").(string)
-	routingRegex := d.Get("routing_regex").(string)
+	routingType, routingRegex := routeRouting(d)
 	position := d.Get("position").(int)
 	slack := d.Get("slack").([]interface{})
 	telegram := d.Get("telegram").([]interface{})
@@ -180,10 +188,16 @@ func ResourceRouteRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	d.Set("integration_id", route.IntegrationId)
 	d.Set("escalation_chain_id", route.EscalationChainId)
-	d.Set("routing_type", route.RoutingType)
-	d.Set("routing_regex", route.RoutingRegex)
 	d.Set("position", route.Position)
 
+	// Routes by labels keep their configured routing type, unless their template was changed outside of Terraform
+	labels := d.Get("routing_labels").(map[string]interface{})
+	if len(labels) == 0 || route.RoutingType != "jinja2" || route.RoutingRegex != routeLabelsTemplate(labels) {
+		d.Set("routing_type", route.RoutingType)
+		d.Set("routing_regex", route.RoutingRegex)
+		d.Set("routing_labels", nil)
+	}
+
 	// Set messengers data only if related fields are presented
 	_, slackOk := d.GetOk("slack")
 	if slackOk {
@@ -205,8 +219,7 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	client := m.(*common.Client).OnCallClient
 
 	escalationChainID := d.Get("escalation_chain_id").(string)
-	routingType := d.Get("routing_type").(string)
-	routingRegex := d.Get("routing_regex").(string)
+	routingType, routingRegex := routeRouting(d)
 	position := d.Get("position").(int)
 	slack := d.Get("slack").([]interface{})
 	telegram := d.Get("telegram").([]interface{})
@@ -232,6 +245,33 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	return ResourceRouteRead(ctx, d, m)
 }
 
+// routeRouting returns the routing type and regex or template of the route, built from `routing_labels` if set.
+func routeRouting(d *schema.ResourceData) (string, string) {
+	if labels := d.Get("routing_labels").(map[string]interface{}); len(labels) > 0 {
+		return "jinja2", routeLabelsTemplate(labels)
+	}
+	return d.Get("routing_type").(string), d.Get("routing_regex").(string)
+}
+
+// routeLabelsTemplate returns a Jinja2 routing template matching the alert groups having all the given labels.
+func routeLabelsTemplate(labels map[string]interface{}) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	conditions := make([]string, 0, len(keys))
+	for _, k := range keys {
+		conditions = append(conditions, fmt.Sprintf("labels.get(%s) == %s", jinjaString(k), jinjaString(labels[k].(string))))
+	}
+	return "{{ " + strings.Join(conditions, " and ") + " }}"
+}
+
+// jinjaString quotes a string as a Jinja2 string literal.
+func jinjaString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
 func ResourceRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*common.Client).OnCallClient
 
@@ -2,6 +2,7 @@ package oncall_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
@@ -33,6 +34,52 @@ func TestAccOnCallRoute_basic(t *testing.T) {
 	})
 }
 
+func TestAccOnCallRoute_labels(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	riName := fmt.Sprintf("integration-%s", acctest.RandString(8))
+	config := func(labels string) string {
+		return strings.Replace(testAccOnCallRouteConfig(riName, ""), `routing_regex = ""`, labels, 1)
+	}
+
+	// TODO: Make parallelizable
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccCheckOnCallRouteResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`routing_labels = { severity = "critical", team = "platform" }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnCallRouteResourceExists("grafana_oncall_route.test-acc-route"),
+					resource.TestCheckResourceAttr("grafana_oncall_route.test-acc-route", "routing_labels.%", "2"),
+					resource.TestCheckResourceAttr("grafana_oncall_route.test-acc-route", "routing_regex", ""),
+					func(s *terraform.State) error {
+						client := testutils.Provider.Meta().(*common.Client).OnCallClient
+						route, _, err := client.Routes.GetRoute(s.RootModule().Resources["grafana_oncall_route.test-acc-route"].Primary.ID, &onCallAPI.GetRouteOptions{})
+						if err != nil {
+							return err
+						}
+						expected := `{{ labels.get("severity") == "critical" and labels.get("team") == "platform" }}`
+						if route.RoutingType != "jinja2" || route.RoutingRegex != expected {
+							return fmt.Errorf("expected a jinja2 route with the template %s, got a %s route with %s", expected, route.RoutingType, route.RoutingRegex)
+						}
+						return nil
+					},
+				),
+			},
+			// Switching back to a regex
+			{
+				Config: config(`routing_regex = "critical"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_oncall_route.test-acc-route", "routing_labels.%", "0"),
+					resource.TestCheckResourceAttr("grafana_oncall_route.test-acc-route", "routing_type", "regex"),
+					resource.TestCheckResourceAttr("grafana_oncall_route.test-acc-route", "routing_regex", "critical"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOnCallRouteResourceDestroy(s *terraform.State) error {
 	client := testutils.Provider.Meta().(*common.Client).OnCallClient
 	for _, r := range s.RootModule().Resources {
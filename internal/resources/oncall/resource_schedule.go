@@ -40,7 +40,7 @@ func ResourceSchedule() *schema.Resource {
 			"team_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource.",
+				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource, or the `oncall_team_id` attribute of a `grafana_oncall_team_link` resource, which waits for the sync.",
 			},
 			"type": {
 				Type:         schema.TypeString,
@@ -65,7 +65,7 @@ func ResourceOnCallShift() *schema.Resource {
 			"team_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource.",
+				Description: "The ID of the OnCall team. To get one, create a team in Grafana, and navigate to the OnCall plugin (to sync the team with OnCall). You can then get the ID using the `grafana_oncall_team` datasource, or the `oncall_team_id` attribute of a `grafana_oncall_team_link` resource, which waits for the sync.",
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -74,10 +74,13 @@ func ResourceOnCallShift() *schema.Resource {
 				Description:  "The shift's name.",
 			},
 			"type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice(onCallShiftTypeOptions, false),
-				Description:  fmt.Sprintf("The shift's type. Can be %s", onCallShiftTypeOptionsVerbal),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"type", "rotation"},
+				RequiredWith:     []string{"start", "duration"},
+				ValidateFunc:     validation.StringInSlice(onCallShiftTypeOptions, false),
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      fmt.Sprintf("The shift's type. Can be %s. Exactly one of `type` and `rotation` must be set.", onCallShiftTypeOptionsVerbal),
 			},
 			"level": {
 				Type:        schema.TypeInt,
@@ -85,30 +88,38 @@ func ResourceOnCallShift() *schema.Resource {
 				Description: "The priority level. The higher the value, the higher the priority.",
 			},
 			"start": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-				Description:  "The start time of the on-call shift. This parameter takes a date format as yyyy-MM-dd'T'HH:mm:ss (for example \"2020-09-05T08:00:00\")",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "The start time of the on-call shift. This parameter takes a date format as yyyy-MM-dd'T'HH:mm:ss (for example \"2020-09-05T08:00:00\"). Required when `type` is set.",
 			},
 			"duration": {
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntAtLeast(0),
-				Description:  "The duration of the event.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateFunc:     validation.IntAtLeast(0),
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "The duration of the event. Required when `type` is set.",
 			},
 			"frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(onCallShiftFrequencyOptions, false),
-				Description:  fmt.Sprintf("The frequency of the event. Can be %s", onCallShiftFrequencyOptionsVerbal),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringInSlice(onCallShiftFrequencyOptions, false),
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      fmt.Sprintf("The frequency of the event. Can be %s", onCallShiftFrequencyOptionsVerbal),
 			},
 			"users": {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional:    true,
-				Description: "The list of on-call users (for single_event and recurrent_event event type).	",
+				Optional:         true,
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "The list of on-call users (for single_event and recurrent_event event type).	",
 			},
 			"rolling_users": {
 				Type: schema.TypeList,
@@ -118,20 +129,26 @@ func ResourceOnCallShift() *schema.Resource {
 						Type: schema.TypeString,
 					},
 				},
-				Optional:    true,
-				Description: "The list of lists with on-call users (for rolling_users event type)",
+				Optional:         true,
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "The list of lists with on-call users (for rolling_users event type)",
 			},
 			"interval": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(1),
-				Description:  "The positive integer representing at which intervals the recurrence rule repeats.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateFunc:     validation.IntAtLeast(1),
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "The positive integer representing at which intervals the recurrence rule repeats.",
 			},
 			"week_start": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(onCallShiftWeekDayOptions, false),
-				Description:  fmt.Sprintf("Start day of the week in iCal format. Can be %s", onCallShiftWeekDayOptionsVerbal),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringInSlice(onCallShiftWeekDayOptions, false),
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      fmt.Sprintf("Start day of the week in iCal format. Can be %s", onCallShiftWeekDayOptionsVerbal),
 			},
 			"by_day": {
 				Type: schema.TypeSet,
@@ -139,8 +156,10 @@ func ResourceOnCallShift() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringInSlice(onCallShiftWeekDayOptions, false),
 				},
-				Optional:    true,
-				Description: fmt.Sprintf("This parameter takes a list of days in iCal format. Can be %s", onCallShiftWeekDayOptionsVerbal),
+				Optional:         true,
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      fmt.Sprintf("This parameter takes a list of days in iCal format. Can be %s", onCallShiftWeekDayOptionsVerbal),
 			},
 			"by_month": {
 				Type: schema.TypeSet,
@@ -148,8 +167,10 @@ func ResourceOnCallShift() *schema.Resource {
 					Type:         schema.TypeInt,
 					ValidateFunc: validation.IntBetween(1, 12),
 				},
-				Optional:    true,
-				Description: "This parameter takes a list of months. Valid values are 1 to 12",
+				Optional:         true,
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "This parameter takes a list of months. Valid values are 1 to 12",
 			},
 			"by_monthday": {
 				Type: schema.TypeSet,
@@ -157,21 +178,28 @@ func ResourceOnCallShift() *schema.Resource {
 					Type:         schema.TypeInt,
 					ValidateFunc: validation.IntBetween(-31, 31),
 				},
-				Optional:    true,
-				Description: "This parameter takes a list of days of the month.  Valid values are 1 to 31 or -31 to -1",
+				Optional:         true,
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "This parameter takes a list of days of the month.  Valid values are 1 to 31 or -31 to -1",
 			},
 			"time_zone": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-				Description:  "The shift's timezone.  Overrides schedule's timezone.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "The shift's timezone.  Overrides schedule's timezone.",
 			},
 			"start_rotation_from_user_index": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(0),
-				Description:  "The index of the list of users in rolling_users, from which on-call rotation starts.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateFunc:     validation.IntAtLeast(0),
+				ConflictsWith:    []string{"rotation"},
+				DiffSuppressFunc: suppressOnCallShiftRotationExpansion,
+				Description:      "The index of the list of users in rolling_users, from which on-call rotation starts.",
 			},
+			"rotation": onCallShiftRotationSchema(),
 		},
 	}
 }
@@ -179,6 +207,34 @@ func ResourceOnCallShift() *schema.Resource {
 func ResourceOnCallShiftCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*common.Client).OnCallClient
 
+	if rotationData, ok := d.GetOk("rotation"); ok {
+		rotation, err := expandOnCallShiftRotation(rotationData.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		levelData := d.Get("level").(int)
+		onCallShift, _, err := client.OnCallShifts.CreateOnCallShift(&onCallAPI.CreateOnCallShiftOptions{
+			TeamId:                     d.Get("team_id").(string),
+			Type:                       rollingUsers,
+			Name:                       d.Get("name").(string),
+			Level:                      &levelData,
+			Start:                      rotation.Start,
+			Duration:                   rotation.Duration,
+			Frequency:                  &rotation.Frequency,
+			Interval:                   &rotation.Interval,
+			WeekStart:                  &rotation.WeekStart,
+			Source:                     sourceTerraform,
+			RollingUsers:               &rotation.RollingUsers,
+			TimeZone:                   &rotation.TimeZone,
+			StartRotationFromUserIndex: &rotation.StartRotationFromUserIndex,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(onCallShift.ID)
+		return ResourceOnCallShiftRead(ctx, d, m)
+	}
+
 	teamIDData := d.Get("team_id").(string)
 	typeData := d.Get("type").(string)
 	nameData := d.Get("name").(string)
@@ -302,6 +358,34 @@ func ResourceOnCallShiftCreate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceOnCallShiftUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*common.Client).OnCallClient
 
+	if rotationData, ok := d.GetOk("rotation"); ok {
+		rotation, err := expandOnCallShiftRotation(rotationData.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		levelData := d.Get("level").(int)
+		onCallShift, _, err := client.OnCallShifts.UpdateOnCallShift(d.Id(), &onCallAPI.UpdateOnCallShiftOptions{
+			TeamId:                     d.Get("team_id").(string),
+			Type:                       rollingUsers,
+			Name:                       d.Get("name").(string),
+			Level:                      &levelData,
+			Start:                      rotation.Start,
+			Duration:                   rotation.Duration,
+			Frequency:                  &rotation.Frequency,
+			Interval:                   &rotation.Interval,
+			WeekStart:                  &rotation.WeekStart,
+			Source:                     sourceTerraform,
+			RollingUsers:               &rotation.RollingUsers,
+			TimeZone:                   &rotation.TimeZone,
+			StartRotationFromUserIndex: &rotation.StartRotationFromUserIndex,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(onCallShift.ID)
+		return ResourceOnCallShiftRead(ctx, d, m)
+	}
+
 	typeData := d.Get("type").(string)
 	nameData := d.Get("name").(string)
 	teamIDData := d.Get("team_id").(string)
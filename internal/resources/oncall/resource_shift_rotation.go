@@ -0,0 +1,125 @@
+package oncall
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var onCallShiftRotationHandoffOptions = []string{
+	"daily",
+	"weekly",
+}
+
+var onCallShiftRotationHandoffTimeRegexp = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+
+func onCallShiftRotationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "A recurring rotation of participants, expanded into a `rolling_users` shift: the participants are on call in turn, 24/7, and hand off at the same time every `handoff_interval` days or weeks. " +
+			"The `type`, `start`, `duration`, `frequency`, `interval`, `week_start`, `rolling_users`, `time_zone` and `start_rotation_from_user_index` attributes are computed from it. Exactly one of `type` and `rotation` must be set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"participants": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The IDs of the users on call, in the order of the rotation.",
+				},
+				"handoff": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(onCallShiftRotationHandoffOptions, false),
+					Description:  "The unit of the time between handoffs. Can be daily, weekly",
+				},
+				"handoff_interval": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of days or weeks between handoffs.",
+				},
+				"start_date": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(finalShiftsDateRegexp, "must be in the YYYY-MM-DD format"),
+					Description:  "The day of the first shift, in the `YYYY-MM-DD` format. Weekly handoffs happen on the same day of the week.",
+				},
+				"handoff_time": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "09:00",
+					ValidateFunc: validation.StringMatch(onCallShiftRotationHandoffTimeRegexp, "must be in the HH:MM format"),
+					Description:  "The time of the handoffs, in the `HH:MM` format.",
+				},
+				"time_zone": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The time zone of `start_date` and `handoff_time`, e.g. `Europe/Paris`.",
+				},
+			},
+		},
+	}
+}
+
+// onCallShiftRotation is the `rolling_users` shift that a `rotation` block expands into.
+type onCallShiftRotation struct {
+	Start                      string
+	Duration                   int
+	Frequency                  string
+	Interval                   int
+	WeekStart                  string
+	RollingUsers               [][]string
+	TimeZone                   string
+	StartRotationFromUserIndex int
+}
+
+// expandOnCallShiftRotation expands the `rotation` block into the definition of a `rolling_users` shift.
+// Each participant is on call for a whole handoff period, and the weeks start on the day of the first shift,
+// so that the recurrence of weekly rotations with an interval is anchored on the handoffs.
+func expandOnCallShiftRotation(rotationData []interface{}) (onCallShiftRotation, error) {
+	rotation := rotationData[0].(map[string]interface{})
+
+	startDate, err := time.Parse(finalShiftsDateFormat, rotation["start_date"].(string))
+	if err != nil {
+		return onCallShiftRotation{}, fmt.Errorf("invalid rotation start_date: %w", err)
+	}
+
+	interval := rotation["handoff_interval"].(int)
+	period := 24 * time.Hour
+	if rotation["handoff"].(string) == "weekly" {
+		period *= 7
+	}
+
+	participants := rotation["participants"].([]interface{})
+	rollingUsers := make([][]string, 0, len(participants))
+	for _, p := range participants {
+		rollingUsers = append(rollingUsers, []string{p.(string)})
+	}
+
+	return onCallShiftRotation{
+		Start:        fmt.Sprintf("%sT%s:00", startDate.Format(finalShiftsDateFormat), rotation["handoff_time"].(string)),
+		Duration:     int((time.Duration(interval) * period).Seconds()),
+		Frequency:    rotation["handoff"].(string),
+		Interval:     interval,
+		WeekStart:    onCallShiftWeekDayOptions[(int(startDate.Weekday())+6)%7],
+		RollingUsers: rollingUsers,
+		TimeZone:     rotation["time_zone"].(string),
+	}, nil
+}
+
+// suppressOnCallShiftRotationExpansion suppresses the diff of the attributes that are computed from the `rotation` block, when it is set.
+func suppressOnCallShiftRotationExpansion(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	_, ok := d.GetOk("rotation")
+	return ok
+}
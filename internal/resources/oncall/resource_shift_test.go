@@ -2,6 +2,7 @@ package oncall_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
@@ -32,6 +33,31 @@ func TestAccOnCallOnCallShift_basic(t *testing.T) {
 	})
 }
 
+func TestAccOnCallOnCallShift_rotationValidation(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	shiftName := fmt.Sprintf("shift-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccCheckOnCallOnCallShiftResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOnCallOnCallShiftRotationConfig(shiftName, `
+	type = "rolling_users"
+	start = "2020-09-04T16:00:00"
+	duration = 3600
+`, "09:00"),
+				ExpectError: regexp.MustCompile(`only one of .rotation,type. can be specified`),
+			},
+			{
+				Config:      testAccOnCallOnCallShiftRotationConfig(shiftName, "", "9am"),
+				ExpectError: regexp.MustCompile(`must be in the HH:MM format`),
+			},
+		},
+	})
+}
+
 func testAccCheckOnCallOnCallShiftResourceDestroy(s *terraform.State) error {
 	client := testutils.Provider.Meta().(*common.Client).OnCallClient
 	for _, r := range s.RootModule().Resources {
@@ -68,6 +94,23 @@ resource "grafana_oncall_on_call_shift" "test-acc-on_call_shift" {
 `, scheduleName, shiftName)
 }
 
+func testAccOnCallOnCallShiftRotationConfig(shiftName, extra, handoffTime string) string {
+	return fmt.Sprintf(`
+resource "grafana_oncall_on_call_shift" "test-acc-on_call_shift" {
+	name = "%s"
+	%s
+	rotation {
+		participants = ["U1234567890AB"]
+		handoff = "weekly"
+		handoff_interval = 2
+		start_date = "2020-09-07"
+		handoff_time = "%s"
+		time_zone = "Europe/Paris"
+	}
+}
+`, shiftName, extra, handoffTime)
+}
+
 func testAccCheckOnCallOnCallShiftResourceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,255 @@
+package oncall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"time"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceTeamLink() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Links a Grafana team to the OnCall team synced from it.
+
+OnCall syncs its teams and users from Grafana, but a new Grafana team only shows up in OnCall after the next sync.
+This resource waits for the team, and optionally the membership of its users in the OnCall team, to be synced, and exports the ID of the OnCall team,
+so that OnCall resources can depend on a ` + "`grafana_team`" + ` resource created in the same apply.
+If the team or its members are missing from OnCall when the resource is refreshed, the next apply waits for them again.
+
+Destroying this resource removes it from the state. OnCall removes the team when the Grafana team is deleted.
+
+This resource requires both the Grafana and the OnCall clients of the provider to be configured.
+
+* [Official documentation](https://grafana.com/docs/oncall/latest/user-and-team-management/)
+* [HTTP API](https://grafana.com/docs/oncall/latest/oncall-api-reference/teams/)
+`,
+		CreateContext: ResourceTeamLinkSync,
+		ReadContext:   ResourceTeamLinkRead,
+		UpdateContext: ResourceTeamLinkSync,
+		DeleteContext: ResourceTeamLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// The members found missing by the refresh are waited for by the apply
+			if d.Get("sync_members").(bool) && len(d.Get("unsynced_members").([]interface{})) > 0 {
+				return d.SetNewComputed("unsynced_members")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Grafana team, i.e. the `id` attribute of a `grafana_team` resource.",
+			},
+			"sync_members": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait for the members of the Grafana team to be synced to OnCall as users and as members of the OnCall team, in addition to the team itself.",
+			},
+			"sync_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "10m",
+				ValidateDiagFunc: validateTeamLinkSyncTimeout,
+				Description:      "How long to wait for the team and its members to be synced to OnCall, as a duration.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the team.",
+			},
+			"oncall_team_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the OnCall team, to use as the `team_id` of OnCall resources.",
+			},
+			"unsynced_members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The emails of the members of the Grafana team which aren't OnCall users or members of the OnCall team yet, sorted alphabetically. Always empty if `sync_members` is false.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func ResourceTeamLinkSync(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.Client).OnCallClient
+	teamID := d.Get("team_id").(string)
+	name, members, err := grafanaTeamWithMembers(ctx, m, teamID, d.Get("sync_members").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout, _ := time.ParseDuration(d.Get("sync_timeout").(string))
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		team, err := findOnCallTeam(client, name)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if team == nil {
+			return retry.RetryableError(fmt.Errorf("team %q isn't synced to OnCall yet", name))
+		}
+		unsynced, err := unsyncedTeamMembers(client, team.ID, members)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if len(unsynced) > 0 {
+			return retry.RetryableError(fmt.Errorf("members of team %q aren't synced to OnCall yet: %v", name, unsynced))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID)
+	return ResourceTeamLinkRead(ctx, d, m)
+}
+
+func ResourceTeamLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.Client).OnCallClient
+	if _, err := time.ParseDuration(d.Get("sync_timeout").(string)); err != nil {
+		// The settings aren't set when the resource is imported
+		d.Set("sync_members", true)
+		d.Set("sync_timeout", "10m")
+	}
+
+	name, members, err := grafanaTeamWithMembers(ctx, m, d.Id(), d.Get("sync_members").(bool))
+	if err, shouldReturn := common.CheckReadError("team", d, err); shouldReturn {
+		return err
+	}
+	team, err := findOnCallTeam(client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if team == nil {
+		log.Printf("[WARN] removing the OnCall link of team %s from state because the team isn't in OnCall", name)
+		d.SetId("")
+		return nil
+	}
+	unsynced, err := unsyncedTeamMembers(client, team.ID, members)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("team_id", d.Id())
+	d.Set("name", name)
+	d.Set("oncall_team_id", team.ID)
+	d.Set("unsynced_members", unsynced)
+	return nil
+}
+
+func ResourceTeamLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] removing the OnCall link of team %s from state, the OnCall team is left as it is", d.Get("name").(string))
+	d.SetId("")
+	return nil
+}
+
+// grafanaTeamWithMembers returns the name of a Grafana team and, if withMembers is set, the emails of its members.
+func grafanaTeamWithMembers(ctx context.Context, m interface{}, id string, withMembers bool) (string, []string, error) {
+	if m.(*common.Client).GrafanaOAPI == nil {
+		return "", nil, fmt.Errorf("the Grafana client is required for `grafana_oncall_team_link`. Set the auth and url provider attributes")
+	}
+	client, _, teamID := common.OAPIClientFromExistingOrgResource(ctx, m, id)
+	team, err := client.Teams.GetTeamByID(teamID)
+	if err != nil {
+		return "", nil, err
+	}
+	if !withMembers {
+		return team.Payload.Name, nil, nil
+	}
+
+	resp, err := client.Teams.GetTeamMembers(teamID)
+	if err != nil {
+		return "", nil, err
+	}
+	members := make([]string, 0, len(resp.Payload))
+	for _, member := range resp.Payload {
+		members = append(members, member.Email)
+	}
+	return team.Payload.Name, members, nil
+}
+
+// findOnCallTeam returns the OnCall team with the given name, or nil if it isn't synced yet.
+func findOnCallTeam(client *onCallAPI.Client, name string) (*onCallAPI.Team, error) {
+	resp, _, err := client.Teams.ListTeams(&onCallAPI.ListTeamOptions{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	// Only keep the team whose name is an exact match
+	for _, team := range resp.Teams {
+		if team.Name == name {
+			return team, nil
+		}
+	}
+	return nil, nil
+}
+
+// unsyncedTeamMembers returns the given emails which aren't the emails of OnCall users in the OnCall team with the given ID, sorted alphabetically.
+// The users are synced before their membership of the team, so a user can be in OnCall without being in the team yet.
+func unsyncedTeamMembers(client *onCallAPI.Client, teamID string, emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return []string{}, nil
+	}
+
+	synced := map[string]bool{}
+	options := &onCallAPI.ListUserOptions{ListOptions: onCallAPI.ListOptions{Page: 1}}
+	for {
+		// The OnCall client doesn't expose the teams of the users
+		req, err := client.NewRequest("GET", "users/", options)
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			onCallAPI.PaginatedResponse
+			Results []onCallUser `json:"results"`
+		}
+		if _, err := client.Do(req, &resp); err != nil {
+			return nil, err
+		}
+		for _, u := range resp.Results {
+			if slices.Contains(u.Teams, teamID) {
+				synced[u.Email] = true
+			}
+		}
+		if resp.Next == nil {
+			break
+		}
+		options.Page++
+	}
+
+	unsynced := []string{}
+	for _, email := range emails {
+		if !synced[email] {
+			unsynced = append(unsynced, email)
+		}
+	}
+	sort.Strings(unsynced)
+	return unsynced, nil
+}
+
+func validateTeamLinkSyncTimeout(i interface{}, p cty.Path) diag.Diagnostics {
+	v := i.(string)
+	if _, err := time.ParseDuration(v); err != nil {
+		return diag.Errorf("%q is not a valid duration: %s", v, err)
+	}
+	return nil
+}
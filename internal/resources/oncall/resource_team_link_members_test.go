@@ -0,0 +1,38 @@
+package oncall
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+)
+
+func TestUnsyncedTeamMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"next": null, "results": [{"id": "U3", "email": "c@example.com", "teams": ["T1"]}]}`))
+			return
+		}
+		w.Write([]byte(`{"next": "http://` + r.Host + `/api/v1/users/?page=2", "results": [
+			{"id": "U1", "email": "a@example.com", "teams": ["T1", "T2"]},
+			{"id": "U2", "email": "b@example.com", "teams": ["T2"]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := onCallAPI.New(server.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// b is an OnCall user but not a member of the team yet, and d isn't an OnCall user
+	unsynced, err := unsyncedTeamMembers(client, "T1", []string{"d@example.com", "c@example.com", "b@example.com", "a@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"b@example.com", "d@example.com"}; !reflect.DeepEqual(unsynced, expected) {
+		t.Errorf("expected %v, got %v", expected, unsynced)
+	}
+}
@@ -0,0 +1,63 @@
+package oncall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOnCallTeamLink_basic(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	teamName := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOnCallTeamLinkConfig(teamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("grafana_oncall_team_link.test", "team_id", "grafana_team.test", "id"),
+					resource.TestCheckResourceAttr("grafana_oncall_team_link.test", "name", teamName),
+					resource.TestCheckResourceAttrSet("grafana_oncall_team_link.test", "oncall_team_id"),
+					// The member of the team is only synced once it's a member of the OnCall team
+					resource.TestCheckResourceAttr("grafana_oncall_team_link.test", "unsynced_members.#", "0"),
+					resource.TestCheckResourceAttrPair("data.grafana_oncall_team.test", "id", "grafana_oncall_team_link.test", "oncall_team_id"),
+				),
+			},
+			{
+				ResourceName:            "grafana_oncall_team_link.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sync_timeout"},
+			},
+		},
+	})
+}
+
+func testAccOnCallTeamLinkConfig(teamName string) string {
+	return fmt.Sprintf(`
+resource "grafana_user" "test" {
+	email    = "%[1]s@example.com"
+	login    = "%[1]s"
+	password = "my-password"
+}
+
+resource "grafana_team" "test" {
+	name    = "%[1]s"
+	members = [grafana_user.test.email]
+}
+
+resource "grafana_oncall_team_link" "test" {
+	team_id      = grafana_team.test.id
+	sync_timeout = "15m"
+}
+
+data "grafana_oncall_team" "test" {
+	name = grafana_oncall_team_link.test.name
+}
+`, teamName)
+}
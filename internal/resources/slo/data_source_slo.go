@@ -31,6 +31,7 @@ Datasource for retrieving all SLOs.
 							Description: `A unique, random identifier. This value will also be the name of the resource stored in the API server. This value is read-only.`,
 							Computed:    true,
 						},
+						common.AppliedDefaultLabelsAttribute: nil,
 					}),
 				},
 			},
@@ -0,0 +1,415 @@
+package slo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/folders"
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	slo "github.com/grafana/slo-openapi-client/go"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const serviceMonitoringRuleGroup = "Service monitoring"
+
+func ResourceServiceMonitoring() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Onboards a service to Grafana in a single resource. The following objects are provisioned, with sensible defaults:
+
+* A folder, named after the service.
+* An overview dashboard in that folder, showing the request rate and error ratio of the service (and its logs, if ` + "`loki_datasource_uid`" + ` is set).
+* An SLO on the ratio of requests that are not server errors.
+* An alert rule group in that folder, firing when the error ratio goes over ` + "`error_ratio_threshold`" + `.
+
+The service's metrics are expected to follow the ` + "`<request_metric>{<service_label>=\"<service_name>\", code=\"<status code>\"}`" + ` convention.
+The provisioned objects are regenerated when the attributes of this resource change. Changes made to them outside of Terraform are not detected.
+To customize them further, use the ` + "`grafana_folder`" + `, ` + "`grafana_dashboard`" + `, ` + "`grafana_slo`" + ` and ` + "`grafana_rule_group`" + ` resources instead.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/alerting-and-irm/slo/)
+`,
+		CreateContext: resourceServiceMonitoringCreate,
+		ReadContext:   resourceServiceMonitoringRead,
+		UpdateContext: resourceServiceMonitoringUpdate,
+		DeleteContext: resourceServiceMonitoringDelete,
+		CustomizeDiff: common.CustomizeDiffDefaultLabels,
+		Schema: map[string]*schema.Schema{
+			common.AppliedDefaultLabelsAttribute: common.AppliedDefaultLabelsSchema(),
+			"service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the service. It is used to name the provisioned objects and to select the service's metrics and logs.",
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"prometheus_datasource_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the Prometheus datasource holding the service's metrics. It is used by the dashboard and the alert rules.",
+			},
+			"loki_datasource_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The UID of the Loki datasource holding the service's logs. If set, a logs panel is added to the dashboard.",
+			},
+			"request_metric": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "http_requests_total",
+				Description: "The counter metric of the requests handled by the service. It must have a `code` label with the response status code.",
+			},
+			"service_label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "service",
+				Description: "The label identifying the service in its metrics and logs.",
+			},
+			"slo_objective": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.995,
+				Description:  "The objective of the SLO, as the ratio of requests that must not be server errors.",
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"slo_window": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "28d",
+				Description: "The window of the SLO objective.",
+			},
+			"error_ratio_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.05,
+				Description:  "The ratio of server errors above which the alert rule fires.",
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"alert_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				Description:  "The interval, in seconds, at which the alert rules are evaluated.",
+				ValidateFunc: validation.IntAtLeast(10),
+			},
+			"folder_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UID of the provisioned folder.",
+			},
+			"dashboard_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UID of the provisioned dashboard.",
+			},
+			"dashboard_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full URL of the provisioned dashboard.",
+			},
+			"slo_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the provisioned SLO.",
+			},
+			"rule_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the provisioned alert rule group.",
+			},
+		},
+	}
+}
+
+func resourceServiceMonitoringCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := common.OAPIWithContext(ctx, m.(*common.Client).GrafanaOAPI.Clone())
+	name := d.Get("service_name").(string)
+
+	folder, err := client.Folders.CreateFolder(&models.CreateFolderCommand{Title: name})
+	if err != nil {
+		return diag.Errorf("failed to create the folder of service %s: %s", name, err)
+	}
+	d.SetId(folder.Payload.UID)
+	d.Set("folder_uid", folder.Payload.UID)
+
+	if diags := putServiceMonitoringObjects(ctx, d, m); diags.HasError() {
+		return diags
+	}
+	m.(*common.Client).SetAppliedDefaultLabels(d)
+	return resourceServiceMonitoringRead(ctx, d, m)
+}
+
+func resourceServiceMonitoringUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := putServiceMonitoringObjects(ctx, d, m); diags.HasError() {
+		return diags
+	}
+	m.(*common.Client).SetAppliedDefaultLabels(d)
+	return resourceServiceMonitoringRead(ctx, d, m)
+}
+
+// putServiceMonitoringObjects creates or updates the dashboard, SLO and rule group of the service.
+// The folder must already exist. Computed attributes are set as soon as each object is created,
+// so that a partially applied resource still tracks (and can delete) what it created.
+func putServiceMonitoringObjects(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	metaClient := m.(*common.Client)
+	client := common.OAPIWithContext(ctx, metaClient.GrafanaOAPI.Clone())
+	folderUID := d.Id()
+
+	dashboard, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{
+		Dashboard: serviceMonitoringDashboard(d),
+		FolderUID: folderUID,
+		Overwrite: true,
+		Message:   "Updated by the grafana_service_monitoring resource",
+	})
+	if err != nil {
+		return diag.Errorf("failed to save the dashboard: %s", err)
+	}
+	d.Set("dashboard_uid", *dashboard.Payload.UID)
+
+	serviceSLO := serviceMonitoringSLO(d, metaClient)
+	if sloUUID := d.Get("slo_uuid").(string); sloUUID == "" {
+		resp, _, err := metaClient.SLOClient.DefaultAPI.V1SloPost(ctx).Slo(serviceSLO).Execute()
+		if err != nil {
+			return diag.Errorf("failed to create the SLO: %s", err)
+		}
+		d.Set("slo_uuid", resp.Uuid)
+	} else {
+		serviceSLO.Uuid = sloUUID
+		if _, err := metaClient.SLOClient.DefaultAPI.V1SloIdPut(ctx, sloUUID).Slo(serviceSLO).Execute(); err != nil {
+			return diag.Errorf("failed to update the SLO: %s", err)
+		}
+	}
+
+	rule, err := serviceMonitoringAlertRule(d, metaClient, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	putParams := provisioning.NewPutAlertRuleGroupParams().
+		WithFolderUID(folderUID).
+		WithGroup(serviceMonitoringRuleGroup).
+		WithBody(&models.AlertRuleGroup{
+			Title:     serviceMonitoringRuleGroup,
+			FolderUID: folderUID,
+			Interval:  int64(d.Get("alert_interval_seconds").(int)),
+			Rules:     []*models.ProvisionedAlertRule{rule},
+		})
+	if _, err := client.Provisioning.PutAlertRuleGroup(putParams); err != nil {
+		return diag.Errorf("failed to save the alert rule group: %s", err)
+	}
+	d.Set("rule_group_name", serviceMonitoringRuleGroup)
+
+	return nil
+}
+
+func resourceServiceMonitoringRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	metaClient := m.(*common.Client)
+	client := common.OAPIWithContext(ctx, metaClient.GrafanaOAPI.Clone())
+
+	// The folder holds all the other objects: if it's gone, so are they.
+	folder, err := client.Folders.GetFolderByUID(d.Id())
+	if err, shouldReturn := common.CheckReadError("service monitoring folder", d, err); shouldReturn {
+		return err
+	}
+	d.Set("folder_uid", folder.Payload.UID)
+
+	if dashboardUID := d.Get("dashboard_uid").(string); dashboardUID != "" {
+		dashboard, err := client.Dashboards.GetDashboardByUID(dashboardUID)
+		if err != nil && !common.IsNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+		if err != nil {
+			d.Set("dashboard_uid", "")
+			d.Set("dashboard_url", "")
+		} else {
+			d.Set("dashboard_url", metaClient.GrafanaSubpath(dashboard.Payload.Meta.URL))
+		}
+	}
+
+	if sloUUID := d.Get("slo_uuid").(string); sloUUID != "" {
+		if _, resp, err := metaClient.SLOClient.DefaultAPI.V1SloIdGet(ctx, sloUUID).Execute(); err != nil {
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return diag.Errorf("failed to read the SLO %s: %s", sloUUID, err)
+			}
+			// Recreated on the next apply
+			d.Set("slo_uuid", "")
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceMonitoringDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	metaClient := m.(*common.Client)
+
+	if sloUUID := d.Get("slo_uuid").(string); sloUUID != "" {
+		if resp, err := metaClient.SLOClient.DefaultAPI.V1SloIdDelete(ctx, sloUUID).Execute(); err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return diag.Errorf("failed to delete the SLO %s: %s", sloUUID, err)
+		}
+	}
+
+	// Deleting the folder also deletes the dashboard and the alert rules in it.
+	force := true
+	_, err := metaClient.GrafanaOAPI.Folders.DeleteFolder(folders.NewDeleteFolderParams().WithContext(ctx).WithFolderUID(d.Id()).WithForceDeleteRules(&force))
+	diags, _ := common.CheckReadError("service monitoring folder", d, err)
+	return diags
+}
+
+// serviceMonitoringSelector returns a PromQL or LogQL stream selector for the service, with the given additional matchers.
+func serviceMonitoringSelector(d *schema.ResourceData, metric string, matchers ...string) string {
+	selector := metric + "{" + d.Get("service_label").(string) + "=" + strconv.Quote(d.Get("service_name").(string))
+	for _, matcher := range matchers {
+		selector += "," + matcher
+	}
+	return selector + "}"
+}
+
+func serviceMonitoringQueries(d *schema.ResourceData, rateInterval string) (requestRate, errorRatio string) {
+	metric := d.Get("request_metric").(string)
+	requestRate = fmt.Sprintf("sum(rate(%s[%s]))", serviceMonitoringSelector(d, metric), rateInterval)
+	errorRatio = fmt.Sprintf("sum(rate(%s[%s])) / %s", serviceMonitoringSelector(d, metric, `code=~"5.."`), rateInterval, requestRate)
+	return requestRate, errorRatio
+}
+
+func serviceMonitoringDashboard(d *schema.ResourceData) map[string]interface{} {
+	prometheus := map[string]interface{}{"type": "prometheus", "uid": d.Get("prometheus_datasource_uid").(string)}
+	requestRate, errorRatio := serviceMonitoringQueries(d, "$__rate_interval")
+
+	panels := []interface{}{
+		map[string]interface{}{
+			"id":         1,
+			"type":       "timeseries",
+			"title":      "Request rate",
+			"datasource": prometheus,
+			"gridPos":    map[string]interface{}{"x": 0, "y": 0, "w": 12, "h": 8},
+			"fieldConfig": map[string]interface{}{
+				"defaults": map[string]interface{}{"unit": "reqps"},
+			},
+			"targets": []interface{}{
+				map[string]interface{}{"refId": "A", "datasource": prometheus, "expr": requestRate},
+			},
+		},
+		map[string]interface{}{
+			"id":         2,
+			"type":       "timeseries",
+			"title":      "Error ratio",
+			"datasource": prometheus,
+			"gridPos":    map[string]interface{}{"x": 12, "y": 0, "w": 12, "h": 8},
+			"fieldConfig": map[string]interface{}{
+				"defaults": map[string]interface{}{"unit": "percentunit"},
+			},
+			"targets": []interface{}{
+				map[string]interface{}{"refId": "A", "datasource": prometheus, "expr": errorRatio},
+			},
+		},
+	}
+	if lokiUID := d.Get("loki_datasource_uid").(string); lokiUID != "" {
+		loki := map[string]interface{}{"type": "loki", "uid": lokiUID}
+		panels = append(panels, map[string]interface{}{
+			"id":         3,
+			"type":       "logs",
+			"title":      "Logs",
+			"datasource": loki,
+			"gridPos":    map[string]interface{}{"x": 0, "y": 8, "w": 24, "h": 12},
+			"targets": []interface{}{
+				map[string]interface{}{"refId": "A", "datasource": loki, "expr": serviceMonitoringSelector(d, "")},
+			},
+		})
+	}
+
+	dashboard := map[string]interface{}{
+		"title":  d.Get("service_name").(string) + " overview",
+		"tags":   []string{"service-monitoring"},
+		"time":   map[string]interface{}{"from": "now-6h", "to": "now"},
+		"panels": panels,
+	}
+	if uid := d.Get("dashboard_uid").(string); uid != "" {
+		dashboard["uid"] = uid
+	}
+	return dashboard
+}
+
+func serviceMonitoringSLO(d *schema.ResourceData, client *common.Client) slo.Slo {
+	name := d.Get("service_name").(string)
+	metric := d.Get("request_metric").(string)
+
+	return slo.Slo{
+		Name:        name + " availability",
+		Description: fmt.Sprintf("Ratio of the requests to %s that are not server errors.", name),
+		Query: slo.Query{
+			Type: QueryTypeRatio,
+			Ratio: &slo.RatioQuery{
+				SuccessMetric: slo.MetricDef{PrometheusMetric: serviceMonitoringSelector(d, metric, `code!~"5.."`)},
+				TotalMetric:   slo.MetricDef{PrometheusMetric: serviceMonitoringSelector(d, metric)},
+			},
+		},
+		Objectives: []slo.Objective{{
+			Value:  d.Get("slo_objective").(float64),
+			Window: d.Get("slo_window").(string),
+		}},
+		Labels: withDefaultLabels(client, []slo.Label{{Key: d.Get("service_label").(string), Value: name}}),
+	}
+}
+
+// serviceMonitoringAlertRule returns the error ratio alert rule of the service.
+// The UID of the existing rule is reused, so that its state and history are kept across updates.
+func serviceMonitoringAlertRule(d *schema.ResourceData, metaClient *common.Client, client *goapi.GrafanaHTTPAPI) (*models.ProvisionedAlertRule, error) {
+	name := d.Get("service_name").(string)
+	title := name + " error ratio"
+
+	uid := ""
+	group, err := client.Provisioning.GetAlertRuleGroup(serviceMonitoringRuleGroup, d.Id())
+	if err != nil && !common.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to read the alert rule group: %w", err)
+	}
+	if err == nil {
+		for _, r := range group.Payload.Rules {
+			if r.Title != nil && *r.Title == title {
+				uid = r.UID
+			}
+		}
+	}
+
+	_, errorRatio := serviceMonitoringQueries(d, "5m")
+	timeRange := &models.RelativeTimeRange{From: models.Duration(600), To: 0}
+	return &models.ProvisionedAlertRule{
+		UID:          uid,
+		Title:        common.Ref(title),
+		FolderUID:    common.Ref(d.Id()),
+		RuleGroup:    common.Ref(serviceMonitoringRuleGroup),
+		ExecErrState: common.Ref("Error"),
+		NoDataState:  common.Ref("OK"),
+		For:          common.Ref(strfmt.Duration(5 * time.Minute)),
+		Condition:    common.Ref("B"),
+		Data: []*models.AlertQuery{
+			{
+				RefID:             "A",
+				DatasourceUID:     d.Get("prometheus_datasource_uid").(string),
+				RelativeTimeRange: timeRange,
+				Model:             map[string]interface{}{"refId": "A", "expr": errorRatio, "instant": true},
+			},
+			{
+				RefID:             "B",
+				DatasourceUID:     "__expr__",
+				RelativeTimeRange: timeRange,
+				Model: map[string]interface{}{
+					"refId":      "B",
+					"type":       "math",
+					"expression": fmt.Sprintf("$A > %g", d.Get("error_ratio_threshold").(float64)),
+				},
+			},
+		},
+		Labels: metaClient.WithDefaultLabels(map[string]string{d.Get("service_label").(string): name}),
+		Annotations: map[string]string{
+			"summary": fmt.Sprintf("The error ratio of %s is above %g", name, d.Get("error_ratio_threshold").(float64)),
+		},
+	}, nil
+}
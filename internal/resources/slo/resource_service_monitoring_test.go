@@ -0,0 +1,95 @@
+package slo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceServiceMonitoring(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	serviceName := acctest.RandomWithPrefix("tf-service")
+	var folderUID, sloUUID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccServiceMonitoringCheckDestroy(&folderUID, &sloUUID),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_service_monitoring/resource.tf", map[string]string{
+					`"checkout"`: `"` + serviceName + `"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccServiceMonitoringCheckExists("grafana_service_monitoring.checkout", &folderUID, &sloUUID),
+					resource.TestCheckResourceAttr("grafana_service_monitoring.checkout", "service_name", serviceName),
+					resource.TestCheckResourceAttrPair("grafana_service_monitoring.checkout", "folder_uid", "grafana_service_monitoring.checkout", "id"),
+					resource.TestCheckResourceAttrSet("grafana_service_monitoring.checkout", "dashboard_uid"),
+					resource.TestCheckResourceAttrSet("grafana_service_monitoring.checkout", "dashboard_url"),
+					resource.TestCheckResourceAttrSet("grafana_service_monitoring.checkout", "slo_uuid"),
+					resource.TestCheckResourceAttr("grafana_service_monitoring.checkout", "rule_group_name", "Service monitoring"),
+					resource.TestCheckResourceAttr("grafana_service_monitoring.checkout", "request_metric", "http_requests_total"),
+					resource.TestCheckResourceAttr("grafana_service_monitoring.checkout", "slo_objective", "0.999"),
+				),
+			},
+			// Update the thresholds, the objects are updated in place
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_service_monitoring/resource.tf", map[string]string{
+					`"checkout"`: `"` + serviceName + `"`,
+					"0.999":      "0.99",
+					"0.02":       "0.1",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccServiceMonitoringCheckExists("grafana_service_monitoring.checkout", &folderUID, &sloUUID),
+					resource.TestCheckResourceAttrPtr("grafana_service_monitoring.checkout", "id", &folderUID),
+					resource.TestCheckResourceAttrPtr("grafana_service_monitoring.checkout", "slo_uuid", &sloUUID),
+					resource.TestCheckResourceAttr("grafana_service_monitoring.checkout", "slo_objective", "0.99"),
+					resource.TestCheckResourceAttr("grafana_service_monitoring.checkout", "error_ratio_threshold", "0.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceMonitoringCheckExists(rn string, folderUID, sloUUID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client)
+		if _, err := client.GrafanaOAPI.Folders.GetFolderByUID(rs.Primary.ID); err != nil {
+			return fmt.Errorf("error getting folder: %s", err)
+		}
+		if _, err := client.GrafanaOAPI.Provisioning.GetAlertRuleGroup("Service monitoring", rs.Primary.ID); err != nil {
+			return fmt.Errorf("error getting rule group: %s", err)
+		}
+		if _, _, err := client.SLOClient.DefaultAPI.V1SloIdGet(context.Background(), rs.Primary.Attributes["slo_uuid"]).Execute(); err != nil {
+			return fmt.Errorf("error getting SLO: %s", err)
+		}
+
+		*folderUID = rs.Primary.ID
+		*sloUUID = rs.Primary.Attributes["slo_uuid"]
+		return nil
+	}
+}
+
+func testAccServiceMonitoringCheckDestroy(folderUID, sloUUID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutils.Provider.Meta().(*common.Client)
+		if _, err := client.GrafanaOAPI.Folders.GetFolderByUID(*folderUID); err == nil {
+			return fmt.Errorf("folder %s still exists", *folderUID)
+		}
+		if _, _, err := client.SLOClient.DefaultAPI.V1SloIdGet(context.Background(), *sloUUID).Execute(); err == nil {
+			return fmt.Errorf("SLO %s still exists", *sloUUID)
+		}
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 
 	slo "github.com/grafana/slo-openapi-client/go"
 
@@ -36,6 +37,7 @@ Resource manages Grafana SLOs.
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: common.CustomizeDiffDefaultLabels,
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -126,6 +128,7 @@ Resource manages Grafana SLOs.
 				Description: `Additional labels that will be attached to all metrics generated from the query. These labels are useful for grouping SLOs in dashboard views that you create by hand. Labels must adhere to Prometheus label name schema - "^[a-zA-Z_][a-zA-Z0-9_]*$"`,
 				Elem:        keyvalueSchema,
 			},
+			common.AppliedDefaultLabelsAttribute: common.AppliedDefaultLabelsSchema(),
 			"objectives": &schema.Schema{
 				Type:        schema.TypeList,
 				Required:    true,
@@ -247,6 +250,8 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, m interface{
 		return diags
 	}
 
+	slo.Labels = withDefaultLabels(m.(*common.Client), slo.Labels)
+
 	client := m.(*common.Client).SLOClient
 	req := client.DefaultAPI.V1SloPost(ctx).Slo(slo)
 	response, _, err := req.Execute()
@@ -261,6 +266,7 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, m interface{
 	}
 
 	d.SetId(response.Uuid)
+	m.(*common.Client).SetAppliedDefaultLabels(d)
 	resourceSloRead(ctx, d, m)
 
 	return resourceSloRead(ctx, d, m)
@@ -285,6 +291,7 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		return diags
 	}
 
+	slo.Labels = withoutDefaultLabels(m.(*common.Client), slo.Labels, packLabels(d.Get("label").([]interface{})))
 	setTerraformState(d, *slo)
 
 	return diags
@@ -294,7 +301,7 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 	var diags diag.Diagnostics
 	sloID := d.Id()
 
-	if d.HasChange("name") || d.HasChange("description") || d.HasChange("query") || d.HasChange("label") || d.HasChange("objectives") || d.HasChange("alerting") || d.HasChange("destination_datasource") {
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("query") || d.HasChange("label") || d.HasChange("objectives") || d.HasChange("alerting") || d.HasChange("destination_datasource") || d.HasChange(common.AppliedDefaultLabelsAttribute) {
 		slo, err := packSloResource(d)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -305,6 +312,8 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 			return diags
 		}
 
+		slo.Labels = withDefaultLabels(m.(*common.Client), slo.Labels)
+
 		client := m.(*common.Client).SLOClient
 
 		req := client.DefaultAPI.V1SloIdPut(ctx, sloID).Slo(slo)
@@ -316,6 +325,7 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 			})
 			return diags
 		}
+		m.(*common.Client).SetAppliedDefaultLabels(d)
 	}
 
 	return resourceSloRead(ctx, d, m)
@@ -469,6 +479,49 @@ func packObjectives(tfobjectives []interface{}) []slo.Objective {
 	return objectives
 }
 
+// withDefaultLabels appends the provider's default labels that aren't already set on the SLO, sorted by key.
+func withDefaultLabels(client *common.Client, labels []slo.Label) []slo.Label {
+	set := map[string]string{}
+	for _, l := range labels {
+		set[l.Key] = l.Value
+	}
+
+	merged := client.WithDefaultLabels(set)
+	keys := make([]string, 0, len(merged))
+	for k := range merged {
+		if _, ok := set[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		labels = append(labels, slo.Label{Key: k, Value: merged[k]})
+	}
+	return labels
+}
+
+// withoutDefaultLabels removes the provider's default labels from the labels read from the API, unless they are in the prior state.
+func withoutDefaultLabels(client *common.Client, labels []slo.Label, prior []slo.Label) []slo.Label {
+	set := map[string]string{}
+	for _, l := range labels {
+		set[l.Key] = l.Value
+	}
+	priorSet := map[string]string{}
+	for _, l := range prior {
+		priorSet[l.Key] = l.Value
+	}
+
+	kept := client.WithoutDefaultLabels(set, priorSet)
+	result := []slo.Label{}
+	for _, l := range labels {
+		if _, ok := kept[l.Key]; ok {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
 func packLabels(tfLabels []interface{}) []slo.Label {
 	labelSlice := []slo.Label{}
 
@@ -10,7 +10,7 @@ import (
 
 func DataSourceProbes() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for retrieving all probes.",
+		Description: "Data source for retrieving all probes: the public probes run by Grafana Labs and the private probes of the stack.",
 		ReadContext: DataSourceProbesRead,
 		Schema: map[string]*schema.Schema{
 			"filter_deprecated": {
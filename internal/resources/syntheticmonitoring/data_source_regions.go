@@ -0,0 +1,122 @@
+package syntheticmonitoring
+
+import (
+	"context"
+	"sort"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRegions() *schema.Resource {
+	probesMap := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description: description,
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Description: `
+Data source for retrieving the regions of the probes available to the stack, with the public probes run by Grafana Labs and the private probes of the stack in each region.
+Checks can select their probes by region, e.g. to only run from regions with online private probes.
+`,
+		ReadContext: DataSourceRegionsRead,
+		Schema: map[string]*schema.Schema{
+			"filter_deprecated": {
+				Type:        schema.TypeBool,
+				Description: "If true, only probes that are not deprecated will be returned.",
+				Optional:    true,
+				Default:     true,
+			},
+			"names": {
+				Description: "The names of the regions, sorted alphabetically.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"regions": {
+				Description: "The regions, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the region.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"probes":         probesMap("Map of the probes of the region with their names as keys and IDs as values."),
+						"public_probes":  probesMap("Map of the public probes of the region with their names as keys and IDs as values."),
+						"private_probes": probesMap("Map of the private probes of the region with their names as keys and IDs as values."),
+						"online_probes":  probesMap("Map of the probes of the region that are online with their names as keys and IDs as values."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*common.Client).SMAPI
+	prbs, err := c.ListProbes(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regions := map[string]map[string]map[string]interface{}{}
+	for _, p := range prbs {
+		if p.Deprecated && d.Get("filter_deprecated").(bool) {
+			continue
+		}
+		region, ok := regions[p.Region]
+		if !ok {
+			region = map[string]map[string]interface{}{
+				"probes":         {},
+				"public_probes":  {},
+				"private_probes": {},
+				"online_probes":  {},
+			}
+			regions[p.Region] = region
+		}
+		region["probes"][p.Name] = p.Id
+		if p.Public {
+			region["public_probes"][p.Name] = p.Id
+		} else {
+			region["private_probes"][p.Name] = p.Id
+		}
+		if p.Online {
+			region["online_probes"][p.Name] = p.Id
+		}
+	}
+
+	names := make([]string, 0, len(regions))
+	for name := range regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	packed := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		packed = append(packed, map[string]interface{}{
+			"name":           name,
+			"probes":         regions[name]["probes"],
+			"public_probes":  regions[name]["public_probes"],
+			"private_probes": regions[name]["private_probes"],
+			"online_probes":  regions[name]["online_probes"],
+		})
+	}
+
+	d.SetId("regions")
+	d.Set("names", names)
+	d.Set("regions", packed)
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package syntheticmonitoring_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRegions(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_synthetic_monitoring_regions/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.grafana_synthetic_monitoring_regions.main", "names.*", "AMER"),
+					resource.TestCheckResourceAttr("data.grafana_synthetic_monitoring_regions.main", "regions.0.name", "AMER"),
+					resource.TestCheckResourceAttrSet("data.grafana_synthetic_monitoring_regions.main", "regions.0.public_probes.Atlanta"),
+				),
+			},
+		},
+	})
+}
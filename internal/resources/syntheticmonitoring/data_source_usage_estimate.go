@@ -0,0 +1,118 @@
+package syntheticmonitoring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// minutesPerMonth is the length of a month used by Grafana Cloud to bill Synthetic Monitoring executions (730 hours).
+const minutesPerMonth = 43800
+
+func DataSourceUsageEstimate() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Estimates the monthly usage of a set of Synthetic Monitoring checks, before they are applied.
+Each check is executed once per period (` + "`frequency`" + `) on each of its probes. Grafana Cloud bills these executions, along with the active series the checks produce.
+
+The estimate is computed by the provider, it doesn't call the Synthetic Monitoring API.
+The attributes of planned checks can be passed directly, as long as they are known at plan time (the probes of checks using ` + "`probe_selector`" + ` are only known after apply).
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/monitor-public-endpoints/checks/)
+`,
+		ReadContext: DataSourceUsageEstimateRead,
+		Schema: map[string]*schema.Schema{
+			"check": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The checks to estimate the usage of.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A name to identify the check in the estimate, e.g. its job.",
+						},
+						"frequency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60000,
+							ValidateFunc: validation.IntBetween(1000, 120000),
+							Description:  "How often the check runs in milliseconds, as in the `grafana_synthetic_monitoring_check` resource.",
+						},
+						"probes": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Description: "IDs of the probes the check runs from, as in the `grafana_synthetic_monitoring_check` resource.",
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"active_series_per_probe": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of active series the check produces on each probe. It depends on the check type and settings (e.g. `basic_metrics_only`), and can be read from the usage of a similar check in the Synthetic Monitoring app. If unset, the active series aren't estimated.",
+						},
+						"executions_per_month": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The estimated number of executions of the check per month.",
+						},
+						"active_series": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The estimated number of active series produced by the check.",
+						},
+					},
+				},
+			},
+			"executions_per_month": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The estimated number of executions of all the checks per month.",
+			},
+			"active_series": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The estimated number of active series produced by all the checks.",
+			},
+		},
+	}
+}
+
+func DataSourceUsageEstimateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	checks := d.Get("check").([]interface{})
+
+	var totalExecutions, totalSeries int
+	for _, c := range checks {
+		check := c.(map[string]interface{})
+		probes := check["probes"].(*schema.Set).Len()
+		executions, series := EstimateCheckUsage(check["frequency"].(int), probes, check["active_series_per_probe"].(int))
+
+		check["executions_per_month"] = executions
+		check["active_series"] = series
+		totalExecutions += executions
+		totalSeries += series
+	}
+
+	d.SetId(fmt.Sprintf("%d-%d", totalExecutions, totalSeries))
+	d.Set("check", checks)
+	d.Set("executions_per_month", totalExecutions)
+	d.Set("active_series", totalSeries)
+
+	return nil
+}
+
+// EstimateCheckUsage returns the monthly executions and the active series of a check running every `frequency` milliseconds on the given number of probes.
+func EstimateCheckUsage(frequency, probes, activeSeriesPerProbe int) (executionsPerMonth, activeSeries int) {
+	executionsPerMonth = probes * minutesPerMonth * 60 * 1000 / frequency
+	activeSeries = probes * activeSeriesPerProbe
+	return executionsPerMonth, activeSeries
+}
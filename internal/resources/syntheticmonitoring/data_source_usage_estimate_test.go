@@ -0,0 +1,49 @@
+package syntheticmonitoring_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/resources/syntheticmonitoring"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestEstimateCheckUsage(t *testing.T) {
+	testutils.IsUnitTest(t)
+
+	for _, tc := range []struct {
+		frequency, probes, seriesPerProbe int
+		executions, series                int
+	}{
+		{frequency: 60000, probes: 1, executions: 43800},
+		{frequency: 60000, probes: 2, seriesPerProbe: 60, executions: 87600, series: 120},
+		{frequency: 10000, probes: 3, seriesPerProbe: 10, executions: 788400, series: 30},
+		{frequency: 120000, probes: 1, executions: 21900},
+	} {
+		executions, series := syntheticmonitoring.EstimateCheckUsage(tc.frequency, tc.probes, tc.seriesPerProbe)
+		if executions != tc.executions || series != tc.series {
+			t.Errorf("frequency %d, %d probes: expected %d executions and %d series, got %d and %d", tc.frequency, tc.probes, tc.executions, tc.series, executions, series)
+		}
+	}
+}
+
+func TestAccDataSourceUsageEstimate(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_synthetic_monitoring_usage_estimate/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_synthetic_monitoring_usage_estimate.checks", "check.0.executions_per_month", "87600"),
+					resource.TestCheckResourceAttr("data.grafana_synthetic_monitoring_usage_estimate.checks", "check.0.active_series", "120"),
+					resource.TestCheckResourceAttr("data.grafana_synthetic_monitoring_usage_estimate.checks", "check.1.executions_per_month", "262800"),
+					resource.TestCheckResourceAttr("data.grafana_synthetic_monitoring_usage_estimate.checks", "check.1.active_series", "0"),
+					resource.TestCheckResourceAttr("data.grafana_synthetic_monitoring_usage_estimate.checks", "executions_per_month", "350400"),
+					resource.TestCheckResourceAttr("data.grafana_synthetic_monitoring_usage_estimate.checks", "active_series", "120"),
+				),
+			},
+		},
+	})
+}
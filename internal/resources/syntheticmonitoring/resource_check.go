@@ -5,14 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sm "github.com/grafana/synthetic-monitoring-agent/pkg/pb/synthetic_monitoring"
+	smapi "github.com/grafana/synthetic-monitoring-api-go-client"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 )
 
@@ -639,7 +643,7 @@ multiple checks for a single endpoint to check different capabilities.
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: ResourceCheckCustomizeDiff,
+		CustomizeDiff: customdiff.All(ResourceCheckCustomizeDiff, common.CustomizeDiffDefaultLabels),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -697,13 +701,45 @@ multiple checks for a single endpoint to check different capabilities.
 				Default:  true,
 			},
 			"probes": {
-				Description: "List of probe location IDs where this target will be checked from.",
-				Type:        schema.TypeSet,
-				Required:    true,
+				Description:  "List of probe location IDs where this target will be checked from. Computed when `probe_selector` is set.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"probes", "probe_selector"},
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
 			},
+			"probe_selector": {
+				Description: "Selects the probes to run the check from by region and labels, instead of listing their IDs in `probes`. " +
+					"Deprecated probes are never selected. The selection is resolved when the check is created or the selector changes.",
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Description: "Only select probes in this region. For example, `EMEA` or `AMER`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"labels": {
+							Description: "Only select probes that have all of these labels.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"refresh": {
+							Description: "Resolve the selection again on every plan, so that probes added or removed since the last apply are picked up.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
 			"labels": {
 				Description: "Custom labels to be included with collected metrics and logs. " +
 					"The maximum number of labels that can be specified per check is 5. " +
@@ -715,6 +751,7 @@ multiple checks for a single endpoint to check different capabilities.
 					Type: schema.TypeString,
 				},
 			},
+			common.AppliedDefaultLabelsAttribute: common.AppliedDefaultLabelsSchema(),
 			"settings": {
 				Description: "Check settings. Should contain exactly one nested block.",
 				Type:        schema.TypeSet,
@@ -728,7 +765,7 @@ multiple checks for a single endpoint to check different capabilities.
 
 func ResourceCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*common.Client).SMAPI
-	chk, err := makeCheck(d)
+	chk, err := makeCheck(ctx, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -738,6 +775,7 @@ func ResourceCheckCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	d.SetId(strconv.FormatInt(res.Id, 10))
 	d.Set("tenant_id", res.TenantId)
+	meta.(*common.Client).SetAppliedDefaultLabels(d)
 	return ResourceCheckRead(ctx, d, meta)
 }
 
@@ -773,7 +811,8 @@ func ResourceCheckRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		for _, l := range chk.Labels {
 			labels[l.Name] = l.Value
 		}
-		d.Set("labels", labels)
+		priorLabels := common.InterfaceMapToStringMap(d.Get("labels").(map[string]interface{}))
+		d.Set("labels", meta.(*common.Client).WithoutDefaultLabels(labels, priorLabels))
 	}
 
 	// Convert sm.Settings...
@@ -1044,7 +1083,7 @@ func ResourceCheckRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 func ResourceCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*common.Client).SMAPI
-	chk, err := makeCheck(d)
+	chk, err := makeCheck(ctx, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1052,6 +1091,7 @@ func ResourceCheckUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*common.Client).SetAppliedDefaultLabels(d)
 	return ResourceCheckRead(ctx, d, meta)
 }
 
@@ -1069,7 +1109,7 @@ func ResourceCheckDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 // makeCheck populates an instance of sm.Check. We need this for create and
 // update calls with the SM API client.
-func makeCheck(d *schema.ResourceData) (*sm.Check, error) {
+func makeCheck(ctx context.Context, d *schema.ResourceData, meta interface{}) (*sm.Check, error) {
 	var id int64
 	if d.Id() != "" {
 		id, _ = strconv.ParseInt(d.Id(), 10, 64)
@@ -1079,12 +1119,18 @@ func makeCheck(d *schema.ResourceData) (*sm.Check, error) {
 	for _, p := range d.Get("probes").(*schema.Set).List() {
 		probes = append(probes, int64(p.(int)))
 	}
+	if selector, ok := d.Get("probe_selector").([]interface{}); ok && len(selector) > 0 && selector[0] != nil && (len(probes) == 0 || d.HasChange("probe_selector")) {
+		var err error
+		if probes, err = resolveProbeSelector(ctx, meta.(*common.Client).SMAPI, selector[0].(map[string]interface{})); err != nil {
+			return nil, err
+		}
+	}
 
 	var labels []sm.Label
-	for name, value := range d.Get("labels").(map[string]interface{}) {
+	for name, value := range meta.(*common.Client).WithDefaultLabels(common.InterfaceMapToStringMap(d.Get("labels").(map[string]interface{}))) {
 		labels = append(labels, sm.Label{
 			Name:  name,
-			Value: value.(string),
+			Value: value,
 		})
 	}
 
@@ -1472,5 +1518,68 @@ func ResourceCheckCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 		return fmt.Errorf("exactly one check setting must be defined, got %d", count)
 	}
 
+	return customizeDiffProbeSelector(ctx, diff, meta)
+}
+
+// customizeDiffProbeSelector marks the probes as unknown until apply when the probe selector is new or changed.
+// When the selector's `refresh` flag is set, the selection is resolved again and the probes are updated if it changed.
+func customizeDiffProbeSelector(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	selector, ok := diff.Get("probe_selector").([]interface{})
+	if !ok || len(selector) == 0 || selector[0] == nil {
+		return nil
+	}
+
+	if diff.Id() == "" || diff.HasChange("probe_selector") {
+		return diff.SetNewComputed("probes")
+	}
+
+	if selector[0].(map[string]interface{})["refresh"].(bool) {
+		probes, err := resolveProbeSelector(ctx, meta.(*common.Client).SMAPI, selector[0].(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		current := common.SetToIntSlice[int64](diff.Get("probes").(*schema.Set))
+		if !reflect.DeepEqual(sortedInt64s(current), probes) {
+			return diff.SetNew("probes", probes)
+		}
+	}
+
 	return nil
 }
+
+// resolveProbeSelector returns the sorted IDs of the probes matching the given `probe_selector` block.
+func resolveProbeSelector(ctx context.Context, c *smapi.Client, selector map[string]interface{}) ([]int64, error) {
+	probes, err := c.ListProbes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	region := selector["region"].(string)
+	labels := selector["labels"].(map[string]interface{})
+
+	var ids []int64
+	for _, probe := range probes {
+		if probe.Deprecated || (region != "" && !strings.EqualFold(probe.Region, region)) {
+			continue
+		}
+		matches := 0
+		for _, l := range probe.Labels {
+			if v, ok := labels[l.Name]; ok && v.(string) == l.Value {
+				matches++
+			}
+		}
+		if matches == len(labels) {
+			ids = append(ids, probe.Id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no probes match the probe selector (region: %q, labels: %v)", region, labels)
+	}
+	return sortedInt64s(ids), nil
+}
+
+func sortedInt64s(s []int64) []int64 {
+	sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+	return s
+}
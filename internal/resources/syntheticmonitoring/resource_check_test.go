@@ -181,6 +181,38 @@ func TestAccResourceCheck_ping(t *testing.T) {
 	})
 }
 
+func TestAccResourceCheck_probeSelector(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	// Inject random job names to avoid conflicts with other tests
+	jobName := acctest.RandomWithPrefix("ping")
+	nameReplaceMap := map[string]string{
+		`"Ping Probe Selector"`: strconv.Quote(jobName),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_synthetic_monitoring_check/ping_probe_selector.tf", nameReplaceMap),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafana_synthetic_monitoring_check.ping", "id"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_check.ping", "job", jobName),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_check.ping", "probe_selector.0.region", "EMEA"),
+					resource.TestCheckResourceAttrSet("grafana_synthetic_monitoring_check.ping", "probes.0"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_synthetic_monitoring_check/ping_probe_selector.tf", map[string]string{
+					`"Ping Probe Selector"`: strconv.Quote(jobName),
+					`"EMEA"`:                `"does-not-exist"`,
+				}),
+				ExpectError: regexp.MustCompile(`no probes match the probe selector`),
+			},
+		},
+	})
+}
+
 func TestAccResourceCheck_tcp(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t)
 
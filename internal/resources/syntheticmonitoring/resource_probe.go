@@ -5,12 +5,14 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sm "github.com/grafana/synthetic-monitoring-agent/pkg/pb/synthetic_monitoring"
 	"github.com/grafana/terraform-provider-grafana/internal/common"
@@ -35,6 +37,7 @@ Grafana Synthetic Monitoring Agent.
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportProbeStateWithToken,
 		},
+		CustomizeDiff: validateProbeRegion,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -62,16 +65,18 @@ Grafana Synthetic Monitoring Agent.
 				Description:      "Latitude coordinates.",
 				Type:             schema.TypeFloat,
 				Required:         true,
+				ValidateFunc:     validation.FloatBetween(-90, 90),
 				DiffSuppressFunc: common.SchemaDiffFloat32,
 			},
 			"longitude": {
 				Description:      "Longitude coordinates.",
 				Type:             schema.TypeFloat,
 				Required:         true,
+				ValidateFunc:     validation.FloatBetween(-180, 180),
 				DiffSuppressFunc: common.SchemaDiffFloat32,
 			},
 			"region": {
-				Description: "Region of the probe.",
+				Description: "Region of the probe. Must be one of the regions of the public probes, e.g. `AMER`, `EMEA` or `APAC`.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
@@ -173,6 +178,44 @@ func ResourceProbeDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// validateProbeRegion checks that the region of the probe is known by the Synthetic Monitoring API: the regions are those of the public probes.
+// The regions of deprecated public probes are still accepted, so that existing probes aren't invalidated when the public probes of their region are retired.
+func validateProbeRegion(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("region") || !d.NewValueKnown("region") {
+		return nil
+	}
+	region := d.Get("region").(string)
+
+	c := meta.(*common.Client).SMAPI
+	if c == nil {
+		return nil
+	}
+	prbs, err := c.ListProbes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list the probes to validate the region: %w", err)
+	}
+
+	regions := map[string]struct{}{}
+	for _, p := range prbs {
+		if p.Public {
+			regions[p.Region] = struct{}{}
+		}
+	}
+	if len(regions) == 0 {
+		// No catalog to validate against
+		return nil
+	}
+	if _, ok := regions[region]; !ok {
+		known := make([]string, 0, len(regions))
+		for r := range regions {
+			known = append(known, r)
+		}
+		sort.Strings(known)
+		return fmt.Errorf("invalid region %q, must be one of: %s", region, strings.Join(known, ", "))
+	}
+	return nil
+}
+
 // makeProbe populates an instance of sm.Probe. We need this for create and
 // update calls with the SM API client.
 func makeProbe(d *schema.ResourceData) *sm.Probe {
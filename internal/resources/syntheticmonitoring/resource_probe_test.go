@@ -127,6 +127,50 @@ func TestAccResourceProbe_InvalidLabels(t *testing.T) {
 	})
 }
 
+func TestAccResourceProbe_InvalidLocation(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	var steps []resource.TestStep
+	for _, tc := range []struct {
+		cfg string
+		err string
+	}{
+		{
+			cfg: testSyntheticMonitoringProbeLocation(91, 86.92262, "APAC"),
+			err: `expected latitude to be in the range \(-90.000000 - 90.000000\)`,
+		},
+		{
+			cfg: testSyntheticMonitoringProbeLocation(27.98606, -181, "APAC"),
+			err: `expected longitude to be in the range \(-180.000000 - 180.000000\)`,
+		},
+		{
+			cfg: testSyntheticMonitoringProbeLocation(27.98606, 86.92262, "Himalaya"),
+			err: `invalid region "Himalaya", must be one of: .*APAC`,
+		},
+	} {
+		steps = append(steps, resource.TestStep{
+			Config:      tc.cfg,
+			ExpectError: regexp.MustCompile(tc.err),
+		})
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps:             steps,
+	})
+}
+
+func testSyntheticMonitoringProbeLocation(latitude, longitude float64, region string) string {
+	return fmt.Sprintf(`
+resource "grafana_synthetic_monitoring_probe" "main" {
+	name      = "Everest"
+	latitude  = %f
+	longitude = %f
+	region    = "%s"
+}
+`, latitude, longitude, region)
+}
+
 func testSyntheticMonitoringProbeLabel(name, value string) string {
 	return fmt.Sprintf(`
 resource "grafana_synthetic_monitoring_probe" "main" {
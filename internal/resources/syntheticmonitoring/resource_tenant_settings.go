@@ -0,0 +1,182 @@
+package syntheticmonitoring
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	SMAPI "github.com/grafana/synthetic-monitoring-api-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// tenantSettings are the settings of a Synthetic Monitoring tenant. The API client doesn't implement the settings endpoints.
+type tenantSettings struct {
+	Thresholds tenantThresholds `json:"thresholds"`
+}
+
+type tenantThresholds struct {
+	Uptime       tenantThreshold `json:"uptime"`
+	Reachability tenantThreshold `json:"reachability"`
+	Latency      tenantThreshold `json:"latency"`
+}
+
+type tenantThreshold struct {
+	UpperLimit float64 `json:"upperLimit"`
+	LowerLimit float64 `json:"lowerLimit"`
+}
+
+func ResourceTenantSettings() *schema.Resource {
+	thresholdSchema := func(description string, limit schema.SchemaValidateFunc) *schema.Schema {
+		return &schema.Schema{
+			Description: description,
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"upper_limit": {
+						Description:  "The upper limit of the values shown as degraded. Must be greater than or equal to `lower_limit`.",
+						Type:         schema.TypeFloat,
+						Required:     true,
+						ValidateFunc: limit,
+					},
+					"lower_limit": {
+						Description:  "The lower limit of the values shown as degraded.",
+						Type:         schema.TypeFloat,
+						Required:     true,
+						ValidateFunc: limit,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+
+		Description: `
+Manages the settings of the Synthetic Monitoring tenant of the stack: the thresholds used by the Synthetic Monitoring app
+to show the uptime, reachability and latency of the checks as healthy, degraded or failing.
+
+There is a single settings object per tenant. Destroying this resource removes it from the state, but leaves the settings as they are.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/monitor-public-endpoints/)
+`,
+
+		CreateContext: ResourceTenantSettingsUpdate,
+		ReadContext:   ResourceTenantSettingsRead,
+		UpdateContext: ResourceTenantSettingsUpdate,
+		DeleteContext: ResourceTenantSettingsDelete,
+		CustomizeDiff: validateTenantThresholds,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"thresholds": {
+				Description: "The thresholds of the checks shown in the Synthetic Monitoring app.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uptime":       thresholdSchema("Thresholds of the uptime of the checks, in percent. Values above the upper limit are shown as healthy, and values below the lower limit as failing.", validation.FloatBetween(0, 100)),
+						"reachability": thresholdSchema("Thresholds of the reachability of the checks, in percent. Values above the upper limit are shown as healthy, and values below the lower limit as failing.", validation.FloatBetween(0, 100)),
+						"latency":      thresholdSchema("Thresholds of the latency of the checks, in milliseconds. Lower is healthier: values below the lower limit are shown as healthy, and values above the upper limit as failing.", validation.FloatAtLeast(0)),
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceTenantSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*common.Client).SMAPI
+	resp, err := c.Get(ctx, "/tenant/settings", true, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("sending get tenant settings request: %w", err))
+	}
+	var settings tenantSettings
+	if err := SMAPI.ValidateResponse("get tenant settings request", resp, &settings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("thresholds", []interface{}{map[string]interface{}{
+		"uptime":       packTenantThreshold(settings.Thresholds.Uptime),
+		"reachability": packTenantThreshold(settings.Thresholds.Reachability),
+		"latency":      packTenantThreshold(settings.Thresholds.Latency),
+	}})
+	return nil
+}
+
+func ResourceTenantSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*common.Client).SMAPI
+	thresholds := d.Get("thresholds").([]interface{})[0].(map[string]interface{})
+	settings := tenantSettings{
+		Thresholds: tenantThresholds{
+			Uptime:       unpackTenantThreshold(thresholds["uptime"]),
+			Reachability: unpackTenantThreshold(thresholds["reachability"]),
+			Latency:      unpackTenantThreshold(thresholds["latency"]),
+		},
+	}
+
+	resp, err := c.PostJSON(ctx, "/tenant/settings/update", true, &settings)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("sending tenant settings update request: %w", err))
+	}
+	var result struct {
+		Msg string `json:"msg"`
+	}
+	if err := SMAPI.ValidateResponse("tenant settings update request", resp, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Id() == "" {
+		tenant, err := c.GetTenant(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(strconv.FormatInt(tenant.Id, 10))
+	}
+	return ResourceTenantSettingsRead(ctx, d, meta)
+}
+
+func ResourceTenantSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] removing the settings of tenant %s from state, they are left as they are in Synthetic Monitoring", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// validateTenantThresholds checks that the lower limit of each threshold isn't above its upper limit, which the API doesn't reject.
+func validateTenantThresholds(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, name := range []string{"uptime", "reachability", "latency"} {
+		upperKey, lowerKey := "thresholds.0."+name+".0.upper_limit", "thresholds.0."+name+".0.lower_limit"
+		if !d.NewValueKnown(upperKey) || !d.NewValueKnown(lowerKey) {
+			continue
+		}
+		upper, lower := d.Get(upperKey).(float64), d.Get(lowerKey).(float64)
+		if lower > upper {
+			return fmt.Errorf("the lower limit of the %s thresholds (%v) must be less than or equal to their upper limit (%v)", name, lower, upper)
+		}
+	}
+	return nil
+}
+
+func packTenantThreshold(t tenantThreshold) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"upper_limit": t.UpperLimit,
+		"lower_limit": t.LowerLimit,
+	}}
+}
+
+func unpackTenantThreshold(raw interface{}) tenantThreshold {
+	t := raw.([]interface{})[0].(map[string]interface{})
+	return tenantThreshold{
+		UpperLimit: t["upper_limit"].(float64),
+		LowerLimit: t["lower_limit"].(float64),
+	}
+}
@@ -0,0 +1,43 @@
+package syntheticmonitoring_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTenantSettings(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	config := testutils.TestAccExample(t, "resources/grafana_synthetic_monitoring_tenant_settings/resource.tf")
+
+	// The settings are shared by all the tests of the tenant
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafana_synthetic_monitoring_tenant_settings.main", "id"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_tenant_settings.main", "thresholds.0.uptime.0.upper_limit", "99"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_tenant_settings.main", "thresholds.0.uptime.0.lower_limit", "95"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_tenant_settings.main", "thresholds.0.reachability.0.lower_limit", "90"),
+					resource.TestCheckResourceAttr("grafana_synthetic_monitoring_tenant_settings.main", "thresholds.0.latency.0.upper_limit", "1000"),
+				),
+			},
+			{
+				// The lower limit of the uptime is above its upper limit
+				Config:      strings.Replace(config, "lower_limit = 95", "lower_limit = 100", 1),
+				ExpectError: regexp.MustCompile(`the lower limit of the uptime thresholds \(100\) must be less than or equal to their upper limit \(99\)`),
+			},
+			{
+				ResourceName:      "grafana_synthetic_monitoring_tenant_settings.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
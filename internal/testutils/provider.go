@@ -10,8 +10,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/Masterminds/semver/v3"
 	"github.com/grafana/terraform-provider-grafana/internal/provider"
+	"github.com/grafana/terraform-provider-grafana/pkg/grafanatest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -180,16 +180,5 @@ func checkSemverConstraint(t *testing.T, semverConstraintOptional ...string) {
 		return
 	}
 
-	semverConstraint := semverConstraintOptional[0]
-	versionStr := os.Getenv("GRAFANA_VERSION")
-	if semverConstraint != "" && versionStr != "" {
-		version := semver.MustParse(versionStr)
-		c, err := semver.NewConstraint(semverConstraint)
-		if err != nil {
-			t.Fatalf("invalid constraint %s: %v", semverConstraint, err)
-		}
-		if !c.Check(version) {
-			t.Skipf("skipping test for Grafana version `%s`, constraint `%s`", versionStr, semverConstraint)
-		}
-	}
+	grafanatest.CheckVersion(t, semverConstraintOptional[0])
 }
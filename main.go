@@ -4,13 +4,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	// The time zones of the alerting and preferences resources are validated against the IANA database, which isn't installed everywhere, e.g. on Windows
+	_ "time/tzdata"
 
 	"github.com/grafana/terraform-provider-grafana/internal/provider"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
-	"github.com/hashicorp/terraform-plugin-mux/tf6to5server"
 )
 
 //go:generate ./tools/generate-docs.sh
@@ -21,8 +19,6 @@ var (
 	version string = "dev"
 )
 
-// Mux config taken here: https://developer.hashicorp.com/terraform/plugin/framework/migrating/mux#terraform-0-12-compatibility-example
-// While not every resource is migrated to the Terraform Plugin Framework, we must mux the old and new providers together.
 func main() {
 	ctx := context.Background()
 
@@ -31,23 +27,7 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	// While we still have the SDK2 provider, we have to use the provider v5 protocol
-	// See https://developer.hashicorp.com/terraform/plugin/mux/translating-protocol-version-6-to-5
-	downgradedFrameworkProvider, err := tf6to5server.DowngradeServer(
-		context.Background(),
-		providerserver.NewProtocol6(provider.FrameworkProvider(version)),
-	)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	providers := []func() tfprotov5.ProviderServer{
-		func() tfprotov5.ProviderServer {
-			return downgradedFrameworkProvider
-		},
-		provider.Provider(version).GRPCProvider,
-	}
-	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	providerServer, err := provider.MakeProviderServer(ctx, version)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -60,7 +40,7 @@ func main() {
 
 	err = tf5server.Serve(
 		"registry.terraform.io/grafana/grafana",
-		muxServer.ProviderServer,
+		providerServer,
 		serveOpts...,
 	)
 
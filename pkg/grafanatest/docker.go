@@ -0,0 +1,117 @@
+package grafanatest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	defaultGrafanaImage   = "grafana/grafana"
+	grafanaStartupTimeout = 2 * time.Minute
+)
+
+// GrafanaOptions are the options of the Grafana container started by StartGrafana.
+type GrafanaOptions struct {
+	// Image is the Docker image of Grafana. Defaults to `grafana/grafana`, use `grafana/grafana-enterprise` for Enterprise features.
+	Image string
+	// Version is the tag of the image. Defaults to GRAFANA_VERSION, or to `latest` if it isn't set.
+	Version string
+	// Env are the environment variables of the container, e.g. `GF_FEATURE_TOGGLES_ENABLE`.
+	Env map[string]string
+}
+
+// StartGrafana starts a Grafana container with Docker, removed at the end of the test, and waits for it to be healthy,
+// like the `docker-compose.yml` of the provider's acceptance tests.
+// It sets GRAFANA_URL, GRAFANA_AUTH (the basic auth of the `admin` user) and GRAFANA_VERSION for the test, so it can't be used in parallel tests.
+// The test is skipped unless TF_ACC is set, without starting the container.
+func StartGrafana(t *testing.T, opts GrafanaOptions) {
+	t.Helper()
+	skipUnlessAcceptanceTests(t)
+
+	image := opts.Image
+	if image == "" {
+		image = defaultGrafanaImage
+	}
+	version := opts.Version
+	if version == "" {
+		version = os.Getenv("GRAFANA_VERSION")
+	}
+	if version == "" {
+		version = "latest"
+	}
+
+	args := []string{"run", "--detach", "--publish", "127.0.0.1::3000"}
+	for k, v := range opts.Env {
+		args = append(args, "--env", k+"="+v)
+	}
+	containerID, err := docker(append(args, image+":"+version)...)
+	if err != nil {
+		t.Fatalf("failed to start Grafana: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := docker("rm", "--force", "--volumes", containerID); err != nil {
+			t.Errorf("failed to remove the Grafana container: %v", err)
+		}
+	})
+
+	address, err := docker("port", containerID, "3000/tcp")
+	if err != nil {
+		t.Fatalf("failed to get the port of Grafana: %v", err)
+	}
+	// The port may be published on IPv4 and IPv6, one per line
+	grafanaURL := "http://" + strings.Split(address, "\n")[0]
+
+	runningVersion, err := waitForGrafana(grafanaURL)
+	if err != nil {
+		logs, _ := docker("logs", "--tail", "50", containerID)
+		t.Fatalf("%v, logs of the container:\n%s", err, logs)
+	}
+
+	t.Setenv("GRAFANA_URL", grafanaURL)
+	t.Setenv("GRAFANA_AUTH", "admin:admin")
+	t.Setenv("GRAFANA_VERSION", runningVersion)
+}
+
+// waitForGrafana polls the health endpoint of Grafana until it's healthy, and returns the version of Grafana.
+func waitForGrafana(grafanaURL string) (string, error) {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	deadline := time.Now().Add(grafanaStartupTimeout)
+	var lastErr error
+	for time.Now().Before(deadline) {
+		resp, err := httpClient.Get(grafanaURL + "/api/health")
+		if err == nil {
+			var health struct {
+				Database string `json:"database"`
+				Version  string `json:"version"`
+			}
+			err = json.NewDecoder(resp.Body).Decode(&health)
+			resp.Body.Close()
+			if err == nil && resp.StatusCode == http.StatusOK && health.Database == "ok" {
+				return health.Version, nil
+			}
+			if err == nil {
+				err = fmt.Errorf("status %d, database %q", resp.StatusCode, health.Database)
+			}
+		}
+		lastErr = err
+		time.Sleep(time.Second)
+	}
+	return "", fmt.Errorf("the Grafana container isn't healthy after %s: %v", grafanaStartupTimeout, lastErr)
+}
+
+func docker(args ...string) (string, error) {
+	out, err := exec.Command("docker", args...).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("docker %s: %w: %s", args[0], err, exitErr.Stderr)
+		}
+		return "", fmt.Errorf("docker %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,103 @@
+// Package grafanatest provides the scaffolding of the acceptance tests of the provider to the authors of Terraform modules,
+// so that they can test their modules against Grafana from Go tests, with the `resource.Test` helper of the Terraform plugin SDK.
+//
+// The provider is configured from the same environment variables as in Terraform (GRAFANA_URL, GRAFANA_AUTH, ...),
+// and GRAFANA_VERSION is the version of Grafana the tests run against. StartGrafana sets them for a Grafana container.
+// Like `resource.Test`, the helpers which need Grafana skip the test unless TF_ACC is set.
+package grafanatest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// ProviderFactories returns the provider factories to set in the `ProtoV5ProviderFactories` of `resource.TestCase`,
+// with the Grafana provider under the `grafana` name. The provider is served like by its released binary.
+// A new provider instance is allocated for each invocation, so that concurrent tests don't overwrite the configuration of each other.
+func ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"grafana": func() (tfprotov5.ProviderServer, error) {
+			server, err := provider.MakeProviderServer(context.Background(), "testacc")
+			if err != nil {
+				return nil, err
+			}
+			return server(), nil
+		},
+	}
+}
+
+// skipUnlessAcceptanceTests skips the test unless TF_ACC is set, like `resource.Test`.
+func skipUnlessAcceptanceTests(t *testing.T) {
+	t.Helper()
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+}
+
+// CheckVersion skips the test if the version of Grafana in GRAFANA_VERSION doesn't match the semver constraint, e.g. `>=10.0.0`.
+// The test isn't skipped if GRAFANA_VERSION isn't set.
+func CheckVersion(t *testing.T, constraint string) {
+	t.Helper()
+
+	versionStr := os.Getenv("GRAFANA_VERSION")
+	if constraint == "" || versionStr == "" {
+		return
+	}
+	version, err := semver.NewVersion(versionStr)
+	if err != nil {
+		t.Fatalf("invalid GRAFANA_VERSION %s: %v", versionStr, err)
+	}
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		t.Fatalf("invalid constraint %s: %v", constraint, err)
+	}
+	if !c.Check(version) {
+		t.Skipf("skipping test for Grafana version `%s`, constraint `%s`", versionStr, constraint)
+	}
+}
+
+// CreateOrg creates an organization with a random name, deleted at the end of the test, and returns its ID.
+// It requires the provider's credentials to have the server admin role, e.g. the basic auth of the `admin` user.
+func CreateOrg(t *testing.T) int64 {
+	t.Helper()
+	skipUnlessAcceptanceTests(t)
+
+	client := configuredClient(t)
+	name := acctest.RandomWithPrefix("tf-test")
+	resp, err := client.GrafanaOAPI.Orgs.CreateOrg(&models.CreateOrgCommand{Name: name})
+	if err != nil {
+		t.Fatalf("failed to create organization %s: %v", name, err)
+	}
+	orgID := *resp.Payload.OrgID
+	t.Cleanup(func() {
+		if _, err := client.GrafanaOAPI.Orgs.DeleteOrgByID(orgID); err != nil {
+			t.Errorf("failed to delete organization %s: %v", name, err)
+		}
+	})
+	return orgID
+}
+
+// configuredClient configures a provider from the environment variables, to call the API of Grafana outside of Terraform.
+func configuredClient(t *testing.T) *common.Client {
+	t.Helper()
+
+	p := provider.Provider("testacc")
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		t.Fatalf("failed to configure provider: %+v", diags)
+	}
+	client := p.Meta().(*common.Client)
+	if client.GrafanaOAPI == nil {
+		t.Fatal("GRAFANA_URL and GRAFANA_AUTH must be set")
+	}
+	return client
+}
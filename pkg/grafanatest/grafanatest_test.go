@@ -0,0 +1,85 @@
+package grafanatest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// runSkipped runs the function in a subtest, and returns whether it skipped the subtest.
+func runSkipped(t *testing.T, name string, f func(t *testing.T)) bool {
+	t.Helper()
+
+	var skipped bool
+	t.Run(name, func(t *testing.T) {
+		defer func() { skipped = t.Skipped() }()
+		f(t)
+	})
+	return skipped
+}
+
+func TestProviderFactories(t *testing.T) {
+	server, err := ProviderFactories()["grafana"]()
+	if err != nil {
+		t.Fatalf("failed to create the provider server: %v", err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("failed to get the provider schema: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("failed to get the provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	if _, ok := resp.ResourceSchemas["grafana_dashboard"]; !ok {
+		t.Error("expected the provider to serve the grafana_dashboard resource")
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	t.Setenv("GRAFANA_VERSION", "10.2.3")
+
+	if runSkipped(t, "matching", func(t *testing.T) { CheckVersion(t, ">=10.0.0") }) {
+		t.Error("expected the test not to be skipped")
+	}
+	if !runSkipped(t, "not matching", func(t *testing.T) { CheckVersion(t, ">=11.0.0") }) {
+		t.Error("expected the test to be skipped")
+	}
+}
+
+func TestSkipUnlessAcceptanceTests(t *testing.T) {
+	t.Setenv(resource.EnvTfAcc, "")
+	// Docker can't be found: the tests fail if they try to start Grafana
+	t.Setenv("PATH", "")
+
+	if !runSkipped(t, "StartGrafana", func(t *testing.T) { StartGrafana(t, GrafanaOptions{}) }) {
+		t.Error("expected StartGrafana to skip the test")
+	}
+	if !runSkipped(t, "CreateOrg", func(t *testing.T) { CreateOrg(t) }) {
+		t.Error("expected CreateOrg to skip the test")
+	}
+}
+
+func TestWaitForGrafana(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"database": "ok", "version": "10.2.3"}`))
+	}))
+	defer server.Close()
+
+	version, err := waitForGrafana(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != "10.2.3" {
+		t.Errorf("expected version 10.2.3, got %s", version)
+	}
+}